@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/robbydyer/sports/internal/espnboard"
+)
+
+var defaultLogoLeagues = []string{"ncaaf", "nba", "nfl", "ncaam", "mlb", "mls", "nhl", "ncaaw", "wnba"}
+
+type logosCmd struct {
+	rArgs  *rootArgs
+	all    bool
+	width  int
+	height int
+}
+
+func newLogosCmd(args *rootArgs) *cobra.Command {
+	c := logosCmd{
+		rArgs: args,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "logos [league...]",
+		Short: "Prints the teams that have no logo and are drawn with a fallback",
+		Long: `Prints the teams that have no logo at the matrix size and are drawn with a fallback,
+so that you know which logos to add to the logoImageDir. Defaults to the major leagues.`,
+		RunE: c.run,
+	}
+
+	f := cmd.Flags()
+	f.BoolVar(&c.all, "all", false, "Print the logo source of every team")
+	f.IntVar(&c.width, "width", 0, "Matrix width. Defaults to the configured matrix size")
+	f.IntVar(&c.height, "height", 0, "Matrix height. Defaults to the configured matrix size")
+
+	return cmd
+}
+
+func (s *logosCmd) run(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		<-ch
+		fmt.Println("Got OS interrupt signal, Shutting down")
+		cancel()
+	}()
+
+	logger, err := s.rArgs.getLogger(s.rArgs.logLevel)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if s.rArgs.writer != nil {
+			s.rArgs.writer.Close()
+		}
+	}()
+
+	if s.width < 1 {
+		s.width = s.rArgs.config.SportsMatrixConfig.HardwareConfig.Cols
+	}
+	if s.height < 1 {
+		s.height = s.rArgs.config.SportsMatrixConfig.HardwareConfig.Rows
+	}
+	bounds := image.Rect(0, 0, s.width, s.height)
+
+	for _, l := range s.rArgs.config.ESPNLeagues {
+		if err := espnboard.RegisterLeague(&l.LeagueDefinition); err != nil {
+			return err
+		}
+	}
+
+	leagues := args
+	if len(leagues) < 1 {
+		leagues = defaultLogoLeagues
+	}
+
+	for _, league := range leagues {
+		api, err := espnboard.NewFromLeague(ctx, league, logger)
+		if err != nil {
+			return err
+		}
+		if err := s.printLogoSources(ctx, api, bounds); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *logosCmd) printLogoSources(ctx context.Context, api *espnboard.ESPNBoard, bounds image.Rectangle) error {
+	sources, err := api.LogoSources(ctx, bounds)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s (%dx%d)\n", api.League(), bounds.Dx(), bounds.Dy())
+	fallbacks := 0
+	for _, src := range sources {
+		if src.Source == espnboard.LogoSourceFallback {
+			fallbacks++
+		} else if !s.all {
+			continue
+		}
+
+		name := src.Team.DisplayName
+		if name == "" {
+			name = src.Team.Name
+		}
+		line := fmt.Sprintf("  %s (%s) %s => %s", src.Team.Abbreviation, src.Team.ID, name, src.Source)
+		if src.File != "" {
+			line = fmt.Sprintf("%s %s", line, src.File)
+		}
+		fmt.Println(line)
+	}
+	if fallbacks == 0 && !s.all {
+		fmt.Println("  All teams have a logo")
+	}
+	fmt.Println("")
+
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"image"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	yaml "github.com/ghodss/yaml"
@@ -17,16 +19,26 @@ import (
 	"github.com/robbydyer/sports/internal/board"
 	calendarboard "github.com/robbydyer/sports/internal/board/calendar"
 	"github.com/robbydyer/sports/internal/board/clock"
+	fightboard "github.com/robbydyer/sports/internal/board/fight"
+	golfboard "github.com/robbydyer/sports/internal/board/golf"
 	imageboard "github.com/robbydyer/sports/internal/board/image"
+	linesboard "github.com/robbydyer/sports/internal/board/lines"
+	pollboard "github.com/robbydyer/sports/internal/board/poll"
 	racingboard "github.com/robbydyer/sports/internal/board/racing"
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
 	statboard "github.com/robbydyer/sports/internal/board/stat"
 	sysboard "github.com/robbydyer/sports/internal/board/sys"
+	tennisboard "github.com/robbydyer/sports/internal/board/tennis"
 	textboard "github.com/robbydyer/sports/internal/board/text"
 	"github.com/robbydyer/sports/internal/config"
 	"github.com/robbydyer/sports/internal/espnboard"
+	"github.com/robbydyer/sports/internal/espnfight"
 	"github.com/robbydyer/sports/internal/espnracing"
+	"github.com/robbydyer/sports/internal/espntennis"
+	"github.com/robbydyer/sports/internal/feed"
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/gcal"
+	"github.com/robbydyer/sports/internal/locale"
 	"github.com/robbydyer/sports/internal/logo"
 	"github.com/robbydyer/sports/internal/matrix"
 	"github.com/robbydyer/sports/internal/mlb"
@@ -34,7 +46,9 @@ import (
 	"github.com/robbydyer/sports/internal/nhl"
 	"github.com/robbydyer/sports/internal/pga"
 	rgb "github.com/robbydyer/sports/internal/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/internal/rgbrender"
 	"github.com/robbydyer/sports/internal/sportsmatrix"
+	"github.com/robbydyer/sports/internal/theme"
 )
 
 var defaultPGAUpdateInterval = 2 * time.Minute
@@ -110,6 +124,22 @@ func newRootCmd(args *rootArgs) *cobra.Command {
 
 			args.setConfigDefaults()
 
+			if err := args.setThemes(); err != nil {
+				return err
+			}
+
+			if err := args.setLocale(); err != nil {
+				return err
+			}
+
+			if err := args.setFallbackFonts(); err != nil {
+				return err
+			}
+
+			if err := fetch.Configure(args.config.Fetch); err != nil {
+				return fmt.Errorf("invalid fetch config: %w", err)
+			}
+
 			if viper.GetBool("debug") || args.config.Debug {
 				debugServer := NewDebugServer("0.0.0.0:6060")
 
@@ -152,6 +182,7 @@ func newRootCmd(args *rootArgs) *cobra.Command {
 	rootCmd.AddCommand(newRunCmd(args))
 	rootCmd.AddCommand(newNcaaMCmd(args))
 	rootCmd.AddCommand(newAbbrevCmd(args))
+	rootCmd.AddCommand(newLogosCmd(args))
 	rootCmd.AddCommand(newCalCmd(args))
 	rootCmd.AddCommand(newGcalSetupCmd(args))
 
@@ -170,6 +201,14 @@ func (r *rootArgs) setConfig(filename string) error {
 		return fmt.Errorf("failed to parse config file: %w", err)
 	}
 
+	if c != nil && c.ESPNLeaguesFile != "" {
+		leagues, err := config.LoadESPNLeagues(c.ESPNLeaguesFile)
+		if err != nil {
+			return err
+		}
+		c.ESPNLeagues = append(c.ESPNLeagues, leagues...)
+	}
+
 	r.config = c
 	return nil
 }
@@ -243,7 +282,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.NCAAMConfig.Stats == nil {
+		r.config.NCAAMConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.NCAAMConfig.SetDefaults()
+	r.config.NCAAMConfig.Stats.SetDefaults()
 	r.config.NCAAMConfig.Headlines.SetDefaults()
 
 	if r.config.NCAAFConfig == nil {
@@ -256,7 +301,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.NCAAFConfig.Stats == nil {
+		r.config.NCAAFConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.NCAAFConfig.SetDefaults()
+	r.config.NCAAFConfig.Stats.SetDefaults()
 	r.config.NCAAFConfig.Headlines.SetDefaults()
 
 	if r.config.NBAConfig == nil {
@@ -269,7 +320,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.NBAConfig.Stats == nil {
+		r.config.NBAConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.NBAConfig.SetDefaults()
+	r.config.NBAConfig.Stats.SetDefaults()
 	r.config.NBAConfig.Headlines.SetDefaults()
 
 	if r.config.NFLConfig == nil {
@@ -282,7 +339,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.NFLConfig.Stats == nil {
+		r.config.NFLConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.NFLConfig.SetDefaults()
+	r.config.NFLConfig.Stats.SetDefaults()
 	r.config.NFLConfig.Headlines.SetDefaults()
 
 	if r.config.MLSConfig == nil {
@@ -295,7 +358,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.MLSConfig.Stats == nil {
+		r.config.MLSConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.MLSConfig.SetDefaults()
+	r.config.MLSConfig.Stats.SetDefaults()
 	r.config.MLSConfig.Headlines.SetDefaults()
 
 	if r.config.EPLConfig == nil {
@@ -308,7 +377,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.EPLConfig.Stats == nil {
+		r.config.EPLConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.EPLConfig.SetDefaults()
+	r.config.EPLConfig.Stats.SetDefaults()
 	r.config.EPLConfig.Headlines.SetDefaults()
 
 	if r.config.DFLConfig == nil {
@@ -321,7 +396,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.DFLConfig.Stats == nil {
+		r.config.DFLConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.DFLConfig.SetDefaults()
+	r.config.DFLConfig.Stats.SetDefaults()
 	r.config.DFLConfig.Headlines.SetDefaults()
 
 	if r.config.DFBConfig == nil {
@@ -334,7 +415,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.DFBConfig.Stats == nil {
+		r.config.DFBConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.DFBConfig.SetDefaults()
+	r.config.DFBConfig.Stats.SetDefaults()
 	r.config.DFBConfig.Headlines.SetDefaults()
 
 	if r.config.UEFAConfig == nil {
@@ -347,7 +434,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.UEFAConfig.Stats == nil {
+		r.config.UEFAConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.UEFAConfig.SetDefaults()
+	r.config.UEFAConfig.Stats.SetDefaults()
 	r.config.UEFAConfig.Headlines.SetDefaults()
 
 	if r.config.FIFAConfig == nil {
@@ -360,7 +453,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.FIFAConfig.Stats == nil {
+		r.config.FIFAConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.FIFAConfig.SetDefaults()
+	r.config.FIFAConfig.Stats.SetDefaults()
 	r.config.FIFAConfig.Headlines.SetDefaults()
 
 	if r.config.SysConfig == nil {
@@ -396,6 +495,67 @@ func (r *rootArgs) setConfigDefaults() {
 	}
 	r.config.IRLConfig.SetDefaults()
 
+	for series, c := range r.config.RacingConfigs {
+		if c == nil {
+			c = &racingboard.Config{}
+			r.config.RacingConfigs[series] = c
+		}
+		c.SetDefaults()
+	}
+
+	for tour, c := range r.config.GolfConfigs {
+		if c == nil {
+			c = &golfboard.Config{}
+			r.config.GolfConfigs[tour] = c
+		}
+		c.SetDefaults()
+	}
+
+	for tour, c := range r.config.TennisConfigs {
+		if c == nil {
+			c = &tennisboard.Config{}
+			r.config.TennisConfigs[tour] = c
+		}
+		c.SetDefaults()
+	}
+
+	for promotion, c := range r.config.FightConfigs {
+		if c == nil {
+			c = &fightboard.Config{}
+			r.config.FightConfigs[promotion] = c
+		}
+		c.SetDefaults()
+	}
+
+	for league, c := range r.config.LinesConfigs {
+		if c == nil {
+			c = &linesboard.Config{}
+			r.config.LinesConfigs[league] = c
+		}
+		c.SetDefaults()
+	}
+
+	for league, c := range r.config.PollConfigs {
+		if c == nil {
+			c = &pollboard.Config{}
+			r.config.PollConfigs[league] = c
+		}
+		c.SetDefaults()
+	}
+
+	for name, c := range r.config.Feeds {
+		if c == nil {
+			c = &feed.Config{}
+			r.config.Feeds[name] = c
+		}
+		if c.Board == nil {
+			c.Board = &textboard.Config{
+				StartEnabled: atomic.NewBool(false),
+			}
+		}
+		c.SetDefaults()
+	}
+
 	if r.config.CalenderConfig == nil {
 		r.config.CalenderConfig = &calendarboard.Config{
 			StartEnabled: atomic.NewBool(false),
@@ -413,7 +573,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.NCAAWConfig.Stats == nil {
+		r.config.NCAAWConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.NCAAWConfig.SetDefaults()
+	r.config.NCAAWConfig.Stats.SetDefaults()
 	r.config.NCAAWConfig.Headlines.SetDefaults()
 
 	if r.config.WNBAConfig == nil {
@@ -426,7 +592,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.WNBAConfig.Stats == nil {
+		r.config.WNBAConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.WNBAConfig.SetDefaults()
+	r.config.WNBAConfig.Stats.SetDefaults()
 	r.config.WNBAConfig.Headlines.SetDefaults()
 
 	if r.config.LigueConfig == nil {
@@ -440,7 +612,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.LigueConfig.Stats == nil {
+		r.config.LigueConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.LigueConfig.SetDefaults()
+	r.config.LigueConfig.Stats.SetDefaults()
 	r.config.LigueConfig.Headlines.SetDefaults()
 
 	if r.config.SerieaConfig == nil {
@@ -453,7 +631,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.SerieaConfig.Stats == nil {
+		r.config.SerieaConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.SerieaConfig.SetDefaults()
+	r.config.SerieaConfig.Stats.SetDefaults()
 	r.config.SerieaConfig.Headlines.SetDefaults()
 
 	if r.config.LaligaConfig == nil {
@@ -466,7 +650,13 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.LaligaConfig.Stats == nil {
+		r.config.LaligaConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.LaligaConfig.SetDefaults()
+	r.config.LaligaConfig.Stats.SetDefaults()
 	r.config.LaligaConfig.Headlines.SetDefaults()
 
 	if r.config.XFLConfig == nil {
@@ -479,8 +669,101 @@ func (r *rootArgs) setConfigDefaults() {
 			StartEnabled: atomic.NewBool(false),
 		}
 	}
+	if r.config.XFLConfig.Stats == nil {
+		r.config.XFLConfig.Stats = &statboard.Config{
+			StartEnabled: atomic.NewBool(false),
+		}
+	}
 	r.config.XFLConfig.SetDefaults()
+	r.config.XFLConfig.Stats.SetDefaults()
 	r.config.XFLConfig.Headlines.SetDefaults()
+
+	for _, c := range []**sportboard.Config{
+		&r.config.IPLConfig,
+		&r.config.IntlCricketConfig,
+		&r.config.SixNationsConfig,
+		&r.config.SuperRugbyConfig,
+	} {
+		if *c == nil {
+			*c = &sportboard.Config{
+				StartEnabled: atomic.NewBool(false),
+			}
+		}
+		if (*c).Headlines == nil {
+			(*c).Headlines = &textboard.Config{
+				StartEnabled: atomic.NewBool(false),
+			}
+		}
+		(*c).SetDefaults()
+		(*c).Headlines.SetDefaults()
+	}
+
+	for _, l := range r.config.ESPNLeagues {
+		if l.Board == nil {
+			l.Board = &sportboard.Config{
+				StartEnabled: atomic.NewBool(false),
+			}
+		}
+		if l.Board.Headlines == nil {
+			l.Board.Headlines = &textboard.Config{
+				StartEnabled: atomic.NewBool(false),
+			}
+		}
+		l.Board.SetDefaults()
+		l.Board.Headlines.SetDefaults()
+		if l.Board.Stats != nil {
+			l.Board.Stats.SetDefaults()
+		}
+	}
+
+	if r.config.TickerConfig != nil {
+		r.config.TickerConfig.SetDefaults()
+	}
+
+	if r.config.LogoPositionDir != "" {
+		espnboard.SetLogoPositionDir(r.config.LogoPositionDir)
+	}
+	if r.config.LogoImageDir != "" {
+		logo.SetOverrideDir(r.config.LogoImageDir)
+	}
+}
+
+// setFallbackFonts sets the fonts used for characters missing from a board's font
+func (r *rootArgs) setFallbackFonts() error {
+	if err := rgbrender.SetFallbackFonts(r.config.FallbackFonts...); err != nil {
+		return fmt.Errorf("invalid fallback font: %w", err)
+	}
+	rgbrender.SetTransliterate(r.config.Transliterate)
+
+	return nil
+}
+
+// setLocale sets the locale every board displays times, dates and labels in
+func (r *rootArgs) setLocale() error {
+	l, err := locale.New(r.config.Locale)
+	if err != nil {
+		return fmt.Errorf("invalid locale: %w", err)
+	}
+	locale.Set(l)
+
+	return nil
+}
+
+// setThemes registers the configured themes and sets the global theme
+func (r *rootArgs) setThemes() error {
+	for _, t := range r.config.Themes {
+		if err := theme.Register(t); err != nil {
+			return fmt.Errorf("invalid theme: %w", err)
+		}
+	}
+
+	if r.config.Theme != "" {
+		if err := theme.SetDefault(r.config.Theme); err != nil {
+			return fmt.Errorf("invalid theme: %w", err)
+		}
+	}
+
+	return nil
 }
 
 func (r *rootArgs) getRGBMatrix(logger *zap.Logger) (matrix.Matrix, error) {
@@ -526,6 +809,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		logger.Error("mlb setup failed", zap.Error(err))
 	}
 
+	// The ESPN league APIs are shared with the poll and lines boards
+	espnAPIs := make(map[string]*espnboard.ESPNBoard)
+
 	if r.config.NHLConfig != nil && nhlAPI != nil {
 		var api sportboard.API
 		if r.alternateAPI {
@@ -534,16 +820,23 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 				return nil, err
 			}
 		} else {
-			api, err = espnboard.NewNHL(ctx, logger)
+			espnAPI, err := espnboard.NewNHL(ctx, logger)
 			if err != nil {
 				return boards, err
 			}
+			espnAPIs["nhl"] = espnAPI
+			api = espnAPI
 		}
 		l, err := espnboard.GetLeaguer("nhl")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		// Alternate APIs' team IDs don't match ESPN's news
+		var headlineOpts []espnboard.HeadlinesOption
+		if !r.alternateAPI {
+			headlineOpts = append(headlineOpts, espnboard.WithTeamSource(api))
+		}
+		headlineAPI := espnboard.NewHeadlines(l, logger, headlineOpts...)
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NHLConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -553,7 +846,11 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 
 		boards = append(boards, b)
 		if r.config.NHLConfig.Stats != nil {
-			b, err := statboard.New(ctx, nhlAPI, r.config.NHLConfig.Stats, logger)
+			var statOpts []statboard.OptionFunc
+			if e, ok := api.(*espnboard.ESPNBoard); ok {
+				statOpts = append(statOpts, statboard.WithLeadersAPI(e))
+			}
+			b, err := statboard.New(ctx, nhlAPI, r.config.NHLConfig.Stats, logger, statOpts...)
 			if err != nil {
 				return nil, err
 			}
@@ -578,18 +875,19 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 				return nil, err
 			}
 		} else {
-			api, err = espnboard.NewMLB(ctx, logger)
+			espnAPI, err := espnboard.NewMLB(ctx, logger)
 			if err != nil {
 				return boards, err
 			}
+			espnAPIs["mlb"] = espnAPI
+			api = espnAPI
 
 			m := &mlblive.MlbLive{
 				Logger: logger,
 			}
 
-			if r.config.MLBConfig.LiveViewFont != nil {
-				m.FontSize = r.config.MLBConfig.LiveViewFont.Size
-			}
+			m.Font = r.config.MLBConfig.LiveViewFont
+			m.Theme = r.config.MLBConfig.Theme
 
 			opts = append(opts,
 				sportboard.WithDetailedLiveRenderer(
@@ -607,7 +905,12 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		// Alternate APIs' team IDs don't match ESPN's news
+		var headlineOpts []espnboard.HeadlinesOption
+		if !r.alternateAPI {
+			headlineOpts = append(headlineOpts, espnboard.WithTeamSource(api))
+		}
+		headlineAPI := espnboard.NewHeadlines(l, logger, headlineOpts...)
 		opts = append(opts, sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo))
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.MLBConfig, opts...)
@@ -617,7 +920,11 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 
 		boards = append(boards, b)
 		if r.config.MLBConfig.Stats != nil {
-			b, err := statboard.New(ctx, mlbAPI, r.config.MLBConfig.Stats, logger)
+			var statOpts []statboard.OptionFunc
+			if e, ok := api.(*espnboard.ESPNBoard); ok {
+				statOpts = append(statOpts, statboard.WithLeadersAPI(e))
+			}
+			b, err := statboard.New(ctx, mlbAPI, r.config.MLBConfig.Stats, logger, statOpts...)
 			if err != nil {
 				return nil, err
 			}
@@ -636,11 +943,12 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return boards, err
 		}
+		espnAPIs["ncaam"] = api
 		l, err := espnboard.GetLeaguer("ncaam")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NCAAMConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
@@ -650,6 +958,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.NCAAMConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.NCAAMConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.NCAAMConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NCAAMConfig.Headlines, logger)
 			if err != nil {
@@ -663,12 +978,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return boards, err
 		}
+		espnAPIs["ncaaf"] = api
 
 		l, err := espnboard.GetLeaguer("ncaaf")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NCAAFConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
@@ -678,6 +994,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.NCAAFConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.NCAAFConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.NCAAFConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NCAAFConfig.Headlines, logger)
 			if err != nil {
@@ -691,11 +1014,12 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["nba"] = api
 		l, err := espnboard.GetLeaguer("nba")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NBAConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
@@ -705,6 +1029,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.NBAConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.NBAConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.NBAConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NBAConfig.Headlines, logger)
 			if err != nil {
@@ -718,11 +1049,12 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["nfl"] = api
 		l, err := espnboard.GetLeaguer("nfl")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NFLConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
@@ -732,6 +1064,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.NFLConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.NFLConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.NFLConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NFLConfig.Headlines, logger, textboard.WithHalfSizeLogo())
 			if err != nil {
@@ -745,12 +1084,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["mls"] = api
 
 		l, err := espnboard.GetLeaguer("mls")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.MLSConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -759,6 +1099,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.MLSConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.MLSConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.MLSConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.MLSConfig.Headlines, logger, textboard.WithHalfSizeLogo())
 			if err != nil {
@@ -772,12 +1119,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["epl"] = api
 
 		l, err := espnboard.GetLeaguer("epl")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.EPLConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -786,6 +1134,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.EPLConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.EPLConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.EPLConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.EPLConfig.Headlines, logger)
 			if err != nil {
@@ -800,12 +1155,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["dfl"] = api
 
 		l, err := espnboard.GetLeaguer("dfl")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.DFLConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -814,6 +1170,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.DFLConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.DFLConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.DFLConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.DFLConfig.Headlines, logger)
 			if err != nil {
@@ -828,12 +1191,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["dfb"] = api
 
 		l, err := espnboard.GetLeaguer("dfb")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.DFBConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -842,6 +1206,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.DFBConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.DFBConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.DFBConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.DFBConfig.Headlines, logger)
 			if err != nil {
@@ -856,12 +1227,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["uefa"] = api
 
 		l, err := espnboard.GetLeaguer("uefa")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.UEFAConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -870,6 +1242,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.UEFAConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.UEFAConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.UEFAConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.UEFAConfig.Headlines, logger)
 			if err != nil {
@@ -884,12 +1263,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["fifa"] = api
 
 		l, err := espnboard.GetLeaguer("fifa")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.FIFAConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -898,6 +1278,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.FIFAConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.FIFAConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.FIFAConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.FIFAConfig.Headlines, logger)
 			if err != nil {
@@ -978,6 +1365,134 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		boards = append(boards, b)
 	}
 
+	racingSeries := make([]string, 0, len(r.config.RacingConfigs))
+	for series := range r.config.RacingConfigs {
+		racingSeries = append(racingSeries, series)
+	}
+	sort.Strings(racingSeries)
+
+	for _, series := range racingSeries {
+		l, err := espnracing.GetLeaguer(series)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range boards {
+			if b.Name() == l.HTTPPathPrefix() {
+				return nil, fmt.Errorf("racing series '%s' is already configured", series)
+			}
+		}
+		api, err := espnracing.New(l, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := racingboard.New(api, logger, r.config.RacingConfigs[series])
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
+	golfTours := make([]string, 0, len(r.config.GolfConfigs))
+	for tour := range r.config.GolfConfigs {
+		golfTours = append(golfTours, tour)
+	}
+	sort.Strings(golfTours)
+
+	for _, tour := range golfTours {
+		l, err := pga.GetLeaguer(tour)
+		if err != nil {
+			return nil, err
+		}
+		b, err := golfboard.New(pga.NewGolf(l, logger), logger, r.config.GolfConfigs[tour])
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
+	tennisTours := make([]string, 0, len(r.config.TennisConfigs))
+	for tour := range r.config.TennisConfigs {
+		tennisTours = append(tennisTours, tour)
+	}
+	sort.Strings(tennisTours)
+
+	for _, tour := range tennisTours {
+		l, err := espntennis.GetLeaguer(tour)
+		if err != nil {
+			return nil, err
+		}
+		api, err := espntennis.New(l, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := tennisboard.New(api, logger, r.config.TennisConfigs[tour])
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
+	promotions := make([]string, 0, len(r.config.FightConfigs))
+	for promotion := range r.config.FightConfigs {
+		promotions = append(promotions, promotion)
+	}
+	sort.Strings(promotions)
+
+	for _, promotion := range promotions {
+		l, err := espnfight.GetLeaguer(promotion)
+		if err != nil {
+			return nil, err
+		}
+		api, err := espnfight.New(l, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := fightboard.New(api, logger, r.config.FightConfigs[promotion])
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
+	pollLeagues := make([]string, 0, len(r.config.PollConfigs))
+	for league := range r.config.PollConfigs {
+		pollLeagues = append(pollLeagues, league)
+	}
+	sort.Strings(pollLeagues)
+
+	for _, league := range pollLeagues {
+		key := strings.ToLower(league)
+		api, err := espnboard.NewPollAPI(ctx, league, espnAPIs[key], logger)
+		if err != nil {
+			return nil, err
+		}
+		espnAPIs[key] = api
+		b, err := pollboard.New(api, logger, r.config.PollConfigs[league])
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
+	feeds := make([]string, 0, len(r.config.Feeds))
+	for name := range r.config.Feeds {
+		feeds = append(feeds, name)
+	}
+	sort.Strings(feeds)
+
+	for _, name := range feeds {
+		c := r.config.Feeds[name]
+		api, err := feed.New(name, c, logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := textboard.New(api, c.Board, logger)
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
 	if r.config.CalenderConfig != nil {
 		api, err := gcal.New(logger)
 		if err != nil {
@@ -991,15 +1506,20 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 	}
 
 	if r.config.NCAAWConfig != nil {
-		api, err := espnboard.NewNCAAWomensBasketball(ctx, logger)
-		if err != nil {
-			return boards, err
+		api := espnAPIs["ncaaw"]
+		if api == nil {
+			var err error
+			api, err = espnboard.NewNCAAWomensBasketball(ctx, logger)
+			if err != nil {
+				return boards, err
+			}
 		}
+		espnAPIs["ncaaw"] = api
 		l, err := espnboard.GetLeaguer("ncaaw")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.NCAAWConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
@@ -1009,6 +1529,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.NCAAWConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.NCAAWConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.NCAAWConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.NCAAWConfig.Headlines, logger)
 			if err != nil {
@@ -1023,11 +1550,12 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["wnba"] = api
 		l, err := espnboard.GetLeaguer("wnba")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.WNBAConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
@@ -1037,6 +1565,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.WNBAConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.WNBAConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.WNBAConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.WNBAConfig.Headlines, logger)
 			if err != nil {
@@ -1051,12 +1586,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["ligue"] = api
 
 		l, err := espnboard.GetLeaguer("ligue")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.LigueConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -1065,6 +1601,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.LigueConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.LigueConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.LigueConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.LigueConfig.Headlines, logger)
 			if err != nil {
@@ -1079,12 +1622,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["seriea"] = api
 
 		l, err := espnboard.GetLeaguer("seriea")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.SerieaConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -1093,6 +1637,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.SerieaConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.SerieaConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.SerieaConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.SerieaConfig.Headlines, logger)
 			if err != nil {
@@ -1107,12 +1658,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["laliga"] = api
 
 		l, err := espnboard.GetLeaguer("laliga")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.LaligaConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
 		)
@@ -1121,6 +1673,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.LaligaConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.LaligaConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.LaligaConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.LaligaConfig.Headlines, logger)
 			if err != nil {
@@ -1135,11 +1694,12 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		espnAPIs["xfl"] = api
 		l, err := espnboard.GetLeaguer("xfl")
 		if err != nil {
 			return nil, err
 		}
-		headlineAPI := espnboard.NewHeadlines(l, logger)
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
 
 		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, r.config.XFLConfig,
 			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
@@ -1149,6 +1709,13 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 
 		boards = append(boards, b)
+		if r.config.XFLConfig.Stats != nil {
+			b, err := statboard.New(ctx, api, r.config.XFLConfig.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
 		if r.config.XFLConfig.Headlines != nil {
 			b, err := textboard.New(headlineAPI, r.config.XFLConfig.Headlines, logger, textboard.WithHalfSizeLogo())
 			if err != nil {
@@ -1158,5 +1725,108 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		}
 	}
 
+	// Cricket and rugby have no stats boards, as ESPN doesn't publish leaders for them
+	richLeagues := []struct {
+		league string
+		config *sportboard.Config
+		newAPI func(context.Context, *zap.Logger) (*espnboard.ESPNBoard, error)
+	}{
+		{league: "ipl", config: r.config.IPLConfig, newAPI: espnboard.NewIPL},
+		{league: "intlcricket", config: r.config.IntlCricketConfig, newAPI: espnboard.NewInternationalCricket},
+		{league: "sixnations", config: r.config.SixNationsConfig, newAPI: espnboard.NewSixNations},
+		{league: "superrugby", config: r.config.SuperRugbyConfig, newAPI: espnboard.NewSuperRugby},
+	}
+	for _, rich := range richLeagues {
+		if rich.config == nil {
+			continue
+		}
+		api, err := rich.newAPI(ctx, logger)
+		if err != nil {
+			return nil, err
+		}
+		l, err := espnboard.GetLeaguer(rich.league)
+		if err != nil {
+			return nil, err
+		}
+		headlineAPI := espnboard.NewHeadlines(l, logger, espnboard.WithTeamSource(api))
+
+		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, rich.config,
+			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+		)
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+
+		if rich.config.Headlines != nil {
+			b, err := textboard.New(headlineAPI, rich.config.Headlines, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
+	}
+
+	for _, l := range r.config.ESPNLeagues {
+		api, err := espnboard.NewFromDefinition(ctx, &l.LeagueDefinition, logger)
+		if err != nil {
+			return nil, err
+		}
+		espnAPIs[l.LeagueDefinition.HTTPPathPrefix()] = api
+		headlineAPI := espnboard.NewHeadlines(&l.LeagueDefinition, logger, espnboard.WithTeamSource(api))
+
+		b, err := sportboard.New(ctx, api, bounds, r.todayT, logger, l.Board,
+			sportboard.WithLeagueLogoGetter(headlineAPI.GetLogo),
+		)
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+
+		if l.Board.Stats != nil {
+			b, err := statboard.New(ctx, api, l.Board.Stats, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
+		if l.Board.Headlines != nil {
+			b, err := textboard.New(headlineAPI, l.Board.Headlines, logger)
+			if err != nil {
+				return nil, err
+			}
+			boards = append(boards, b)
+		}
+	}
+
+	// Lines boards come after the ESPN leagues from the config, so those leagues are registered
+	linesLeagues := make([]string, 0, len(r.config.LinesConfigs))
+	for league := range r.config.LinesConfigs {
+		linesLeagues = append(linesLeagues, league)
+	}
+	sort.Strings(linesLeagues)
+
+	for _, league := range linesLeagues {
+		api, err := espnboard.NewLinesAPI(ctx, league, espnAPIs[strings.ToLower(league)], logger)
+		if err != nil {
+			return nil, err
+		}
+		b, err := linesboard.New(api, logger, r.config.LinesConfigs[league])
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
+	if r.config.TickerConfig != nil {
+		b, err := sportboard.NewTicker(r.config.TickerConfig, logger,
+			sportboard.TickerBoards(r.config.TickerConfig.Leagues, boards)...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
 	return boards, nil
 }
@@ -113,9 +113,8 @@ func (c *mlbCmd) run(cmd *cobra.Command, args []string) error {
 		Logger: logger,
 	}
 
-	if c.rArgs.config.MLBConfig.LiveViewFont != nil {
-		m.FontSize = c.rArgs.config.MLBConfig.LiveViewFont.Size
-	}
+	m.Font = c.rArgs.config.MLBConfig.LiveViewFont
+	m.Theme = c.rArgs.config.MLBConfig.Theme
 
 	dR := func(ctx context.Context, canvas board.Canvas, game sportboard.Game, hLogo *logo.Logo, aLogo *logo.Logo) error {
 		logger.Info("render detailed live view")
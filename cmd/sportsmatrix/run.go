@@ -14,7 +14,9 @@ import (
 	"github.com/robbydyer/sports/internal/board"
 	imageboard "github.com/robbydyer/sports/internal/board/image"
 	cnvs "github.com/robbydyer/sports/internal/canvas"
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/matrix"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 	"github.com/robbydyer/sports/internal/sportsmatrix"
 )
 
@@ -39,6 +41,8 @@ func newRunCmd(args *rootArgs) *cobra.Command {
 func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	// Keep the latest responses for offline mode and for outages after a restart
+	defer fetch.Flush()
 
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
@@ -77,6 +81,14 @@ func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 
 	canvases = append(canvases, cnvs.NewCanvas(matrix))
 
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(matrix, logger,
+		scrcnvs.WithPreloadThreads(s.rArgs.config.SportsMatrixConfig.PreloadThreads),
+	)
+	if err != nil {
+		return err
+	}
+	canvases = append(canvases, scrollCanvas)
+
 	newBoards := []board.Board{}
 	inBetweenBoards := []board.Board{}
 
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"embed"
+	"fmt"
 	"image"
 	"path/filepath"
+	"strings"
 
 	"github.com/disintegration/imaging"
 
@@ -42,3 +44,66 @@ func GetLogo(fileName string, bounds image.Rectangle) (*logo.Logo, error) {
 		},
 	), nil
 }
+
+// flagAliases maps ISO 3166 alpha-3 country codes to the IOC codes used for flag assets
+var flagAliases = map[string]string{
+	"deu": "ger",
+	"nld": "ned",
+	"che": "sui",
+	"hrv": "cro",
+	"dnk": "den",
+	"chl": "chi",
+	"prt": "por",
+	"grc": "gre",
+	"svn": "slo",
+	"lva": "lat",
+	"bgr": "bul",
+	"idn": "ina",
+}
+
+// HasFlag returns true if there is a bundled flag for a country code
+func HasFlag(country string) bool {
+	_, err := assets.ReadFile(filepath.Join("assets", "flags", flagFile(country)))
+	return err == nil
+}
+
+// GetFlag gets a country flag by its IOC or ISO 3166 alpha-3 code, such as "ESP" or "usa"
+func GetFlag(country string, bounds image.Rectangle) (*logo.Logo, error) {
+	if !HasFlag(country) {
+		return nil, fmt.Errorf("no flag for country '%s'", country)
+	}
+	fileName := flagFile(country)
+
+	getter := func(ctx context.Context) (image.Image, error) {
+		b, err := assets.ReadFile(filepath.Join("assets", "flags", fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		reader := bytes.NewReader(b)
+		return imaging.Decode(reader)
+	}
+	return logo.New(
+		fmt.Sprintf("flag_%s_%dx%d", strings.TrimSuffix(fileName, ".png"), bounds.Dx(), bounds.Dy()),
+		getter,
+		"/tmp/sportsmatrix/assetlogos",
+		bounds,
+		&logo.Config{
+			FitImage: true,
+			Abbrev:   fileName,
+			XSize:    bounds.Dx(),
+			YSize:    bounds.Dy(),
+			Pt: &logo.Pt{
+				Zoom: 1,
+			},
+		},
+	), nil
+}
+
+func flagFile(country string) string {
+	code := strings.ToLower(strings.TrimSpace(country))
+	if alias, ok := flagAliases[code]; ok {
+		code = alias
+	}
+	return code + ".png"
+}
@@ -0,0 +1,29 @@
+package assetlogo
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagsUnique(t *testing.T) {
+	t.Parallel()
+
+	entries, err := assets.ReadDir(filepath.Join("assets", "flags"))
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+
+	seen := make(map[string]string)
+	for _, e := range entries {
+		b, err := assets.ReadFile(filepath.Join("assets", "flags", e.Name()))
+		require.NoError(t, err)
+
+		sum := fmt.Sprintf("%x", sha256.Sum256(b))
+		other, ok := seen[sum]
+		require.False(t, ok, "flag %s is the same image as %s", e.Name(), other)
+		seen[sum] = e.Name()
+	}
+}
@@ -32,6 +32,19 @@ type Board interface {
 	Enabler() Enabler
 }
 
+// ScrollBoard is a Board that can render to a scrolling Canvas
+type ScrollBoard interface {
+	Board
+	ScrollMode() bool
+	ScrollRender(ctx context.Context, canvas Canvas, padding int) (Canvas, error)
+}
+
+// WrapperBoard is a Board that renders other Boards, such as a ticker of several leagues
+type WrapperBoard interface {
+	Board
+	WrappedBoards() []Board
+}
+
 // Canvas ...
 type Canvas interface {
 	image.Image
@@ -44,12 +44,13 @@ type Todayer func() []time.Time
 type Config struct {
 	TodayFunc          Todayer
 	boardDelay         time.Duration
-	StartEnabled       *atomic.Bool `json:"enabled"`
-	BoardDelay         string       `json:"boardDelay"`
-	OnTimes            []string     `json:"onTimes"`
-	OffTimes           []string     `json:"offTimes"`
-	TightScrollPadding int          `json:"tightScrollPadding"`
-	CalendarIDs        []string     `json:"calendarIDs"`
+	StartEnabled       *atomic.Bool          `json:"enabled"`
+	BoardDelay         string                `json:"boardDelay"`
+	OnTimes            []string              `json:"onTimes"`
+	OffTimes           []string              `json:"offTimes"`
+	TightScrollPadding int                   `json:"tightScrollPadding"`
+	CalendarIDs        []string              `json:"calendarIDs"`
+	Font               *rgbrender.FontConfig `json:"font"`
 }
 
 // API ...
@@ -111,7 +112,7 @@ func New(api API, logger *zap.Logger, config *Config) (*CalendarBoard, error) {
 	}
 	if err := util.SetCrons(config.OffTimes, func() {
 		s.log.Info("calendarboard turning off")
-		s.Enabler().Disable()
+		board.DisableScheduled(s.Enabler())
 	}); err != nil {
 		return nil, err
 	}
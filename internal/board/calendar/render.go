@@ -11,7 +11,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/locale"
 	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 )
 
 // Render ...
@@ -24,6 +26,66 @@ func (s *CalendarBoard) Render(ctx context.Context, canvas board.Canvas) error {
 	return nil
 }
 
+// ScrollRender renders each of today's events as a cell of a ScrollCanvas
+func (s *CalendarBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	events, err := s.api.DailyEvents(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) < 1 {
+		return nil, nil
+	}
+
+	scheduleWriter, err := s.getScheduleWriter(rgbrender.ZeroedBounds(canvas.Bounds()))
+	if err != nil {
+		return nil, err
+	}
+
+	if s.logo == nil {
+		var err error
+		s.logo, err = s.api.CalendarIcon(ctx, canvas.Bounds())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.Name()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+EVENTS:
+	for _, event := range events {
+		select {
+		case <-ctx.Done():
+			return nil, context.Canceled
+		default:
+		}
+		img, err := s.renderEvent(ctx, canvas.Bounds(), event, scheduleWriter)
+		if err != nil {
+			s.log.Error("failed to render calendar event",
+				zap.Error(err),
+			)
+			continue EVENTS
+		}
+
+		scrollCanvas.AddCanvas(img)
+	}
+
+	go scrollCanvas.MatchScroll(ctx, base)
+
+	return scrollCanvas, nil
+}
+
 // Render ...
 // nolint:contextcheck
 func (s *CalendarBoard) render(ctx context.Context, canvas board.Canvas) error {
@@ -113,8 +175,8 @@ func (s *CalendarBoard) renderEvent(ctx context.Context, bounds image.Rectangle,
 		img,
 		dateBounds,
 		[]string{
-			event.Time.Format("Mon Jan 2"),
-			event.Time.Format("03:04PM"),
+			locale.Current().DayDate(event.Time),
+			locale.Current().Time(event.Time),
 		},
 		color.White,
 	); err != nil {
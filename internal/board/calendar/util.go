@@ -29,5 +29,10 @@ func (s *CalendarBoard) getScheduleWriter(bounds image.Rectangle) (*rgbrender.Te
 		s.scheduleWriter.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
 	}
 
+	if err := s.scheduleWriter.Configure(s.config.Font); err != nil {
+		s.scheduleWriter = nil
+		return nil, err
+	}
+
 	return s.scheduleWriter, nil
 }
@@ -2,8 +2,7 @@ package clock
 
 import (
 	"context"
-	"fmt"
-	"image/color"
+	"image"
 	"net/http"
 	"sync"
 	"time"
@@ -15,8 +14,10 @@ import (
 
 	"github.com/robbydyer/sports/internal/board"
 	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/locale"
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
 	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/theme"
 	"github.com/robbydyer/sports/internal/twirphelpers"
 	"github.com/robbydyer/sports/internal/util"
 )
@@ -38,12 +39,14 @@ type Clock struct {
 // Config is a Clock configuration
 type Config struct {
 	boardDelay   time.Duration
-	StartEnabled *atomic.Bool `json:"enabled"`
-	BoardDelay   string       `json:"boardDelay"`
-	OnTimes      []string     `json:"onTimes"`
-	OffTimes     []string     `json:"offTimes"`
-	ShowBetween  *atomic.Bool `json:"showBetween"`
-	Enable24Hour *atomic.Bool `json:"enable24Hour"`
+	StartEnabled *atomic.Bool          `json:"enabled"`
+	BoardDelay   string                `json:"boardDelay"`
+	OnTimes      []string              `json:"onTimes"`
+	OffTimes     []string              `json:"offTimes"`
+	ShowBetween  *atomic.Bool          `json:"showBetween"`
+	Enable24Hour *atomic.Bool          `json:"enable24Hour"`
+	Font         *rgbrender.FontConfig `json:"font"`
+	Theme        string                `json:"theme"`
 }
 
 // SetDefaults ...
@@ -66,9 +69,6 @@ func (c *Config) SetDefaults() {
 		c.ShowBetween = atomic.NewBool(false)
 	}
 
-	if c.Enable24Hour == nil {
-		c.Enable24Hour = atomic.NewBool(false)
-	}
 }
 
 // New returns a new Clock board
@@ -106,7 +106,7 @@ func New(config *Config, logger *zap.Logger) (*Clock, error) {
 	}
 	if err := util.SetCrons(config.OffTimes, func() {
 		c.log.Info("clock turning off")
-		c.Enabler().Disable()
+		board.DisableScheduled(c.Enabler())
 	}); err != nil {
 		return nil, err
 	}
@@ -148,32 +148,12 @@ func (c *Clock) Render(ctx context.Context, canvas board.Canvas) error {
 }
 
 func (c *Clock) currentTimeStr() string {
-	ampm := ""
-	h, m, _ := time.Now().Local().Clock()
-	z := ""
-	if m < 10 {
-		z = "0"
-	}
-
-	if c.config.Enable24Hour.Load() {
-		hz := ""
-		if h < 10 {
-			hz = "0"
-		}
-		return fmt.Sprintf("%s%d:%s%d", hz, h, z, m)
-	}
-
-	if h >= 12 {
-		h = h - 12
-		ampm = "PM"
-	} else {
-		ampm = "AM"
-	}
-	if h == 0 {
-		h = 12
-	}
+	return c.locale().Time(time.Now())
+}
 
-	return fmt.Sprintf("%d:%s%d%s", h, z, m, ampm)
+// locale returns the global locale with the board's 24 hour setting
+func (c *Clock) locale() *locale.Locale {
+	return locale.Current().With24Hour(c.config.Enable24Hour)
 }
 
 // Render ...
@@ -224,15 +204,7 @@ func (c *Clock) render(ctx context.Context, canvas board.Canvas) error {
 			}
 			c.log.Debug("done waiting for update")
 
-			if err := writer.WriteAligned(
-				rgbrender.CenterCenter,
-				canvas,
-				canvas.Bounds(),
-				[]string{
-					c.currentTimeStr(),
-				},
-				color.White,
-			); err != nil {
+			if err := c.writeTime(canvas, writer); err != nil {
 				c.log.Error("failed to write clock", zap.Error(err))
 				return
 			}
@@ -296,6 +268,43 @@ func (c *Clock) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 	}, nil
 }
 
+// writeTime writes the current time. If there's a secondary time zone, its time is written below in
+// a smaller font.
+func (c *Clock) writeTime(canvas board.Canvas, writer *rgbrender.TextWriter) error {
+	clr := theme.Resolve(c.config.Theme).TimeColor()
+	now := time.Now()
+	bounds := canvas.Bounds()
+
+	secondary, ok := c.locale().SecondaryTime(now)
+	if !ok {
+		return writer.WriteAligned(rgbrender.CenterCenter, canvas, bounds, []string{c.locale().Time(now)}, clr)
+	}
+
+	smallWriter, err := c.getWriter(rgbrender.ZeroedBounds(bounds).Dy() / 2)
+	if err != nil {
+		return err
+	}
+	midY := bounds.Min.Y + (bounds.Dy() * 2 / 3)
+
+	if err := writer.WriteAligned(
+		rgbrender.CenterCenter,
+		canvas,
+		image.Rect(bounds.Min.X, bounds.Min.Y, bounds.Max.X, midY),
+		[]string{c.locale().Time(now)},
+		clr,
+	); err != nil {
+		return err
+	}
+
+	return smallWriter.WriteAligned(
+		rgbrender.CenterCenter,
+		canvas,
+		image.Rect(bounds.Min.X, midY, bounds.Max.X, bounds.Max.Y),
+		[]string{secondary},
+		clr,
+	)
+}
+
 func (c *Clock) getWriter(canvasHeight int) (*rgbrender.TextWriter, error) {
 	if w, ok := c.textWriters[canvasHeight]; ok {
 		return w, nil
@@ -311,10 +320,15 @@ func (c *Clock) getWriter(canvasHeight int) (*rgbrender.TextWriter, error) {
 
 	size := 0.5 * float64(canvasHeight)
 
+	writer := rgbrender.NewTextWriter(c.font, size)
+	writer.YStartCorrection = -3
+	if err := writer.Configure(c.config.Font); err != nil {
+		return nil, err
+	}
+
 	c.Lock()
 	defer c.Unlock()
-	c.textWriters[canvasHeight] = rgbrender.NewTextWriter(c.font, size)
-	c.textWriters[canvasHeight].YStartCorrection = -3
+	c.textWriters[canvasHeight] = writer
 
-	return c.textWriters[canvasHeight], nil
+	return writer, nil
 }
@@ -0,0 +1,230 @@
+package fightboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/logo"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+	"github.com/robbydyer/sports/internal/util"
+
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+)
+
+// fightWeek is how long before an event that its card is shown
+const fightWeek = 7 * 24 * time.Hour
+
+// Card segments, in the order they're shown
+const (
+	MainCard     = "Main Card"
+	Prelims      = "Prelims"
+	EarlyPrelims = "Early Prelims"
+)
+
+// FightBoard implements board.Board
+type FightBoard struct {
+	config      *Config
+	api         API
+	log         *zap.Logger
+	writer      *rgbrender.TextWriter
+	leagueLogo  *logo.Logo
+	events      []*Event
+	rpcServer   pb.TwirpServer
+	boardCtx    context.Context
+	boardCancel context.CancelFunc
+	enabler     board.Enabler
+}
+
+// Config ...
+type Config struct {
+	boardDelay   time.Duration
+	StartEnabled *atomic.Bool          `json:"enabled"`
+	BoardDelay   string                `json:"boardDelay"`
+	OnTimes      []string              `json:"onTimes"`
+	OffTimes     []string              `json:"offTimes"`
+	ShowCard     *atomic.Bool          `json:"showCard"`
+	ShowPrelims  *atomic.Bool          `json:"showPrelims"`
+	ShowRecords  *atomic.Bool          `json:"showRecords"`
+	Font         *rgbrender.FontConfig `json:"font"`
+}
+
+// API ...
+type API interface {
+	LeagueShortName() string
+	HTTPPathPrefix() string
+	GetLogo(ctx context.Context, bounds image.Rectangle) (*logo.Logo, error)
+	GetScheduledEvents(ctx context.Context) ([]*Event, error)
+	GetEventCard(ctx context.Context, event *Event) ([]*Bout, error)
+}
+
+// Event is a fight night
+type Event struct {
+	ID        string
+	Date      time.Time
+	Name      string
+	Live      bool
+	Completed bool
+}
+
+// Bout is a single fight on an event's card
+type Bout struct {
+	ID string
+	// Segment is the part of the card, such as MainCard or Prelims
+	Segment string
+	// Order is the position on the card within its segment. 1 is the top of the segment.
+	Order       int
+	WeightClass string
+	Title       bool
+	Fighters    []*Fighter
+	Live        bool
+	Completed   bool
+	Round       int
+	Clock       string
+	// Result is the method of victory, such as "KO/TKO"
+	Result string
+}
+
+// Fighter ...
+type Fighter struct {
+	Name string
+	// Record is the fighter's win-loss-draw record, such as "25-3-0"
+	Record string
+	Winner bool
+}
+
+// SetDefaults sets config defaults
+func (c *Config) SetDefaults() {
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err != nil {
+			c.boardDelay = 10 * time.Second
+		} else {
+			c.boardDelay = d
+		}
+	} else {
+		c.boardDelay = 10 * time.Second
+	}
+
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.ShowCard == nil {
+		c.ShowCard = atomic.NewBool(true)
+	}
+	if c.ShowPrelims == nil {
+		c.ShowPrelims = atomic.NewBool(true)
+	}
+	if c.ShowRecords == nil {
+		c.ShowRecords = atomic.NewBool(true)
+	}
+}
+
+// New ...
+func New(api API, logger *zap.Logger, config *Config) (*FightBoard, error) {
+	s := &FightBoard{
+		config:  config,
+		api:     api,
+		log:     logger,
+		enabler: enabler.New(),
+	}
+
+	if config.StartEnabled.Load() {
+		s.enabler.Enable()
+	}
+
+	s.log.Info("Register Fight Board",
+		zap.String("league", api.LeagueShortName()),
+		zap.String("board name", s.Name()),
+	)
+
+	if err := util.SetCrons(config.OnTimes, func() {
+		s.log.Info("fightboard turning on")
+		s.Enabler().Enable()
+	}); err != nil {
+		return nil, err
+	}
+	if err := util.SetCrons(config.OffTimes, func() {
+		s.log.Info("fightboard turning off")
+		board.DisableScheduled(s.Enabler())
+	}); err != nil {
+		return nil, err
+	}
+	if err := util.SetCrons([]string{"0 4 * * *"}, s.cacheClear); err != nil {
+		return nil, err
+	}
+
+	svr := &Server{
+		board: s,
+	}
+	prfx := s.api.HTTPPathPrefix()
+	if !strings.HasPrefix(prfx, "/") {
+		prfx = fmt.Sprintf("/%s", prfx)
+	}
+	s.rpcServer = pb.NewBasicBoardServer(svr,
+		twirp.WithServerPathPrefix(fmt.Sprintf("/fight%s", prfx)),
+		twirp.ChainHooks(
+			twirphelpers.GetDefaultHooks(s, s.log),
+		),
+	)
+
+	return s, nil
+}
+
+func (s *FightBoard) cacheClear() {
+	s.events = []*Event{}
+	s.leagueLogo = nil
+}
+
+// Name ...
+func (s *FightBoard) Name() string {
+	return fmt.Sprintf("Fight: %s", s.api.LeagueShortName())
+}
+
+// Enabler ...
+func (s *FightBoard) Enabler() board.Enabler {
+	return s.enabler
+}
+
+// InBetween ...
+func (s *FightBoard) InBetween() bool {
+	return false
+}
+
+// ScrollMode ...
+func (s *FightBoard) ScrollMode() bool {
+	return false
+}
+
+// GetHTTPHandlers ...
+func (s *FightBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+// GetRPCHandler ...
+func (s *FightBoard) GetRPCHandler() (string, http.Handler) {
+	return s.rpcServer.PathPrefix(), s.rpcServer
+}
+
+// segmentRank orders card segments main card first
+func segmentRank(segment string) int {
+	switch segment {
+	case MainCard:
+		return 0
+	case Prelims:
+		return 1
+	case EarlyPrelims:
+		return 2
+	}
+	return 3
+}
@@ -0,0 +1,364 @@
+package fightboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/locale"
+	"github.com/robbydyer/sports/internal/logo"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	headerColor = color.RGBA{255, 255, 0, 255}
+	titleColor  = color.RGBA{255, 170, 0, 255}
+	liveColor   = color.RGBA{0, 255, 0, 255}
+	loserColor  = color.RGBA{130, 130, 130, 255}
+)
+
+// Render ...
+//
+//nolint:contextcheck
+func (s *FightBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	s.boardCtx, s.boardCancel = context.WithCancel(ctx)
+
+	pages, err := s.pages(s.boardCtx, canvas.Bounds())
+	if err != nil {
+		return err
+	}
+
+PAGES:
+	for _, page := range pages {
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		default:
+		}
+
+		draw.Draw(canvas, page.Bounds(), page, image.Point{}, draw.Over)
+		board.DrawStaleMarker(s.api, canvas, rgbrender.ZeroedBounds(canvas.Bounds()))
+
+		if err := canvas.Render(s.boardCtx); err != nil {
+			s.log.Error("failed to render fight board",
+				zap.Error(err),
+			)
+			continue PAGES
+		}
+
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		case <-time.After(s.config.boardDelay):
+		}
+	}
+
+	return nil
+}
+
+// ScrollRender renders each event and bout as a cell of a ScrollCanvas
+func (s *FightBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	pages, err := s.pages(ctx, canvas.Bounds())
+	if err != nil {
+		return nil, err
+	}
+	if len(pages) < 1 {
+		return nil, nil
+	}
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.Name()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages {
+		scrollCanvas.AddCanvas(page)
+	}
+
+	go scrollCanvas.MatchScroll(ctx, base)
+
+	return scrollCanvas, nil
+}
+
+// pages returns a page for each scheduled event. Events that are live, or in their fight week,
+// are followed by a page for each bout on the card.
+func (s *FightBoard) pages(ctx context.Context, bounds image.Rectangle) ([]draw.Image, error) {
+	if s.leagueLogo == nil {
+		var err error
+		s.leagueLogo, err = s.api.GetLogo(ctx, bounds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(s.events) < 1 {
+		var err error
+		s.events, err = s.api.GetScheduledEvents(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer, err := s.getWriter(rgbrender.ZeroedBounds(bounds))
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Debug("fight events",
+		zap.String("league", s.api.LeagueShortName()),
+		zap.Int("number", len(s.events)),
+	)
+
+	var pages []draw.Image
+
+EVENTS:
+	for _, event := range s.events {
+		select {
+		case <-ctx.Done():
+			return nil, context.Canceled
+		default:
+		}
+
+		img, err := s.renderEvent(ctx, bounds, event, s.leagueLogo, writer)
+		if err != nil {
+			s.log.Error("failed to render fight event",
+				zap.Error(err),
+			)
+			continue EVENTS
+		}
+		pages = append(pages, img)
+
+		if !s.config.ShowCard.Load() || (!event.Live && time.Until(event.Date) > fightWeek) {
+			continue EVENTS
+		}
+
+		bouts, err := s.api.GetEventCard(ctx, event)
+		if err != nil {
+			s.log.Error("failed to get fight card",
+				zap.String("event", event.Name),
+				zap.Error(err),
+			)
+			continue EVENTS
+		}
+
+		for _, bout := range s.watchedBouts(bouts) {
+			img, err := s.renderBout(bounds, writer, bout)
+			if err != nil {
+				s.log.Error("failed to render bout",
+					zap.String("bout", bout.ID),
+					zap.Error(err),
+				)
+				continue
+			}
+			pages = append(pages, img)
+		}
+	}
+
+	return pages, nil
+}
+
+// watchedBouts orders the card from the main event down, dropping prelims if they're disabled
+func (s *FightBoard) watchedBouts(bouts []*Bout) []*Bout {
+	watched := []*Bout{}
+	for _, b := range bouts {
+		if b.Segment != MainCard && !s.config.ShowPrelims.Load() {
+			continue
+		}
+		watched = append(watched, b)
+	}
+
+	sort.SliceStable(watched, func(i, j int) bool {
+		ri, rj := segmentRank(watched[i].Segment), segmentRank(watched[j].Segment)
+		if ri != rj {
+			return ri < rj
+		}
+		return watched[i].Order < watched[j].Order
+	})
+
+	return watched
+}
+
+func (s *FightBoard) renderEvent(ctx context.Context, bounds image.Rectangle, event *Event, leagueLogo *logo.Logo, writer *rgbrender.TextWriter) (draw.Image, error) {
+	img := image.NewRGBA(bounds)
+	canvasBounds := rgbrender.ZeroedBounds(bounds)
+
+	logoImg, err := leagueLogo.RenderRightAlignedWithEnd(ctx, canvasBounds, (canvasBounds.Max.X-canvasBounds.Min.X)/2)
+	if err != nil {
+		return nil, err
+	}
+
+	pt := image.Pt(logoImg.Bounds().Min.X, logoImg.Bounds().Min.Y)
+	draw.Draw(img, logoImg.Bounds(), logoImg, pt, draw.Over)
+
+	gradient := rgbrender.GradientXRectangle(
+		canvasBounds,
+		0.1,
+		color.Black,
+		s.log,
+	)
+	pt = image.Pt(gradient.Bounds().Min.X, gradient.Bounds().Min.Y)
+	draw.Draw(img, gradient.Bounds(), gradient, pt, draw.Over)
+
+	loc := locale.Current()
+	txt := []string{
+		event.Name,
+		loc.Date(event.Date),
+		loc.TimeZone(event.Date),
+	}
+	if secondary, ok := loc.SecondaryTime(event.Date); ok && !event.Live {
+		txt = append(txt, secondary)
+	}
+	clr := color.Color(color.White)
+	if event.Live {
+		txt[2] = "LIVE"
+		clr = liveColor
+	}
+
+	lengths, err := writer.MeasureStrings(img, txt)
+	if err != nil {
+		return nil, err
+	}
+	maxX := canvasBounds.Dx() / 2
+	for _, length := range lengths {
+		if length > maxX {
+			maxX = length
+		}
+	}
+
+	textBounds := image.Rect(
+		canvasBounds.Max.X/2,
+		canvasBounds.Min.Y,
+		(canvasBounds.Max.X/2)+maxX,
+		canvasBounds.Max.Y,
+	)
+
+	if err := writer.WriteAligned(
+		rgbrender.LeftCenter,
+		img,
+		textBounds,
+		txt,
+		clr,
+	); err != nil {
+		return nil, fmt.Errorf("failed to write fight event: %w", err)
+	}
+
+	return img, nil
+}
+
+// renderBout shows the card segment and bout status, then each fighter's name and record
+func (s *FightBoard) renderBout(bounds image.Rectangle, writer *rgbrender.TextWriter, bout *Bout) (draw.Image, error) {
+	if len(bout.Fighters) != 2 {
+		return nil, fmt.Errorf("bout has %d fighters", len(bout.Fighters))
+	}
+
+	img := image.NewRGBA(bounds)
+	canvasBounds := rgbrender.ZeroedBounds(bounds)
+	rowH := canvasBounds.Dy() / 3
+	lineHeight := int(math.Ceil(writer.FontSize))
+
+	// row returns a line of text vertically centered in the i'th third of the canvas
+	row := func(i int) image.Rectangle {
+		y := canvasBounds.Min.Y + (i * rowH) + ((rowH - lineHeight) / 2)
+		return image.Rect(canvasBounds.Min.X, y, canvasBounds.Max.X, y+lineHeight)
+	}
+
+	status, statusColor := boutStatus(bout)
+	statusW := 0
+	if status != "" {
+		w, err := writer.MeasureStrings(img, []string{status})
+		if err != nil {
+			return nil, err
+		}
+		statusW = w[0] + 2
+		if err := writer.WriteAligned(rgbrender.RightTop, img, row(0), []string{status}, statusColor); err != nil {
+			return nil, err
+		}
+	}
+
+	header := fmt.Sprintf("%s %s", segmentAbbreviation(bout.Segment), bout.WeightClass)
+	hdrColor := headerColor
+	if bout.Title {
+		header = fmt.Sprintf("TITLE %s", bout.WeightClass)
+		hdrColor = titleColor
+	}
+	header, err := truncate(writer, img, header, canvasBounds.Dx()-statusW)
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.WriteAligned(rgbrender.LeftTop, img, row(0), []string{header}, hdrColor); err != nil {
+		return nil, err
+	}
+
+	for i, f := range bout.Fighters {
+		r := row(i + 1)
+
+		clr := color.Color(color.White)
+		if bout.Completed && !f.Winner {
+			clr = loserColor
+		}
+
+		recordW := 0
+		if s.config.ShowRecords.Load() && f.Record != "" {
+			w, err := writer.MeasureStrings(img, []string{f.Record})
+			if err != nil {
+				return nil, err
+			}
+			recordW = w[0] + 2
+			if err := writer.WriteAligned(rgbrender.RightTop, img, r, []string{f.Record}, loserColor); err != nil {
+				return nil, err
+			}
+		}
+
+		name, err := truncate(writer, img, lastName(f.Name), r.Dx()-recordW)
+		if err != nil {
+			return nil, err
+		}
+		if err := writer.WriteAligned(rgbrender.LeftTop, img, r, []string{name}, clr); err != nil {
+			return nil, err
+		}
+	}
+
+	return img, nil
+}
+
+// boutStatus returns the round and clock of a live bout, or the method and round of a finished one
+func boutStatus(bout *Bout) (string, color.Color) {
+	switch {
+	case bout.Live:
+		return fmt.Sprintf("R%d %s", bout.Round, bout.Clock), liveColor
+	case bout.Completed && bout.Result != "" && bout.Round > 0:
+		return fmt.Sprintf("%s R%d", bout.Result, bout.Round), color.White
+	case bout.Completed:
+		return bout.Result, color.White
+	}
+	return "", color.White
+}
+
+func segmentAbbreviation(segment string) string {
+	switch segment {
+	case MainCard:
+		return "MAIN"
+	case Prelims:
+		return "PRELIM"
+	case EarlyPrelims:
+		return "EARLY"
+	}
+	return segment
+}
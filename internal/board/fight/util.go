@@ -0,0 +1,61 @@
+package fightboard
+
+import (
+	"image"
+	"image/draw"
+	"strings"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+func (s *FightBoard) getWriter(bounds image.Rectangle) (*rgbrender.TextWriter, error) {
+	if s.writer != nil {
+		return s.writer, nil
+	}
+
+	var err error
+	s.writer, err = rgbrender.DefaultTextWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	if bounds.Dy() <= 256 {
+		s.writer.FontSize = 8.0
+		s.writer.YStartCorrection = -2
+	} else {
+		s.writer.FontSize = 0.25 * float64(bounds.Dy())
+		s.writer.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
+	}
+
+	if err := s.writer.Configure(s.config.Font); err != nil {
+		s.writer = nil
+		return nil, err
+	}
+
+	return s.writer, nil
+}
+
+// truncate shortens a string until it fits within the given pixel width
+func truncate(writer *rgbrender.TextWriter, img draw.Image, str string, width int) (string, error) {
+	r := []rune(str)
+	for len(r) > 0 {
+		w, err := writer.MeasureStrings(img, []string{string(r)})
+		if err != nil {
+			return "", err
+		}
+		if w[0] <= width {
+			break
+		}
+		r = r[:len(r)-1]
+	}
+
+	return string(r), nil
+}
+
+func lastName(name string) string {
+	parts := strings.Fields(name)
+	if len(parts) < 2 {
+		return name
+	}
+	return strings.Join(parts[1:], " ")
+}
@@ -0,0 +1,244 @@
+package golfboard
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+	"github.com/robbydyer/sports/internal/util"
+
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+)
+
+const defaultLimit = 10
+
+// GolfBoard shows a golf tournament leaderboard
+type GolfBoard struct {
+	config      *Config
+	api         API
+	log         *zap.Logger
+	writer      *rgbrender.TextWriter
+	tournament  *Tournament
+	lastUpdate  time.Time
+	rpcServer   pb.TwirpServer
+	boardCtx    context.Context
+	boardCancel context.CancelFunc
+	enabler     board.Enabler
+	sync.Mutex
+}
+
+// Config ...
+type Config struct {
+	boardDelay      time.Duration
+	updateInterval  time.Duration
+	StartEnabled    *atomic.Bool          `json:"enabled"`
+	BoardDelay      string                `json:"boardDelay"`
+	UpdateInterval  string                `json:"updateInterval"`
+	OnTimes         []string              `json:"onTimes"`
+	OffTimes        []string              `json:"offTimes"`
+	Limit           int                   `json:"limit"`
+	FavoritePlayers []string              `json:"favoritePlayers"`
+	ShowTeeTimes    *atomic.Bool          `json:"showTeeTimes"`
+	ShowScorecard   *atomic.Bool          `json:"showScorecard"`
+	Font            *rgbrender.FontConfig `json:"font"`
+}
+
+// API ...
+type API interface {
+	LeagueShortName() string
+	HTTPPathPrefix() string
+	GetTournament(ctx context.Context) (*Tournament, error)
+}
+
+// Tournament is the current event on a tour
+type Tournament struct {
+	Name  string
+	Round int
+	// CutLine is the cut score to par. Empty if there is no cut
+	CutLine string
+	Players []*Player
+}
+
+// Player is a golfer on the leaderboard
+type Player struct {
+	ID       string
+	Name     string
+	Position string
+	Order    int
+	// Score is the total score to par
+	Score string
+	Thru  string
+	// TeeTime is the player's tee time for the current round
+	TeeTime time.Time
+	// Movement is the number of positions gained in the current round. Negative is positions lost.
+	Movement int
+	Cut      bool
+	// Holes are the player's scores for the current round
+	Holes []*Hole
+}
+
+// Hole is a player's score on a single hole
+type Hole struct {
+	Number  int
+	Strokes int
+	// ToPar is the score relative to par, such as -1 for a birdie
+	ToPar int
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err != nil {
+			c.boardDelay = 10 * time.Second
+		} else {
+			c.boardDelay = d
+		}
+	} else {
+		c.boardDelay = 10 * time.Second
+	}
+
+	if c.UpdateInterval != "" {
+		d, err := time.ParseDuration(c.UpdateInterval)
+		if err != nil {
+			c.updateInterval = 2 * time.Minute
+		} else {
+			c.updateInterval = d
+		}
+	} else {
+		c.updateInterval = 2 * time.Minute
+	}
+
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.ShowTeeTimes == nil {
+		c.ShowTeeTimes = atomic.NewBool(true)
+	}
+	if c.ShowScorecard == nil {
+		c.ShowScorecard = atomic.NewBool(true)
+	}
+	if c.Limit == 0 {
+		c.Limit = defaultLimit
+	}
+}
+
+// New ...
+func New(api API, logger *zap.Logger, config *Config) (*GolfBoard, error) {
+	s := &GolfBoard{
+		config:  config,
+		api:     api,
+		log:     logger,
+		enabler: enabler.New(),
+	}
+
+	if config.StartEnabled.Load() {
+		s.enabler.Enable()
+	}
+
+	s.log.Info("Register Golf Board",
+		zap.String("league", api.LeagueShortName()),
+		zap.String("board name", s.Name()),
+	)
+
+	if err := util.SetCrons(config.OnTimes, func() {
+		s.log.Info("golfboard turning on")
+		s.Enabler().Enable()
+	}); err != nil {
+		return nil, err
+	}
+	if err := util.SetCrons(config.OffTimes, func() {
+		s.log.Info("golfboard turning off")
+		board.DisableScheduled(s.Enabler())
+	}); err != nil {
+		return nil, err
+	}
+
+	svr := &Server{
+		board: s,
+	}
+	prfx := s.api.HTTPPathPrefix()
+	if !strings.HasPrefix(prfx, "/") {
+		prfx = fmt.Sprintf("/%s", prfx)
+	}
+	s.rpcServer = pb.NewBasicBoardServer(svr,
+		twirp.WithServerPathPrefix(fmt.Sprintf("/golf%s", prfx)),
+		twirp.ChainHooks(
+			twirphelpers.GetDefaultHooks(s, s.log),
+		),
+	)
+
+	return s, nil
+}
+
+// Name ...
+func (s *GolfBoard) Name() string {
+	return fmt.Sprintf("Golf: %s", s.api.LeagueShortName())
+}
+
+// Enabler ...
+func (s *GolfBoard) Enabler() board.Enabler {
+	return s.enabler
+}
+
+// InBetween ...
+func (s *GolfBoard) InBetween() bool {
+	return false
+}
+
+// ScrollMode ...
+func (s *GolfBoard) ScrollMode() bool {
+	return false
+}
+
+// GetHTTPHandlers ...
+func (s *GolfBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+// GetRPCHandler ...
+func (s *GolfBoard) GetRPCHandler() (string, http.Handler) {
+	return s.rpcServer.PathPrefix(), s.rpcServer
+}
+
+// Started returns true once any player has started the current round
+func (t *Tournament) Started() bool {
+	for _, p := range t.Players {
+		if p.Thru != "" && p.Thru != "0" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LastName ...
+func (p *Player) LastName() string {
+	parts := strings.Fields(p.Name)
+	if len(parts) < 2 {
+		return p.Name
+	}
+	return strings.Join(parts[1:], " ")
+}
+
+// isFavorite returns true if the player's full or last name matches a favorite
+func (s *GolfBoard) isFavorite(p *Player) bool {
+	for _, fav := range s.config.FavoritePlayers {
+		if strings.EqualFold(fav, p.Name) || strings.EqualFold(fav, p.LastName()) {
+			return true
+		}
+	}
+
+	return false
+}
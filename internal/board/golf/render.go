@@ -0,0 +1,547 @@
+package golfboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/locale"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	titleColor  = color.RGBA{255, 255, 0, 255}
+	cutColor    = color.RGBA{100, 100, 100, 255}
+	underColor  = color.RGBA{255, 0, 0, 255}
+	overColor   = color.RGBA{0, 255, 0, 255}
+	eagleColor  = color.RGBA{255, 255, 0, 255}
+	upColor     = color.RGBA{0, 255, 0, 255}
+	downColor   = color.RGBA{255, 0, 0, 255}
+	unplayedClr = color.RGBA{100, 100, 100, 255}
+)
+
+type tableRow struct {
+	movement   int
+	position   string
+	name       string
+	score      string
+	thru       string
+	color      color.Color
+	scoreColor color.Color
+}
+
+// Render ...
+//
+//nolint:contextcheck
+func (s *GolfBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	s.boardCtx, s.boardCancel = context.WithCancel(ctx)
+
+	pages, err := s.pages(s.boardCtx, canvas.Bounds())
+	if err != nil {
+		return err
+	}
+
+PAGES:
+	for _, page := range pages {
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		default:
+		}
+
+		draw.Draw(canvas, page.Bounds(), page, image.Point{}, draw.Over)
+		board.DrawStaleMarker(s.api, canvas, rgbrender.ZeroedBounds(canvas.Bounds()))
+
+		if err := canvas.Render(s.boardCtx); err != nil {
+			s.log.Error("failed to render golf board",
+				zap.Error(err),
+			)
+			continue PAGES
+		}
+
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		case <-time.After(s.config.boardDelay):
+		}
+	}
+
+	return nil
+}
+
+// ScrollRender renders each page of the leaderboard as a cell of a ScrollCanvas
+func (s *GolfBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	pages, err := s.pages(ctx, canvas.Bounds())
+	if err != nil {
+		return nil, err
+	}
+	if len(pages) < 1 {
+		return nil, nil
+	}
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.Name()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages {
+		scrollCanvas.AddCanvas(page)
+	}
+
+	go scrollCanvas.MatchScroll(ctx, base)
+
+	return scrollCanvas, nil
+}
+
+// updateTournament refreshes the tournament once the update interval has passed. A stale
+// tournament is kept if the refresh fails.
+func (s *GolfBoard) updateTournament(ctx context.Context) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.tournament != nil && time.Since(s.lastUpdate) < s.config.updateInterval {
+		return nil
+	}
+
+	t, err := s.api.GetTournament(ctx)
+	if err != nil {
+		if s.tournament != nil {
+			s.log.Error("failed to update golf tournament, using cached",
+				zap.String("league", s.api.LeagueShortName()),
+				zap.Error(err),
+			)
+			return nil
+		}
+		return err
+	}
+
+	s.tournament = t
+	s.lastUpdate = time.Now()
+
+	return nil
+}
+
+// pages returns the tee times before play starts, or the leaderboard followed by favorite players' scorecards
+func (s *GolfBoard) pages(ctx context.Context, bounds image.Rectangle) ([]draw.Image, error) {
+	if err := s.updateTournament(ctx); err != nil {
+		return nil, err
+	}
+
+	s.Lock()
+	t := s.tournament
+	s.Unlock()
+
+	if t == nil || len(t.Players) < 1 {
+		return nil, nil
+	}
+
+	writer, err := s.getWriter(rgbrender.ZeroedBounds(bounds))
+	if err != nil {
+		return nil, err
+	}
+
+	title := t.Name
+	if t.Round > 0 {
+		title = fmt.Sprintf("%s R%d", t.Name, t.Round)
+	}
+
+	if !t.Started() && s.config.ShowTeeTimes.Load() {
+		rows := teeTimeRows(t.Players, s.config.Limit)
+		if len(rows) > 0 {
+			return s.renderTable(bounds, writer, title, "", rows)
+		}
+	}
+
+	cut := ""
+	if t.CutLine != "" {
+		cut = fmt.Sprintf("CUT %s", t.CutLine)
+	}
+
+	pages, err := s.renderTable(bounds, writer, title, cut, leaderboardRows(t.Players, s.config.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.config.ShowScorecard.Load() {
+		return pages, nil
+	}
+
+	for _, p := range t.Players {
+		if !s.isFavorite(p) || len(p.Holes) < 1 {
+			continue
+		}
+		img, err := s.renderScorecard(bounds, writer, p)
+		if err != nil {
+			s.log.Error("failed to render golf scorecard",
+				zap.String("player", p.Name),
+				zap.Error(err),
+			)
+			continue
+		}
+		pages = append(pages, img)
+	}
+
+	return pages, nil
+}
+
+func leaderboardRows(players []*Player, limit int) []*tableRow {
+	sorted := make([]*Player, len(players))
+	copy(sorted, players)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Order < sorted[j].Order
+	})
+
+	rows := []*tableRow{}
+	for _, p := range sorted {
+		if limit > 0 && len(rows) >= limit {
+			break
+		}
+		row := &tableRow{
+			movement:   p.Movement,
+			position:   p.Position,
+			name:       p.LastName(),
+			score:      p.Score,
+			thru:       p.Thru,
+			color:      color.White,
+			scoreColor: scoreColor(p.Score),
+		}
+		if p.Cut {
+			row.movement = 0
+			row.color = cutColor
+			row.scoreColor = cutColor
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func teeTimeRows(players []*Player, limit int) []*tableRow {
+	sorted := []*Player{}
+	for _, p := range players {
+		if !p.TeeTime.IsZero() {
+			sorted = append(sorted, p)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TeeTime.Before(sorted[j].TeeTime)
+	})
+
+	rows := []*tableRow{}
+	for _, p := range sorted {
+		if limit > 0 && len(rows) >= limit {
+			break
+		}
+		rows = append(rows, &tableRow{
+			position:   locale.Current().Time(p.TeeTime),
+			name:       p.LastName(),
+			color:      color.White,
+			scoreColor: color.White,
+		})
+	}
+
+	return rows
+}
+
+// renderTable splits rows into as many pages as needed to fit the canvas. Each page starts with
+// the title on the left and titleRight on the right.
+func (s *GolfBoard) renderTable(bounds image.Rectangle, writer *rgbrender.TextWriter, title string, titleRight string, rows []*tableRow) ([]draw.Image, error) {
+	canvasBounds := rgbrender.ZeroedBounds(bounds)
+
+	lineHeight := int(math.Ceil(writer.FontSize))
+	rowsPerPage := (canvasBounds.Dy() / lineHeight) - 1
+	if rowsPerPage < 1 {
+		return nil, fmt.Errorf("canvas too small for golf leaderboard")
+	}
+
+	arrowW := lineHeight / 2
+	hasMovement := false
+	for _, r := range rows {
+		if r.movement != 0 {
+			hasMovement = true
+			break
+		}
+	}
+
+	var pages []draw.Image
+
+	for start := 0; start < len(rows); start += rowsPerPage {
+		end := start + rowsPerPage
+		if end > len(rows) {
+			end = len(rows)
+		}
+		page := rows[start:end]
+
+		img := image.NewRGBA(bounds)
+
+		var positions, scores, thrus []string
+		for _, r := range page {
+			positions = append(positions, r.position)
+			scores = append(scores, r.score)
+			thrus = append(thrus, r.thru)
+		}
+
+		posWidths, err := writer.MeasureStrings(img, positions)
+		if err != nil {
+			return nil, err
+		}
+		scoreWidths, err := writer.MeasureStrings(img, scores)
+		if err != nil {
+			return nil, err
+		}
+		thruWidths, err := writer.MeasureStrings(img, thrus)
+		if err != nil {
+			return nil, err
+		}
+
+		posX := canvasBounds.Min.X
+		if hasMovement {
+			posX += arrowW + 1
+		}
+		nameX := posX + maxWidth(posWidths) + 2
+		thruX := canvasBounds.Max.X - maxWidth(thruWidths)
+		scoreX := thruX - maxWidth(scoreWidths) - 2
+
+		rightW := 0
+		if titleRight != "" {
+			w, err := writer.MeasureStrings(img, []string{titleRight})
+			if err != nil {
+				return nil, err
+			}
+			rightW = w[0] + 2
+			if err := writer.WriteAligned(
+				rgbrender.RightTop,
+				img,
+				image.Rect(canvasBounds.Min.X, canvasBounds.Min.Y, canvasBounds.Max.X, canvasBounds.Min.Y+lineHeight),
+				[]string{titleRight},
+				cutColor,
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		t, err := truncate(writer, img, title, canvasBounds.Dx()-rightW)
+		if err != nil {
+			return nil, err
+		}
+		if err := writer.WriteAligned(
+			rgbrender.LeftTop,
+			img,
+			image.Rect(canvasBounds.Min.X, canvasBounds.Min.Y, canvasBounds.Max.X-rightW, canvasBounds.Min.Y+lineHeight),
+			[]string{t},
+			titleColor,
+		); err != nil {
+			return nil, err
+		}
+
+		for i, r := range page {
+			y := canvasBounds.Min.Y + ((i + 1) * lineHeight)
+
+			drawMovement(img, image.Rect(canvasBounds.Min.X, y, canvasBounds.Min.X+arrowW, y+lineHeight), r.movement)
+
+			if err := writer.WriteAligned(
+				rgbrender.RightTop,
+				img,
+				image.Rect(posX, y, nameX-2, y+lineHeight),
+				[]string{r.position},
+				r.color,
+			); err != nil {
+				return nil, err
+			}
+
+			name, err := truncate(writer, img, r.name, scoreX-nameX-1)
+			if err != nil {
+				return nil, err
+			}
+			if err := writer.WriteAligned(
+				rgbrender.LeftTop,
+				img,
+				image.Rect(nameX, y, scoreX, y+lineHeight),
+				[]string{name},
+				r.color,
+			); err != nil {
+				return nil, err
+			}
+			if err := writer.WriteAligned(
+				rgbrender.RightTop,
+				img,
+				image.Rect(scoreX, y, thruX-2, y+lineHeight),
+				[]string{r.score},
+				r.scoreColor,
+			); err != nil {
+				return nil, err
+			}
+			if err := writer.WriteAligned(
+				rgbrender.RightTop,
+				img,
+				image.Rect(thruX, y, canvasBounds.Max.X, y+lineHeight),
+				[]string{r.thru},
+				r.color,
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		pages = append(pages, img)
+	}
+
+	return pages, nil
+}
+
+// renderScorecard shows a player's current round hole by hole, front nine over back nine
+func (s *GolfBoard) renderScorecard(bounds image.Rectangle, writer *rgbrender.TextWriter, p *Player) (draw.Image, error) {
+	canvasBounds := rgbrender.ZeroedBounds(bounds)
+	lineHeight := int(math.Ceil(writer.FontSize))
+	if canvasBounds.Dy() < lineHeight*3 {
+		return nil, fmt.Errorf("canvas too small for golf scorecard")
+	}
+
+	img := image.NewRGBA(bounds)
+
+	titleBounds := image.Rect(canvasBounds.Min.X, canvasBounds.Min.Y, canvasBounds.Max.X, canvasBounds.Min.Y+lineHeight)
+
+	right := p.Score
+	if p.Thru != "" {
+		right = fmt.Sprintf("%s %s", p.Score, p.Thru)
+	}
+	w, err := writer.MeasureStrings(img, []string{right})
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.WriteAligned(rgbrender.RightTop, img, titleBounds, []string{right}, scoreColor(p.Score)); err != nil {
+		return nil, err
+	}
+
+	name, err := truncate(writer, img, p.LastName(), canvasBounds.Dx()-w[0]-2)
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.WriteAligned(rgbrender.LeftTop, img, titleBounds, []string{name}, titleColor); err != nil {
+		return nil, err
+	}
+
+	strokes := map[int]*Hole{}
+	for _, h := range p.Holes {
+		strokes[h.Number] = h
+	}
+
+	cellW := canvasBounds.Dx() / 9
+
+	for hole := 1; hole <= 18; hole++ {
+		col := (hole - 1) % 9
+		row := (hole - 1) / 9
+		x := canvasBounds.Min.X + (col * cellW)
+		y := canvasBounds.Min.Y + ((row + 1) * lineHeight)
+
+		txt := "-"
+		clr := color.Color(unplayedClr)
+		if h, ok := strokes[hole]; ok && h.Strokes > 0 {
+			txt = fmt.Sprint(h.Strokes)
+			clr = holeColor(h.ToPar)
+		}
+
+		if err := writer.WriteAligned(
+			rgbrender.CenterTop,
+			img,
+			image.Rect(x, y, x+cellW, y+lineHeight),
+			[]string{txt},
+			clr,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return img, nil
+}
+
+// drawMovement draws an up or down arrow for positions gained or lost
+func drawMovement(img draw.Image, bounds image.Rectangle, movement int) {
+	w := bounds.Dx()
+	if w < 2 {
+		return
+	}
+	midY := bounds.Min.Y + (bounds.Dy() / 2)
+
+	switch {
+	case movement > 0:
+		rgbrender.DrawUpTriangle(img, image.Pt(bounds.Min.X, midY+(w/4)), w, 0, upColor, upColor)
+	case movement < 0:
+		rgbrender.DrawDownTriangle(img, image.Pt(bounds.Min.X, midY-(w/4)), w, 0, downColor, downColor)
+	}
+}
+
+// truncate shortens a string until it fits within the given pixel width
+func truncate(writer *rgbrender.TextWriter, img draw.Image, str string, width int) (string, error) {
+	r := []rune(str)
+	for len(r) > 0 {
+		w, err := writer.MeasureStrings(img, []string{string(r)})
+		if err != nil {
+			return "", err
+		}
+		if w[0] <= width {
+			break
+		}
+		r = r[:len(r)-1]
+	}
+
+	return string(r), nil
+}
+
+// scoreColor matches the PGA stat board: red under par, green over par
+func scoreColor(score string) color.Color {
+	i := toPar(score)
+	switch {
+	case i < 0:
+		return underColor
+	case i > 0:
+		return overColor
+	}
+	return color.White
+}
+
+func holeColor(toPar int) color.Color {
+	switch {
+	case toPar <= -2:
+		return eagleColor
+	case toPar < 0:
+		return underColor
+	case toPar > 0:
+		return overColor
+	}
+	return color.White
+}
+
+func toPar(score string) int {
+	var i int
+	if _, err := fmt.Sscanf(score, "%d", &i); err != nil {
+		return 0
+	}
+	return i
+}
+
+func maxWidth(widths []int) int {
+	m := 0
+	for _, w := range widths {
+		if w > m {
+			m = w
+		}
+	}
+	return m
+}
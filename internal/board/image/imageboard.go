@@ -21,6 +21,7 @@ import (
 
 	"github.com/robbydyer/sports/internal/board"
 	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/metrics"
 	pb "github.com/robbydyer/sports/internal/proto/imageboard"
 	"github.com/robbydyer/sports/internal/rgbrender"
 	"github.com/robbydyer/sports/internal/twirphelpers"
@@ -150,7 +151,7 @@ func New(config *Config, logger *zap.Logger) (*ImageBoard, error) {
 	}
 	if err := util.SetCrons(config.OffTimes, func() {
 		i.log.Info("imageboard turning off")
-		i.Enabler().Disable()
+		board.DisableScheduled(i.Enabler())
 	}); err != nil {
 		return nil, err
 	}
@@ -523,6 +524,7 @@ func (i *ImageBoard) getSizedImage(path string, bounds image.Rectangle, preloade
 				zap.Int("X", bounds.Dx()),
 				zap.Int("Y", bounds.Dy()),
 			)
+			metrics.CacheHit("image")
 			return p, nil
 		}
 	}
@@ -544,10 +546,13 @@ func (i *ImageBoard) getSizedImage(path string, bounds image.Rectangle, preloade
 			if i.config.UseMemCache.Load() {
 				i.imageCache[key] = img
 			}
+			metrics.CacheHit("image")
 			return img, nil
 		}
 	}
 
+	metrics.CacheMiss("image")
+
 	img, err := imaging.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open image: %w", err)
@@ -601,6 +606,7 @@ func (i *ImageBoard) getSizedGIF(ctx context.Context, path string, bounds image.
 				zap.Int("X", bounds.Dx()),
 				zap.Int("Y", bounds.Dy()),
 			)
+			metrics.CacheHit("gif")
 			return p, nil
 		}
 	}
@@ -617,11 +623,14 @@ func (i *ImageBoard) getSizedGIF(ctx context.Context, path string, bounds image.
 			if i.config.UseMemCache.Load() {
 				i.setGIFCache(key, g)
 			}
+			metrics.CacheHit("gif")
 
 			return g, nil
 		}
 	}
 
+	metrics.CacheMiss("gif")
+
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
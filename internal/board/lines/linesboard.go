@@ -0,0 +1,280 @@
+package linesboard
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+	"github.com/robbydyer/sports/internal/util"
+
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+)
+
+// LinesBoard shows the betting lines of the day's games
+type LinesBoard struct {
+	config      *Config
+	api         API
+	log         *zap.Logger
+	writer      *rgbrender.TextWriter
+	lines       []*Line
+	lastUpdate  time.Time
+	rpcServer   pb.TwirpServer
+	boardCtx    context.Context
+	boardCancel context.CancelFunc
+	enabler     board.Enabler
+	sync.Mutex
+}
+
+// Config ...
+type Config struct {
+	boardDelay         time.Duration
+	updateInterval     time.Duration
+	StartEnabled       *atomic.Bool          `json:"enabled"`
+	BoardDelay         string                `json:"boardDelay"`
+	UpdateInterval     string                `json:"updateInterval"`
+	OnTimes            []string              `json:"onTimes"`
+	OffTimes           []string              `json:"offTimes"`
+	ShowWinProbability *atomic.Bool          `json:"showWinProbability"`
+	ShowMovement       *atomic.Bool          `json:"showMovement"`
+	Enable24Hour       *atomic.Bool          `json:"enable24Hour"`
+	FavoriteTeams      []string              `json:"favoriteTeams"`
+	Font               *rgbrender.FontConfig `json:"font"`
+}
+
+// API ...
+type API interface {
+	League() string
+	HTTPPathPrefix() string
+	// GetLines gets the lines of the games on the given day. Games without lines are skipped.
+	GetLines(ctx context.Context, day time.Time) ([]*Line, error)
+}
+
+// Line is a game's betting lines
+type Line struct {
+	Provider string
+	Start    time.Time
+	Live     bool
+	Complete bool
+	// Period is the live game's period, such as "Q3"
+	Period string
+	Clock  string
+	Away   *TeamLine
+	Home   *TeamLine
+	// Total is the over/under. Zero if there isn't one.
+	Total     float64
+	OpenTotal *float64
+	// HomeWinProbability is the home team's chance of winning, from 0 to 1. Negative if unknown.
+	HomeWinProbability float64
+}
+
+// TeamLine is a team's side of a game's lines
+type TeamLine struct {
+	ID           string
+	Abbreviation string
+	Color        color.Color
+	Favorite     bool
+	// Spread is the team's point spread, such as -3.5 for a favorite
+	Spread     float64
+	OpenSpread *float64
+	// Moneyline is the American odds, such as -180 or +150. Zero if there isn't one.
+	Moneyline     float64
+	OpenMoneyline *float64
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err != nil {
+			c.boardDelay = 10 * time.Second
+		} else {
+			c.boardDelay = d
+		}
+	} else {
+		c.boardDelay = 10 * time.Second
+	}
+
+	if c.UpdateInterval != "" {
+		d, err := time.ParseDuration(c.UpdateInterval)
+		if err != nil {
+			c.updateInterval = 5 * time.Minute
+		} else {
+			c.updateInterval = d
+		}
+	} else {
+		c.updateInterval = 5 * time.Minute
+	}
+
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.ShowWinProbability == nil {
+		c.ShowWinProbability = atomic.NewBool(true)
+	}
+	if c.ShowMovement == nil {
+		c.ShowMovement = atomic.NewBool(true)
+	}
+}
+
+// New ...
+func New(api API, logger *zap.Logger, config *Config) (*LinesBoard, error) {
+	s := &LinesBoard{
+		config:  config,
+		api:     api,
+		log:     logger,
+		enabler: enabler.New(),
+	}
+
+	if config.StartEnabled.Load() {
+		s.enabler.Enable()
+	}
+
+	s.log.Info("Register Lines Board",
+		zap.String("league", api.League()),
+		zap.String("board name", s.Name()),
+	)
+
+	if err := util.SetCrons(config.OnTimes, func() {
+		s.log.Info("linesboard turning on")
+		s.Enabler().Enable()
+	}); err != nil {
+		return nil, err
+	}
+	if err := util.SetCrons(config.OffTimes, func() {
+		s.log.Info("linesboard turning off")
+		board.DisableScheduled(s.Enabler())
+	}); err != nil {
+		return nil, err
+	}
+
+	svr := &Server{
+		board: s,
+	}
+	prfx := s.api.HTTPPathPrefix()
+	if !strings.HasPrefix(prfx, "/") {
+		prfx = fmt.Sprintf("/%s", prfx)
+	}
+	s.rpcServer = pb.NewBasicBoardServer(svr,
+		twirp.WithServerPathPrefix(fmt.Sprintf("/lines%s", prfx)),
+		twirp.ChainHooks(
+			twirphelpers.GetDefaultHooks(s, s.log),
+		),
+	)
+
+	return s, nil
+}
+
+// Name ...
+func (s *LinesBoard) Name() string {
+	return fmt.Sprintf("Lines: %s", s.api.League())
+}
+
+// Enabler ...
+func (s *LinesBoard) Enabler() board.Enabler {
+	return s.enabler
+}
+
+// InBetween ...
+func (s *LinesBoard) InBetween() bool {
+	return false
+}
+
+// ScrollMode ...
+func (s *LinesBoard) ScrollMode() bool {
+	return false
+}
+
+// GetHTTPHandlers ...
+func (s *LinesBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+// GetRPCHandler ...
+func (s *LinesBoard) GetRPCHandler() (string, http.Handler) {
+	return s.rpcServer.PathPrefix(), s.rpcServer
+}
+
+func (s *LinesBoard) isFavorite(l *Line) bool {
+	for _, fav := range s.config.FavoriteTeams {
+		for _, t := range []*TeamLine{l.Away, l.Home} {
+			if strings.EqualFold(fav, t.Abbreviation) || fav == t.ID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// SpreadMovement is how far the spread has moved since the open
+func (t *TeamLine) SpreadMovement() float64 {
+	return Movement(t.OpenSpread, t.Spread)
+}
+
+// MoneylineMovement is how far the moneyline has moved since the open
+func (t *TeamLine) MoneylineMovement() float64 {
+	return Movement(t.OpenMoneyline, t.Moneyline)
+}
+
+// TotalMovement is how far the over/under has moved since the open
+func (l *Line) TotalMovement() float64 {
+	if l.Total == 0 {
+		return 0
+	}
+	return Movement(l.OpenTotal, l.Total)
+}
+
+// Movement is the change from an opening line. Zero if the opening line is unknown.
+func Movement(open *float64, current float64) float64 {
+	if open == nil {
+		return 0
+	}
+	return current - *open
+}
+
+// FormatSpread formats a point spread, such as "-3.5", "+7" or "PK" for a pick'em
+func FormatSpread(spread float64) string {
+	if spread == 0 {
+		return "PK"
+	}
+	s := strconv.FormatFloat(spread, 'f', -1, 64)
+	if spread > 0 {
+		return "+" + s
+	}
+	return s
+}
+
+// FormatMoneyline formats American odds, such as "-180", "+150" or "EVEN"
+func FormatMoneyline(ml float64) string {
+	switch {
+	case ml == 0:
+		return ""
+	case math.Abs(ml) == 100:
+		return "EVEN"
+	case ml > 0:
+		return fmt.Sprintf("+%d", int(ml))
+	}
+	return fmt.Sprint(int(ml))
+}
+
+// FormatTotal formats an over/under, such as "47.5"
+func FormatTotal(total float64) string {
+	if total == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(total, 'f', -1, 64)
+}
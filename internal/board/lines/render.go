@@ -0,0 +1,356 @@
+package linesboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/locale"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	statusColor   = color.RGBA{100, 100, 100, 255}
+	favoriteColor = color.RGBA{0, 255, 255, 255}
+	upColor       = color.RGBA{0, 255, 0, 255}
+	downColor     = color.RGBA{255, 0, 0, 255}
+	awayBarColor  = color.RGBA{0, 0, 255, 255}
+	homeBarColor  = color.RGBA{255, 0, 0, 255}
+)
+
+// Render ...
+//
+//nolint:contextcheck
+func (s *LinesBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	s.boardCtx, s.boardCancel = context.WithCancel(ctx)
+
+	pages, err := s.pages(s.boardCtx, canvas.Bounds())
+	if err != nil {
+		return err
+	}
+
+PAGES:
+	for _, page := range pages {
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		default:
+		}
+
+		draw.Draw(canvas, page.Bounds(), page, image.Point{}, draw.Over)
+
+		if err := canvas.Render(s.boardCtx); err != nil {
+			s.log.Error("failed to render lines board",
+				zap.Error(err),
+			)
+			continue PAGES
+		}
+
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		case <-time.After(s.config.boardDelay):
+		}
+	}
+
+	return nil
+}
+
+// ScrollRender renders each game's lines as a cell of a ScrollCanvas
+func (s *LinesBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	pages, err := s.pages(ctx, canvas.Bounds())
+	if err != nil {
+		return nil, err
+	}
+	if len(pages) < 1 {
+		return nil, nil
+	}
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.Name()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages {
+		scrollCanvas.AddCanvas(page)
+	}
+
+	go scrollCanvas.MatchScroll(ctx, base)
+
+	return scrollCanvas, nil
+}
+
+// updateLines refreshes the day's lines once the update interval has passed. Stale lines are
+// kept if the refresh fails.
+func (s *LinesBoard) updateLines(ctx context.Context) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.lines != nil && time.Since(s.lastUpdate) < s.config.updateInterval {
+		return nil
+	}
+
+	lines, err := s.api.GetLines(ctx, time.Now())
+	if err != nil {
+		if s.lines != nil {
+			s.log.Error("failed to update lines, using cached",
+				zap.String("league", s.api.League()),
+				zap.Error(err),
+			)
+			return nil
+		}
+		return err
+	}
+
+	s.lines = lines
+	s.lastUpdate = time.Now()
+
+	return nil
+}
+
+// pages returns a page for each game that hasn't finished, favorite teams first
+func (s *LinesBoard) pages(ctx context.Context, bounds image.Rectangle) ([]draw.Image, error) {
+	if err := s.updateLines(ctx); err != nil {
+		return nil, err
+	}
+
+	s.Lock()
+	lines := []*Line{}
+	for _, l := range s.lines {
+		if !l.Complete && l.Home != nil && l.Away != nil {
+			lines = append(lines, l)
+		}
+	}
+	s.Unlock()
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return s.isFavorite(lines[i]) && !s.isFavorite(lines[j])
+	})
+
+	writer, err := s.getWriter(rgbrender.ZeroedBounds(bounds))
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []draw.Image
+	for _, l := range lines {
+		img, err := s.renderLine(bounds, writer, l)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, img)
+	}
+
+	return pages, nil
+}
+
+// renderLine shows a game's status and over/under, then each team's spread and moneyline, then
+// the win probability of a live game
+func (s *LinesBoard) renderLine(bounds image.Rectangle, writer *rgbrender.TextWriter, l *Line) (draw.Image, error) {
+	canvasBounds := rgbrender.ZeroedBounds(bounds)
+	lineHeight := int(math.Ceil(writer.FontSize))
+	if canvasBounds.Dy() < lineHeight*3 {
+		return nil, fmt.Errorf("canvas too small for lines board")
+	}
+	arrowW := lineHeight / 2
+
+	img := image.NewRGBA(bounds)
+
+	clr := color.Color(color.White)
+	if s.isFavorite(l) {
+		clr = favoriteColor
+	}
+
+	rowBounds := func(row int) image.Rectangle {
+		y := canvasBounds.Min.Y + (row * lineHeight)
+		return image.Rect(canvasBounds.Min.X, y, canvasBounds.Max.X, y+lineHeight)
+	}
+
+	statusW := canvasBounds.Dx()
+	if total := FormatTotal(l.Total); total != "" {
+		total = fmt.Sprintf("O/U %s", total)
+		r := rowBounds(0)
+		r.Max.X -= arrowW + 1
+		if err := writer.WriteAligned(rgbrender.RightTop, img, r, []string{total}, clr); err != nil {
+			return nil, err
+		}
+		s.drawMovement(img, image.Rect(r.Max.X+1, r.Min.Y, r.Max.X+1+arrowW, r.Max.Y), l.TotalMovement())
+
+		w, err := writer.MeasureStrings(img, []string{total})
+		if err != nil {
+			return nil, err
+		}
+		statusW -= w[0] + arrowW + 3
+	}
+
+	status, err := truncate(writer, img, s.status(l), statusW)
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.WriteAligned(rgbrender.LeftTop, img, rowBounds(0), []string{status}, statusColor); err != nil {
+		return nil, err
+	}
+
+	moneylines := []string{FormatMoneyline(l.Away.Moneyline), FormatMoneyline(l.Home.Moneyline)}
+	spreads := []string{FormatSpread(l.Away.Spread), FormatSpread(l.Home.Spread)}
+
+	mlWidths, err := writer.MeasureStrings(img, moneylines)
+	if err != nil {
+		return nil, err
+	}
+	spreadWidths, err := writer.MeasureStrings(img, spreads)
+	if err != nil {
+		return nil, err
+	}
+
+	mlX := canvasBounds.Max.X - arrowW - 1 - maxWidth(mlWidths)
+	spreadX := mlX - 2 - arrowW - 1 - maxWidth(spreadWidths)
+
+	for i, t := range []*TeamLine{l.Away, l.Home} {
+		r := rowBounds(i + 1)
+
+		if err := writer.WriteAligned(rgbrender.LeftTop, img, image.Rect(r.Min.X, r.Min.Y, spreadX, r.Max.Y), []string{t.Abbreviation}, clr); err != nil {
+			return nil, err
+		}
+
+		spreadEnd := mlX - 2 - arrowW - 1
+		if err := writer.WriteAligned(rgbrender.RightTop, img, image.Rect(spreadX, r.Min.Y, spreadEnd, r.Max.Y), []string{spreads[i]}, clr); err != nil {
+			return nil, err
+		}
+		s.drawMovement(img, image.Rect(spreadEnd+1, r.Min.Y, spreadEnd+1+arrowW, r.Max.Y), t.SpreadMovement())
+
+		mlEnd := canvasBounds.Max.X - arrowW - 1
+		if err := writer.WriteAligned(rgbrender.RightTop, img, image.Rect(mlX, r.Min.Y, mlEnd, r.Max.Y), []string{moneylines[i]}, clr); err != nil {
+			return nil, err
+		}
+		s.drawMovement(img, image.Rect(mlEnd+1, r.Min.Y, mlEnd+1+arrowW, r.Max.Y), t.MoneylineMovement())
+	}
+
+	if l.Live && l.HomeWinProbability >= 0 && s.config.ShowWinProbability.Load() && canvasBounds.Dy() >= lineHeight*4 {
+		if err := s.renderWinProbability(img, writer, rowBounds(3), l); err != nil {
+			return nil, err
+		}
+	}
+
+	return img, nil
+}
+
+// renderWinProbability writes each team's chance of winning on either side of a probability bar
+func (s *LinesBoard) renderWinProbability(img draw.Image, writer *rgbrender.TextWriter, bounds image.Rectangle, l *Line) error {
+	away := fmt.Sprintf("%d%%", int(math.Round((1-l.HomeWinProbability)*100)))
+	home := fmt.Sprintf("%d%%", int(math.Round(l.HomeWinProbability*100)))
+
+	widths, err := writer.MeasureStrings(img, []string{away, home})
+	if err != nil {
+		return err
+	}
+
+	if err := writer.WriteAligned(rgbrender.LeftTop, img, bounds, []string{away}, color.White); err != nil {
+		return err
+	}
+	if err := writer.WriteAligned(rgbrender.RightTop, img, bounds, []string{home}, color.White); err != nil {
+		return err
+	}
+
+	barH := bounds.Dy() / 2
+	barY := bounds.Min.Y + ((bounds.Dy() - barH) / 2)
+	bar := image.Rect(bounds.Min.X+widths[0]+2, barY, bounds.Max.X-widths[1]-2, barY+barH)
+
+	DrawWinProbability(img, bar, 1-l.HomeWinProbability, teamColor(l.Away, awayBarColor), teamColor(l.Home, homeBarColor))
+
+	return nil
+}
+
+func (s *LinesBoard) status(l *Line) string {
+	if l.Live {
+		if l.Clock == "" {
+			return strings.ReplaceAll(l.Period, " - ", " ")
+		}
+		return fmt.Sprintf("%s %s", l.Period, l.Clock)
+	}
+	return locale.Current().With24Hour(s.config.Enable24Hour).Time(l.Start)
+}
+
+// drawMovement draws an up or down arrow for a line that has moved since the open
+func (s *LinesBoard) drawMovement(img draw.Image, bounds image.Rectangle, movement float64) {
+	if !s.config.ShowMovement.Load() {
+		return
+	}
+	w := bounds.Dx()
+	if w < 2 {
+		return
+	}
+	midY := bounds.Min.Y + (bounds.Dy() / 2)
+
+	switch {
+	case movement > 0:
+		rgbrender.DrawUpTriangle(img, image.Pt(bounds.Min.X, midY+(w/4)), w, 0, upColor, upColor)
+	case movement < 0:
+		rgbrender.DrawDownTriangle(img, image.Pt(bounds.Min.X, midY-(w/4)), w, 0, downColor, downColor)
+	}
+}
+
+// DrawWinProbability draws a bar split between the left team's chance of winning, from 0 to 1,
+// and the right team's
+func DrawWinProbability(img draw.Image, bounds image.Rectangle, leftProbability float64, leftColor color.Color, rightColor color.Color) {
+	if bounds.Dx() < 2 || bounds.Dy() < 1 {
+		return
+	}
+	leftProbability = math.Max(0, math.Min(1, leftProbability))
+	split := bounds.Min.X + int(math.Round(leftProbability*float64(bounds.Dx())))
+
+	draw.Draw(img, image.Rect(bounds.Min.X, bounds.Min.Y, split, bounds.Max.Y), image.NewUniform(leftColor), image.Point{}, draw.Over)
+	draw.Draw(img, image.Rect(split, bounds.Min.Y, bounds.Max.X, bounds.Max.Y), image.NewUniform(rightColor), image.Point{}, draw.Over)
+}
+
+func teamColor(t *TeamLine, fallback color.Color) color.Color {
+	if t.Color == nil {
+		return fallback
+	}
+	return t.Color
+}
+
+// truncate shortens a string until it fits within the given pixel width
+func truncate(writer *rgbrender.TextWriter, img draw.Image, str string, width int) (string, error) {
+	r := []rune(str)
+	for len(r) > 0 {
+		w, err := writer.MeasureStrings(img, []string{string(r)})
+		if err != nil {
+			return "", err
+		}
+		if w[0] <= width {
+			break
+		}
+		r = r[:len(r)-1]
+	}
+
+	return strings.TrimSpace(string(r)), nil
+}
+
+func maxWidth(widths []int) int {
+	m := 0
+	for _, w := range widths {
+		if w > m {
+			m = w
+		}
+	}
+	return m
+}
@@ -0,0 +1,237 @@
+package pollboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/logo"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+	"github.com/robbydyer/sports/internal/util"
+
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+)
+
+const defaultLimit = 25
+
+// PollBoard shows a college sports ranking poll, such as the AP Top 25
+type PollBoard struct {
+	config      *Config
+	api         API
+	log         *zap.Logger
+	writer      *rgbrender.TextWriter
+	poll        *Poll
+	lastUpdate  time.Time
+	logos       map[string]*logo.Logo
+	rpcServer   pb.TwirpServer
+	boardCtx    context.Context
+	boardCancel context.CancelFunc
+	enabler     board.Enabler
+	sync.Mutex
+}
+
+// Config ...
+type Config struct {
+	boardDelay     time.Duration
+	updateInterval time.Duration
+	StartEnabled   *atomic.Bool `json:"enabled"`
+	BoardDelay     string       `json:"boardDelay"`
+	UpdateInterval string       `json:"updateInterval"`
+	OnTimes        []string     `json:"onTimes"`
+	OffTimes       []string     `json:"offTimes"`
+	// Poll is the poll to show, such as "ap", "coaches" or "cfp". Defaults to the
+	// playoff rankings when available, then the AP poll, then the coaches poll.
+	Poll          string                `json:"poll"`
+	Limit         int                   `json:"limit"`
+	ShowLogos     *atomic.Bool          `json:"showLogos"`
+	ShowRecord    *atomic.Bool          `json:"showRecord"`
+	ShowPrevious  *atomic.Bool          `json:"showPrevious"`
+	FavoriteTeams []string              `json:"favoriteTeams"`
+	Font          *rgbrender.FontConfig `json:"font"`
+}
+
+// API ...
+type API interface {
+	League() string
+	HTTPPathPrefix() string
+	GetPoll(ctx context.Context, poll string) (*Poll, error)
+	GetLogo(ctx context.Context, logoKey string, logoConf *logo.Config, bounds image.Rectangle) (*logo.Logo, error)
+}
+
+// Poll is the latest edition of a ranking poll
+type Poll struct {
+	// Name is the poll's short name, such as "AP Poll"
+	Name string
+	// Week is the poll's edition, such as "Week 7" or "Final Rankings"
+	Week  string
+	Teams []*RankedTeam
+}
+
+// RankedTeam is a team's entry in a poll
+type RankedTeam struct {
+	ID           string
+	Abbreviation string
+	Record       string
+	Rank         int
+	// Previous is the team's rank in the previous edition of the poll. Zero if it was unranked.
+	Previous int
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err != nil {
+			c.boardDelay = 10 * time.Second
+		} else {
+			c.boardDelay = d
+		}
+	} else {
+		c.boardDelay = 10 * time.Second
+	}
+
+	if c.UpdateInterval != "" {
+		d, err := time.ParseDuration(c.UpdateInterval)
+		if err != nil {
+			c.updateInterval = 1 * time.Hour
+		} else {
+			c.updateInterval = d
+		}
+	} else {
+		c.updateInterval = 1 * time.Hour
+	}
+
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.ShowLogos == nil {
+		c.ShowLogos = atomic.NewBool(true)
+	}
+	if c.ShowRecord == nil {
+		c.ShowRecord = atomic.NewBool(true)
+	}
+	if c.ShowPrevious == nil {
+		c.ShowPrevious = atomic.NewBool(true)
+	}
+	if c.Limit == 0 {
+		c.Limit = defaultLimit
+	}
+}
+
+// New ...
+func New(api API, logger *zap.Logger, config *Config) (*PollBoard, error) {
+	s := &PollBoard{
+		config:  config,
+		api:     api,
+		log:     logger,
+		logos:   make(map[string]*logo.Logo),
+		enabler: enabler.New(),
+	}
+
+	if config.StartEnabled.Load() {
+		s.enabler.Enable()
+	}
+
+	s.log.Info("Register Poll Board",
+		zap.String("league", api.League()),
+		zap.String("poll", config.Poll),
+		zap.String("board name", s.Name()),
+	)
+
+	if err := util.SetCrons(config.OnTimes, func() {
+		s.log.Info("pollboard turning on")
+		s.Enabler().Enable()
+	}); err != nil {
+		return nil, err
+	}
+	if err := util.SetCrons(config.OffTimes, func() {
+		s.log.Info("pollboard turning off")
+		board.DisableScheduled(s.Enabler())
+	}); err != nil {
+		return nil, err
+	}
+
+	svr := &Server{
+		board: s,
+	}
+	prfx := s.api.HTTPPathPrefix()
+	if !strings.HasPrefix(prfx, "/") {
+		prfx = fmt.Sprintf("/%s", prfx)
+	}
+	s.rpcServer = pb.NewBasicBoardServer(svr,
+		twirp.WithServerPathPrefix(fmt.Sprintf("/poll%s", prfx)),
+		twirp.ChainHooks(
+			twirphelpers.GetDefaultHooks(s, s.log),
+		),
+	)
+
+	return s, nil
+}
+
+// Name ...
+func (s *PollBoard) Name() string {
+	return fmt.Sprintf("Poll: %s", s.api.League())
+}
+
+// Enabler ...
+func (s *PollBoard) Enabler() board.Enabler {
+	return s.enabler
+}
+
+// InBetween ...
+func (s *PollBoard) InBetween() bool {
+	return false
+}
+
+// ScrollMode ...
+func (s *PollBoard) ScrollMode() bool {
+	return false
+}
+
+// GetHTTPHandlers ...
+func (s *PollBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+// GetRPCHandler ...
+func (s *PollBoard) GetRPCHandler() (string, http.Handler) {
+	return s.rpcServer.PathPrefix(), s.rpcServer
+}
+
+// Movement is the number of places the team moved up since the previous poll. Negative is places
+// lost. Teams new to the poll have no movement.
+func (r *RankedTeam) Movement() int {
+	if r.Previous == 0 {
+		return 0
+	}
+	return r.Previous - r.Rank
+}
+
+// PreviousStr is the team's previous rank, or "NR" if it was unranked
+func (r *RankedTeam) PreviousStr() string {
+	if r.Previous == 0 {
+		return "NR"
+	}
+	return fmt.Sprint(r.Previous)
+}
+
+func (s *PollBoard) isFavorite(r *RankedTeam) bool {
+	for _, fav := range s.config.FavoriteTeams {
+		if strings.EqualFold(fav, r.Abbreviation) || fav == r.ID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,407 @@
+package pollboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	titleColor    = color.RGBA{255, 255, 0, 255}
+	previousColor = color.RGBA{100, 100, 100, 255}
+	favoriteColor = color.RGBA{0, 255, 255, 255}
+	upColor       = color.RGBA{0, 255, 0, 255}
+	downColor     = color.RGBA{255, 0, 0, 255}
+)
+
+// Render ...
+//
+//nolint:contextcheck
+func (s *PollBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	s.boardCtx, s.boardCancel = context.WithCancel(ctx)
+
+	pages, err := s.pages(s.boardCtx, canvas.Bounds())
+	if err != nil {
+		return err
+	}
+
+PAGES:
+	for _, page := range pages {
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		default:
+		}
+
+		draw.Draw(canvas, page.Bounds(), page, image.Point{}, draw.Over)
+
+		if err := canvas.Render(s.boardCtx); err != nil {
+			s.log.Error("failed to render poll board",
+				zap.Error(err),
+			)
+			continue PAGES
+		}
+
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		case <-time.After(s.config.boardDelay):
+		}
+	}
+
+	return nil
+}
+
+// ScrollRender renders each page of the poll as a cell of a ScrollCanvas
+func (s *PollBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	pages, err := s.pages(ctx, canvas.Bounds())
+	if err != nil {
+		return nil, err
+	}
+	if len(pages) < 1 {
+		return nil, nil
+	}
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.Name()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages {
+		scrollCanvas.AddCanvas(page)
+	}
+
+	go scrollCanvas.MatchScroll(ctx, base)
+
+	return scrollCanvas, nil
+}
+
+// updatePoll refreshes the poll once the update interval has passed. A stale poll is
+// kept if the refresh fails.
+func (s *PollBoard) updatePoll(ctx context.Context) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.poll != nil && time.Since(s.lastUpdate) < s.config.updateInterval {
+		return nil
+	}
+
+	p, err := s.api.GetPoll(ctx, s.config.Poll)
+	if err != nil {
+		if s.poll != nil {
+			s.log.Error("failed to update poll, using cached",
+				zap.String("league", s.api.League()),
+				zap.Error(err),
+			)
+			return nil
+		}
+		return err
+	}
+
+	s.poll = p
+	s.lastUpdate = time.Now()
+
+	return nil
+}
+
+// pages splits the poll into as many pages as needed to fit the canvas. Each page starts with
+// the poll's name and week.
+func (s *PollBoard) pages(ctx context.Context, bounds image.Rectangle) ([]draw.Image, error) {
+	if err := s.updatePoll(ctx); err != nil {
+		return nil, err
+	}
+
+	s.Lock()
+	p := s.poll
+	s.Unlock()
+
+	if p == nil || len(p.Teams) < 1 {
+		return nil, nil
+	}
+
+	teams := p.Teams
+	if s.config.Limit > 0 && len(teams) > s.config.Limit {
+		teams = teams[:s.config.Limit]
+	}
+
+	writer, err := s.getWriter(rgbrender.ZeroedBounds(bounds))
+	if err != nil {
+		return nil, err
+	}
+
+	canvasBounds := rgbrender.ZeroedBounds(bounds)
+	lineHeight := int(math.Ceil(writer.FontSize))
+	rowsPerPage := (canvasBounds.Dy() / lineHeight) - 1
+	if rowsPerPage < 1 {
+		return nil, fmt.Errorf("canvas too small for poll board")
+	}
+
+	var pages []draw.Image
+	for start := 0; start < len(teams); start += rowsPerPage {
+		end := start + rowsPerPage
+		if end > len(teams) {
+			end = len(teams)
+		}
+		img, err := s.renderPage(ctx, bounds, writer, p, teams[start:end])
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, img)
+	}
+
+	return pages, nil
+}
+
+func (s *PollBoard) renderPage(ctx context.Context, bounds image.Rectangle, writer *rgbrender.TextWriter, p *Poll, teams []*RankedTeam) (draw.Image, error) {
+	canvasBounds := rgbrender.ZeroedBounds(bounds)
+	lineHeight := int(math.Ceil(writer.FontSize))
+	arrowW := lineHeight / 2
+
+	img := image.NewRGBA(bounds)
+
+	var ranks, records, previous []string
+	hasMovement := false
+	for _, t := range teams {
+		ranks = append(ranks, fmt.Sprint(t.Rank))
+		records = append(records, t.Record)
+		previous = append(previous, t.PreviousStr())
+		if t.Movement() != 0 {
+			hasMovement = true
+		}
+	}
+
+	rankWidths, err := writer.MeasureStrings(img, ranks)
+	if err != nil {
+		return nil, err
+	}
+	recordWidths, err := writer.MeasureStrings(img, records)
+	if err != nil {
+		return nil, err
+	}
+	previousWidths, err := writer.MeasureStrings(img, previous)
+	if err != nil {
+		return nil, err
+	}
+	minName, err := writer.MeasureStrings(img, []string{"AAA"})
+	if err != nil {
+		return nil, err
+	}
+
+	rankX := canvasBounds.Min.X
+	if hasMovement {
+		rankX += arrowW + 1
+	}
+	logoX := rankX + maxWidth(rankWidths) + 2
+	nameX := logoX
+	if s.config.ShowLogos.Load() {
+		nameX += lineHeight + 1
+	}
+
+	previousX := canvasBounds.Max.X
+	if s.config.ShowPrevious.Load() {
+		previousX -= maxWidth(previousWidths)
+	}
+	recordX := previousX
+	if s.config.ShowRecord.Load() {
+		recordX -= maxWidth(recordWidths) + 2
+	}
+
+	// Drop the previous rank on narrow matrices rather than squeezing out the team name
+	showPrevious := s.config.ShowPrevious.Load()
+	if showPrevious && recordX-nameX < minName[0] {
+		showPrevious = false
+		recordX += canvasBounds.Max.X - previousX
+		previousX = canvasBounds.Max.X
+	}
+
+	if err := s.renderTitle(img, canvasBounds, writer, p, lineHeight); err != nil {
+		return nil, err
+	}
+
+	for i, t := range teams {
+		y := canvasBounds.Min.Y + ((i + 1) * lineHeight)
+
+		clr := color.Color(color.White)
+		if s.isFavorite(t) {
+			clr = favoriteColor
+		}
+
+		drawMovement(img, image.Rect(canvasBounds.Min.X, y, canvasBounds.Min.X+arrowW, y+lineHeight), t.Movement())
+
+		if err := writer.WriteAligned(
+			rgbrender.RightTop,
+			img,
+			image.Rect(rankX, y, logoX-2, y+lineHeight),
+			[]string{fmt.Sprint(t.Rank)},
+			clr,
+		); err != nil {
+			return nil, err
+		}
+
+		if s.config.ShowLogos.Load() {
+			if l, err := s.teamLogo(ctx, t, lineHeight); err != nil {
+				s.log.Error("failed to get poll team logo",
+					zap.String("team", t.Abbreviation),
+					zap.Error(err),
+				)
+			} else {
+				draw.Draw(img, image.Rect(logoX, y, logoX+lineHeight, y+lineHeight), l, l.Bounds().Min, draw.Over)
+			}
+		}
+
+		name, err := truncate(writer, img, t.Abbreviation, recordX-nameX-1)
+		if err != nil {
+			return nil, err
+		}
+		if err := writer.WriteAligned(
+			rgbrender.LeftTop,
+			img,
+			image.Rect(nameX, y, recordX, y+lineHeight),
+			[]string{name},
+			clr,
+		); err != nil {
+			return nil, err
+		}
+
+		if s.config.ShowRecord.Load() {
+			if err := writer.WriteAligned(
+				rgbrender.RightTop,
+				img,
+				image.Rect(recordX, y, previousX-2, y+lineHeight),
+				[]string{t.Record},
+				clr,
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		if showPrevious {
+			if err := writer.WriteAligned(
+				rgbrender.RightTop,
+				img,
+				image.Rect(previousX, y, canvasBounds.Max.X, y+lineHeight),
+				[]string{t.PreviousStr()},
+				previousColor,
+			); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return img, nil
+}
+
+func (s *PollBoard) renderTitle(img draw.Image, canvasBounds image.Rectangle, writer *rgbrender.TextWriter, p *Poll, lineHeight int) error {
+	titleBounds := image.Rect(canvasBounds.Min.X, canvasBounds.Min.Y, canvasBounds.Max.X, canvasBounds.Min.Y+lineHeight)
+
+	week := shortWeek(p.Week)
+	rightW := 0
+	if week != "" {
+		w, err := writer.MeasureStrings(img, []string{week})
+		if err != nil {
+			return err
+		}
+		rightW = w[0] + 2
+		if err := writer.WriteAligned(rgbrender.RightTop, img, titleBounds, []string{week}, previousColor); err != nil {
+			return err
+		}
+	}
+
+	name, err := truncate(writer, img, p.Name, canvasBounds.Dx()-rightW)
+	if err != nil {
+		return err
+	}
+
+	return writer.WriteAligned(rgbrender.LeftTop, img, titleBounds, []string{name}, titleColor)
+}
+
+func (s *PollBoard) teamLogo(ctx context.Context, t *RankedTeam, size int) (image.Image, error) {
+	bounds := image.Rect(0, 0, size, size)
+	logoKey := fmt.Sprintf("%s_POLL_%dx%d", t.ID, size, size)
+
+	s.Lock()
+	l, ok := s.logos[logoKey]
+	s.Unlock()
+
+	if !ok {
+		var err error
+		l, err = s.api.GetLogo(ctx, logoKey, nil, bounds)
+		if err != nil {
+			return nil, err
+		}
+		l.SetLogger(s.log)
+
+		s.Lock()
+		s.logos[logoKey] = l
+		s.Unlock()
+	}
+
+	return l.GetThumbnail(ctx, bounds)
+}
+
+// shortWeek shortens a poll edition such as "Week 7" to "WK 7"
+func shortWeek(week string) string {
+	return strings.Replace(week, "Week ", "WK ", 1)
+}
+
+// drawMovement draws an up or down arrow for places gained or lost
+func drawMovement(img draw.Image, bounds image.Rectangle, movement int) {
+	w := bounds.Dx()
+	if w < 2 {
+		return
+	}
+	midY := bounds.Min.Y + (bounds.Dy() / 2)
+
+	switch {
+	case movement > 0:
+		rgbrender.DrawUpTriangle(img, image.Pt(bounds.Min.X, midY+(w/4)), w, 0, upColor, upColor)
+	case movement < 0:
+		rgbrender.DrawDownTriangle(img, image.Pt(bounds.Min.X, midY-(w/4)), w, 0, downColor, downColor)
+	}
+}
+
+// truncate shortens a string until it fits within the given pixel width
+func truncate(writer *rgbrender.TextWriter, img draw.Image, str string, width int) (string, error) {
+	r := []rune(str)
+	for len(r) > 0 {
+		w, err := writer.MeasureStrings(img, []string{string(r)})
+		if err != nil {
+			return "", err
+		}
+		if w[0] <= width {
+			break
+		}
+		r = r[:len(r)-1]
+	}
+
+	return string(r), nil
+}
+
+func maxWidth(widths []int) int {
+	m := 0
+	for _, w := range widths {
+		if w > m {
+			m = w
+		}
+	}
+	return m
+}
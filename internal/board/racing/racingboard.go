@@ -22,6 +22,8 @@ import (
 	pb "github.com/robbydyer/sports/internal/proto/racingboard"
 )
 
+const defaultTableLimit = 10
+
 // RacingBoard implements board.Board
 type RacingBoard struct {
 	config         *Config
@@ -30,6 +32,7 @@ type RacingBoard struct {
 	scheduleWriter *rgbrender.TextWriter
 	leagueLogo     *logo.Logo
 	events         []*Event
+	standings      map[string][]*Standing
 	rpcServer      pb.TwirpServer
 	boardCtx       context.Context
 	boardCancel    context.CancelFunc
@@ -45,11 +48,16 @@ type Todayer func() []time.Time
 type Config struct {
 	TodayFunc          Todayer
 	boardDelay         time.Duration
-	StartEnabled       *atomic.Bool `json:"enabled"`
-	BoardDelay         string       `json:"boardDelay"`
-	OnTimes            []string     `json:"onTimes"`
-	OffTimes           []string     `json:"offTimes"`
-	TightScrollPadding int          `json:"tightScrollPadding"`
+	StartEnabled       *atomic.Bool          `json:"enabled"`
+	BoardDelay         string                `json:"boardDelay"`
+	OnTimes            []string              `json:"onTimes"`
+	OffTimes           []string              `json:"offTimes"`
+	TightScrollPadding int                   `json:"tightScrollPadding"`
+	ShowResults        *atomic.Bool          `json:"showResults"`
+	ShowStandings      *atomic.Bool          `json:"showStandings"`
+	ResultsLimit       int                   `json:"resultsLimit"`
+	StandingsLimit     int                   `json:"standingsLimit"`
+	Font               *rgbrender.FontConfig `json:"font"`
 }
 
 // API ...
@@ -57,13 +65,38 @@ type API interface {
 	LeagueShortName() string
 	GetLogo(ctx context.Context, bounds image.Rectangle) (*logo.Logo, error)
 	GetScheduledEvents(ctx context.Context) ([]*Event, error)
+	GetEventResults(ctx context.Context, event *Event) ([]*Result, error)
+	GetDriverStandings(ctx context.Context) ([]*Standing, error)
+	GetConstructorStandings(ctx context.Context) ([]*Standing, error)
 	HTTPPathPrefix() string
 }
 
 // Event ...
 type Event struct {
-	Date time.Time
-	Name string
+	ID        string
+	Date      time.Time
+	Name      string
+	Live      bool
+	Completed bool
+	// Status is a short description of the current session, such as the lap count
+	Status string
+}
+
+// Result is a driver's position in a session
+type Result struct {
+	Position int
+	Driver   string
+	Team     string
+	// Gap is the interval to the leader, or the total time for the leader
+	Gap   string
+	InPit bool
+}
+
+// Standing is a driver or constructor's position in the championship
+type Standing struct {
+	Position int
+	Name     string
+	Points   string
 }
 
 // SetDefaults sets config defaults
@@ -81,6 +114,18 @@ func (c *Config) SetDefaults() {
 	if c.StartEnabled == nil {
 		c.StartEnabled = atomic.NewBool(false)
 	}
+	if c.ShowResults == nil {
+		c.ShowResults = atomic.NewBool(true)
+	}
+	if c.ShowStandings == nil {
+		c.ShowStandings = atomic.NewBool(false)
+	}
+	if c.ResultsLimit == 0 {
+		c.ResultsLimit = defaultTableLimit
+	}
+	if c.StandingsLimit == 0 {
+		c.StandingsLimit = defaultTableLimit
+	}
 }
 
 // New ...
@@ -118,7 +163,7 @@ func New(api API, logger *zap.Logger, config *Config) (*RacingBoard, error) {
 	}
 	if err := util.SetCrons(config.OffTimes, func() {
 		s.log.Info("racingboard turning off")
-		s.Enabler().Disable()
+		board.DisableScheduled(s.Enabler())
 	}); err != nil {
 		return nil, err
 	}
@@ -146,6 +191,7 @@ func New(api API, logger *zap.Logger, config *Config) (*RacingBoard, error) {
 func (s *RacingBoard) cacheClear() {
 	s.events = []*Event{}
 	s.leagueLogo = nil
+	s.standings = nil
 }
 
 // Name ...
@@ -162,6 +208,11 @@ func (s *RacingBoard) InBetween() bool {
 	return false
 }
 
+// ScrollMode ...
+func (s *RacingBoard) ScrollMode() bool {
+	return false
+}
+
 // HasPriority ...
 func (s *RacingBoard) HasPriority() bool {
 	return false
@@ -11,8 +11,10 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/locale"
 	"github.com/robbydyer/sports/internal/logo"
 	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 )
 
 // Render ...
@@ -25,6 +27,73 @@ func (s *RacingBoard) Render(ctx context.Context, canvas board.Canvas) error {
 	return nil
 }
 
+// ScrollRender renders each scheduled event as a cell of a ScrollCanvas
+func (s *RacingBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	if s.leagueLogo == nil {
+		var err error
+		s.leagueLogo, err = s.api.GetLogo(ctx, canvas.Bounds())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(s.events) < 1 {
+		var err error
+		s.events, err = s.api.GetScheduledEvents(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	scheduleWriter, err := s.getScheduleWriter(rgbrender.ZeroedBounds(canvas.Bounds()))
+	if err != nil {
+		return nil, err
+	}
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.Name()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+EVENTS:
+	for _, event := range s.events {
+		select {
+		case <-ctx.Done():
+			return nil, context.Canceled
+		default:
+		}
+		img, err := s.renderEvent(ctx, canvas.Bounds(), event, s.leagueLogo, scheduleWriter)
+		if err != nil {
+			s.log.Error("failed to render racing event",
+				zap.Error(err),
+			)
+			continue EVENTS
+		}
+
+		scrollCanvas.AddCanvas(img)
+	}
+
+	for _, page := range s.tablePages(ctx, canvas.Bounds(), scheduleWriter) {
+		scrollCanvas.AddCanvas(page)
+	}
+
+	if scrollCanvas.Len() < 1 {
+		return nil, nil
+	}
+
+	go scrollCanvas.MatchScroll(ctx, base)
+
+	return scrollCanvas, nil
+}
+
 // Render ...
 //
 //nolint:contextcheck
@@ -76,6 +145,7 @@ EVENTS:
 		}
 
 		draw.Draw(canvas, img.Bounds(), img, image.Point{}, draw.Over)
+		board.DrawStaleMarker(s.api, canvas, rgbrender.ZeroedBounds(canvas.Bounds()))
 
 		if err := canvas.Render(s.boardCtx); err != nil {
 			s.log.Error("failed to render racing board",
@@ -91,6 +161,33 @@ EVENTS:
 		}
 	}
 
+	pages := s.tablePages(s.boardCtx, canvas.Bounds(), scheduleWriter)
+
+PAGES:
+	for _, page := range pages {
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		default:
+		}
+
+		draw.Draw(canvas, page.Bounds(), page, image.Point{}, draw.Over)
+		board.DrawStaleMarker(s.api, canvas, rgbrender.ZeroedBounds(canvas.Bounds()))
+
+		if err := canvas.Render(s.boardCtx); err != nil {
+			s.log.Error("failed to render racing table",
+				zap.Error(err),
+			)
+			continue PAGES
+		}
+
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case <-time.After(tablePageDelay):
+		}
+	}
+
 	return nil
 }
 
@@ -115,13 +212,14 @@ func (s *RacingBoard) renderEvent(ctx context.Context, bounds image.Rectangle, e
 	pt = image.Pt(gradient.Bounds().Min.X, gradient.Bounds().Min.Y)
 	draw.Draw(img, gradient.Bounds(), gradient, pt, draw.Over)
 
-	event.Date = event.Date.Local()
-
-	tz, _ := event.Date.Zone()
+	loc := locale.Current()
 	txt := []string{
 		event.Name,
-		event.Date.Format("01/02/2006"),
-		fmt.Sprintf("%s %s", event.Date.Format("3:04PM"), tz),
+		loc.Date(event.Date),
+		loc.TimeZone(event.Date),
+	}
+	if secondary, ok := loc.SecondaryTime(event.Date); ok {
+		txt = append(txt, secondary)
 	}
 
 	lengths, err := scheduleWriter.MeasureStrings(img, txt)
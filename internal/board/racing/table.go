@@ -0,0 +1,269 @@
+package racingboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+const (
+	// raceWeekend is how long before an event's start date that its sessions may be running
+	raceWeekend    = 72 * time.Hour
+	tablePageDelay = 5 * time.Second
+)
+
+var (
+	titleColor = color.RGBA{255, 255, 0, 255}
+	pitColor   = color.RGBA{255, 0, 0, 255}
+)
+
+type tableRow struct {
+	position string
+	name     string
+	value    string
+	color    color.Color
+}
+
+// tablePages returns the rendered pages of any live results, final results and standings tables
+func (s *RacingBoard) tablePages(ctx context.Context, bounds image.Rectangle, writer *rgbrender.TextWriter) []draw.Image {
+	var pages []draw.Image
+
+	anyLive := false
+
+	if s.config.ShowResults.Load() {
+		for _, event := range s.events {
+			if time.Until(event.Date) > raceWeekend {
+				continue
+			}
+
+			results, err := s.api.GetEventResults(ctx, event)
+			if err != nil {
+				s.log.Error("failed to get racing results",
+					zap.String("league", s.api.LeagueShortName()),
+					zap.String("event", event.Name),
+					zap.Error(err),
+				)
+				continue
+			}
+			if len(results) < 1 {
+				continue
+			}
+
+			if event.Live {
+				anyLive = true
+			}
+
+			title := event.Name
+			if event.Status != "" {
+				title = fmt.Sprintf("%s %s", event.Name, event.Status)
+			}
+
+			p, err := s.renderTable(bounds, writer, title, resultRows(results, s.config.ResultsLimit))
+			if err != nil {
+				s.log.Error("failed to render racing results",
+					zap.String("league", s.api.LeagueShortName()),
+					zap.Error(err),
+				)
+				continue
+			}
+			pages = append(pages, p...)
+		}
+	}
+
+	// Standings don't change during a session, so skip them while one is live
+	if !s.config.ShowStandings.Load() || anyLive {
+		return pages
+	}
+
+	if err := s.updateStandings(ctx); err != nil {
+		s.log.Error("failed to get racing standings",
+			zap.String("league", s.api.LeagueShortName()),
+			zap.Error(err),
+		)
+	}
+
+	for _, title := range []string{"Drivers", "Constructors"} {
+		standings := s.standings[title]
+		if len(standings) < 1 {
+			continue
+		}
+		p, err := s.renderTable(bounds, writer, title, standingRows(standings, s.config.StandingsLimit))
+		if err != nil {
+			s.log.Error("failed to render racing standings",
+				zap.String("league", s.api.LeagueShortName()),
+				zap.Error(err),
+			)
+			continue
+		}
+		pages = append(pages, p...)
+	}
+
+	return pages
+}
+
+// updateStandings fetches standings if they aren't cached. The cache is cleared daily.
+func (s *RacingBoard) updateStandings(ctx context.Context) error {
+	if s.standings != nil {
+		return nil
+	}
+
+	drivers, err := s.api.GetDriverStandings(ctx)
+	if err != nil {
+		return err
+	}
+	constructors, err := s.api.GetConstructorStandings(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.standings = map[string][]*Standing{
+		"Drivers":      drivers,
+		"Constructors": constructors,
+	}
+
+	return nil
+}
+
+func resultRows(results []*Result, limit int) []*tableRow {
+	rows := []*tableRow{}
+	for _, r := range results {
+		if limit > 0 && len(rows) >= limit {
+			break
+		}
+		row := &tableRow{
+			position: fmt.Sprint(r.Position),
+			name:     r.Driver,
+			value:    r.Gap,
+			color:    color.White,
+		}
+		if r.InPit {
+			row.value = "PIT"
+			row.color = pitColor
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func standingRows(standings []*Standing, limit int) []*tableRow {
+	rows := []*tableRow{}
+	for _, st := range standings {
+		if limit > 0 && len(rows) >= limit {
+			break
+		}
+		rows = append(rows, &tableRow{
+			position: fmt.Sprint(st.Position),
+			name:     st.Name,
+			value:    st.Points,
+			color:    color.White,
+		})
+	}
+
+	return rows
+}
+
+// renderTable splits rows into as many pages as needed to fit the canvas. Each page starts with the title.
+func (s *RacingBoard) renderTable(bounds image.Rectangle, writer *rgbrender.TextWriter, title string, rows []*tableRow) ([]draw.Image, error) {
+	canvasBounds := rgbrender.ZeroedBounds(bounds)
+
+	lineHeight := int(math.Ceil(writer.FontSize))
+	rowsPerPage := (canvasBounds.Dy() / lineHeight) - 1
+	if rowsPerPage < 1 {
+		return nil, fmt.Errorf("canvas too small for racing table")
+	}
+
+	var pages []draw.Image
+
+	for start := 0; start < len(rows); start += rowsPerPage {
+		end := start + rowsPerPage
+		if end > len(rows) {
+			end = len(rows)
+		}
+		page := rows[start:end]
+
+		img := image.NewRGBA(bounds)
+
+		var positions, names []string
+		for _, r := range page {
+			positions = append(positions, r.position)
+			names = append(names, r.name)
+		}
+
+		posWidths, err := writer.MeasureStrings(img, positions)
+		if err != nil {
+			return nil, err
+		}
+		nameWidths, err := writer.MeasureStrings(img, names)
+		if err != nil {
+			return nil, err
+		}
+		posW := maxWidth(posWidths)
+		nameX := canvasBounds.Min.X + posW + 2
+		valueX := nameX + maxWidth(nameWidths) + 2
+
+		if err := writer.WriteAligned(
+			rgbrender.LeftTop,
+			img,
+			image.Rect(canvasBounds.Min.X, canvasBounds.Min.Y, canvasBounds.Max.X, canvasBounds.Min.Y+lineHeight),
+			[]string{title},
+			titleColor,
+		); err != nil {
+			return nil, err
+		}
+
+		for i, r := range page {
+			y := canvasBounds.Min.Y + ((i + 1) * lineHeight)
+
+			if err := writer.WriteAligned(
+				rgbrender.RightTop,
+				img,
+				image.Rect(canvasBounds.Min.X, y, canvasBounds.Min.X+posW, y+lineHeight),
+				[]string{r.position},
+				color.White,
+			); err != nil {
+				return nil, err
+			}
+			if err := writer.WriteAligned(
+				rgbrender.LeftTop,
+				img,
+				image.Rect(nameX, y, valueX, y+lineHeight),
+				[]string{r.name},
+				r.color,
+			); err != nil {
+				return nil, err
+			}
+			if err := writer.WriteAligned(
+				rgbrender.RightTop,
+				img,
+				image.Rect(valueX, y, canvasBounds.Max.X, y+lineHeight),
+				[]string{r.value},
+				r.color,
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		pages = append(pages, img)
+	}
+
+	return pages, nil
+}
+
+func maxWidth(widths []int) int {
+	m := 0
+	for _, w := range widths {
+		if w > m {
+			m = w
+		}
+	}
+	return m
+}
@@ -29,6 +29,11 @@ func (s *RacingBoard) getScheduleWriter(bounds image.Rectangle) (*rgbrender.Text
 		s.scheduleWriter.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
 	}
 
+	if err := s.scheduleWriter.Configure(s.config.Font); err != nil {
+		s.scheduleWriter = nil
+		return nil, err
+	}
+
 	return s.scheduleWriter, nil
 }
 
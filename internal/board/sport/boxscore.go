@@ -0,0 +1,81 @@
+package sportboard
+
+import (
+	"context"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	statboard "github.com/robbydyer/sports/internal/board/stat"
+)
+
+// BoxScore is an in-game summary of team stat comparisons and top performers
+type BoxScore struct {
+	// TeamStats has a row per stat, with a column for each team
+	TeamStats       []statboard.Player
+	TeamStatColumns []string
+	// Leaders has a row per top performer, prefixed with their team
+	Leaders       []statboard.Player
+	LeaderColumns []string
+}
+
+// BoxScorer is implemented by a Game that can provide a live box score
+type BoxScorer interface {
+	GetBoxScore(ctx context.Context) (*BoxScore, error)
+}
+
+// WithBoxScoreAPI sets the stat API used for rendering live box scores
+func WithBoxScoreAPI(api statboard.API) OptionFunc {
+	return func(s *SportBoard) error {
+		conf := &statboard.Config{
+			StartEnabled: atomic.NewBool(true),
+		}
+		conf.SetDefaults()
+
+		b, err := statboard.New(context.Background(), api, conf, s.log)
+		if err != nil {
+			return err
+		}
+		s.boxScoreBoard = b
+
+		return nil
+	}
+}
+
+// renderBoxScore renders the box score of a live game, if the game supports it
+func (s *SportBoard) renderBoxScore(ctx context.Context, canvas board.Canvas, game Game) error {
+	if !s.config.ShowBoxScore.Load() || s.boxScoreBoard == nil {
+		return nil
+	}
+
+	scorer, ok := game.(BoxScorer)
+	if !ok {
+		return nil
+	}
+
+	isLive, err := game.IsLive()
+	if err != nil || !isLive {
+		return err
+	}
+
+	box, err := scorer.GetBoxScore(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.log.Debug("rendering box score",
+		zap.String("league", s.api.League()),
+		zap.Int("game ID", game.GetID()),
+		zap.Int("team stats", len(box.TeamStats)),
+		zap.Int("leaders", len(box.Leaders)),
+	)
+
+	defer func() { _ = canvas.Clear() }()
+
+	if err := s.boxScoreBoard.RenderTable(ctx, canvas, box.TeamStats, box.TeamStatColumns, false); err != nil {
+		return err
+	}
+
+	return s.boxScoreBoard.RenderTable(ctx, canvas, box.Leaders, box.LeaderColumns, true)
+}
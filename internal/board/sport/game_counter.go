@@ -2,7 +2,6 @@ package sportboard
 
 import (
 	"image"
-	"image/color"
 
 	"go.uber.org/zap"
 
@@ -37,6 +36,8 @@ func (s *SportBoard) RenderGameCounter(canvas board.Canvas, numGames int, active
 	)
 
 	img := image.NewRGBA(bounds)
+	activeColor := s.theme().ScoredColor()
+	inactiveColor := s.theme().TextColor()
 
 	yPix := aligned.Max.Y - 1
 	for i := 0; i < totalWidth; i += spacing + 1 {
@@ -45,7 +46,7 @@ func (s *SportBoard) RenderGameCounter(canvas board.Canvas, numGames int, active
 			for x := 0; x < pixSize; x++ {
 				firstY := yPix
 				for y := 0; y < pixSize; y++ {
-					img.Set(xPix, yPix, red)
+					img.Set(xPix, yPix, activeColor)
 					yPix--
 				}
 				yPix = firstY
@@ -58,7 +59,7 @@ func (s *SportBoard) RenderGameCounter(canvas board.Canvas, numGames int, active
 		for x := 0; x < pixSize; x++ {
 			firstY := yPix
 			for y := 0; y < pixSize; y++ {
-				img.Set(xPix, yPix, color.White)
+				img.Set(xPix, yPix, inactiveColor)
 				yPix--
 			}
 			yPix = firstY
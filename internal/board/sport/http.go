@@ -14,7 +14,7 @@ import (
 //
 //nolint:contextcheck
 func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
-	return []*board.HTTPHandler{
+	handlers := []*board.HTTPHandler{
 		{
 			Path: fmt.Sprintf("/%s/hidefavoritescore", s.api.HTTPPathPrefix()),
 			Handler: func(http.ResponseWriter, *http.Request) {
@@ -129,5 +129,7 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				_, _ = w.Write([]byte("false"))
 			},
 		},
-	}, nil
+	}
+
+	return append(handlers, s.logoEditorHandlers()...), nil
 }
@@ -11,13 +11,44 @@ import (
 	"github.com/robbydyer/sports/internal/rgbrender"
 )
 
+// logoConfig returns the position of a logo. Positions saved from the logo editor are used ahead
+// of the board's logoConfigs, which are used ahead of the API's defaults.
 func (s *SportBoard) logoConfig(logoKey string, bounds image.Rectangle) *logo.Config {
+	var apiConf *logo.Config
+	if le, ok := s.api.(LogoEditor); ok {
+		var saved bool
+		apiConf, saved = le.LogoConfig(logoKey, bounds)
+		if saved {
+			return apiConf
+		}
+	}
+
 	for _, conf := range s.config.LogoConfigs {
 		if conf.Abbrev == logoKey {
 			return conf
 		}
 	}
 
+	if apiConf != nil {
+		return apiConf
+	}
+
+	if logo.ScoreboardKey(logoKey) {
+		s.log.Debug("no logo config defined, logo will be fitted automatically", zap.String("logo key", logoKey))
+
+		return &logo.Config{
+			Abbrev: logoKey,
+			XSize:  bounds.Dx(),
+			YSize:  bounds.Dy(),
+			Auto:   true,
+			Pt: &logo.Pt{
+				X:    0,
+				Y:    0,
+				Zoom: 1,
+			},
+		}
+	}
+
 	s.log.Debug("no logo config defined, defaults will be used", zap.String("logo key", logoKey))
 
 	zoom := float64(1)
@@ -154,6 +185,19 @@ func (s *SportBoard) RenderLeftLogo(ctx context.Context, canvasBounds image.Rect
 		return nil, fmt.Errorf("logo %s was nil", logoKey)
 	}
 
+	renderedLogo, setCache, err := s.drawLeftLogo(ctx, bounds, teamID, l)
+	if err != nil {
+		return nil, err
+	}
+	if setCache {
+		s.setLogoDrawCache(logoKey, renderedLogo)
+	}
+	return renderedLogo, nil
+}
+
+// drawLeftLogo positions a logo on the left side of the scoreboard. The rendered logo shouldn't
+// be cached if the team info width is unknown.
+func (s *SportBoard) drawLeftLogo(ctx context.Context, bounds image.Rectangle, teamID string, l *logo.Logo) (image.Image, bool, error) {
 	textWidth := s.textAreaWidth(bounds)
 	logoEndX := (bounds.Dx() - textWidth) / 2
 	s.log.Debug("starting logoWidth",
@@ -185,12 +229,9 @@ func (s *SportBoard) RenderLeftLogo(ctx context.Context, canvasBounds image.Rect
 	renderedLogo, renderErr = l.RenderRightAlignedWithEnd(ctx, bounds, logoEndX)
 	if renderErr != nil {
 		s.log.Error("failed to render left logo", zap.Error(renderErr))
-		return nil, fmt.Errorf("failed to render left logo: %w", renderErr)
+		return nil, false, fmt.Errorf("failed to render left logo: %w", renderErr)
 	}
-	if setCache {
-		s.setLogoDrawCache(logoKey, renderedLogo)
-	}
-	return renderedLogo, nil
+	return renderedLogo, setCache, nil
 }
 
 // RenderRightLogo ...
@@ -236,6 +277,19 @@ func (s *SportBoard) RenderRightLogo(ctx context.Context, canvasBounds image.Rec
 		return nil, fmt.Errorf("logo %s was nil", logoKey)
 	}
 
+	renderedLogo, setCache, err := s.drawRightLogo(ctx, bounds, teamID, l)
+	if err != nil {
+		return nil, err
+	}
+	if setCache {
+		s.setLogoDrawCache(logoKey, renderedLogo)
+	}
+	return renderedLogo, nil
+}
+
+// drawRightLogo positions a logo on the right side of the scoreboard. The rendered logo shouldn't
+// be cached if the team info width is unknown.
+func (s *SportBoard) drawRightLogo(ctx context.Context, bounds image.Rectangle, teamID string, l *logo.Logo) (image.Image, bool, error) {
 	textWidth := s.textAreaWidth(bounds)
 	logoWidth := (bounds.Dx() - textWidth) / 2
 	s.log.Debug("starting logoWidth",
@@ -246,6 +300,7 @@ func (s *SportBoard) RenderRightLogo(ctx context.Context, canvasBounds image.Rec
 	setCache := true
 
 	if s.config.ShowRecord.Load() {
+		var err error
 		recordAdder, err = s.getTeamInfoWidth(s.api.League(), teamID)
 		if err != nil {
 			s.log.Error("failed to get team info width",
@@ -272,10 +327,7 @@ func (s *SportBoard) RenderRightLogo(ctx context.Context, canvasBounds image.Rec
 	renderedLogo, renderErr = l.RenderLeftAlignedWithStart(ctx, bounds, startX)
 	if renderErr != nil {
 		s.log.Error("failed to render right logo", zap.Error(renderErr))
-		return nil, fmt.Errorf("failed to render right logo: %w", err)
+		return nil, false, fmt.Errorf("failed to render right logo: %w", renderErr)
 	}
-	if setCache {
-		s.setLogoDrawCache(logoKey, renderedLogo)
-	}
-	return renderedLogo, nil
+	return renderedLogo, setCache, nil
 }
@@ -0,0 +1,56 @@
+package sportboard
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+type logoEditorAPI struct {
+	API
+	conf  *logo.Config
+	saved bool
+}
+
+func (a *logoEditorAPI) LogoConfig(logoKey string, bounds image.Rectangle) (*logo.Config, bool) {
+	return a.conf, a.saved
+}
+
+func (a *logoEditorAPI) SaveLogoConfig(logoKey string, conf *logo.Config, bounds image.Rectangle) error {
+	return nil
+}
+
+func TestLogoConfigPrecedence(t *testing.T) {
+	t.Parallel()
+
+	key := "1_HOME_64x32"
+	bounds := image.Rect(0, 0, 64, 32)
+	handWritten := &logo.Config{Abbrev: key, Pt: &logo.Pt{X: 1, Zoom: 1}}
+	apiConf := &logo.Config{Abbrev: key, Pt: &logo.Pt{X: 2, Zoom: 1}}
+
+	tests := []struct {
+		name     string
+		saved    bool
+		expected *logo.Config
+	}{
+		{name: "saved from the editor", saved: true, expected: apiConf},
+		{name: "built in default", saved: false, expected: handWritten},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			s := &SportBoard{
+				config: &Config{LogoConfigs: []*logo.Config{handWritten}},
+				api:    &logoEditorAPI{conf: apiConf, saved: test.saved},
+				log:    zap.NewNop(),
+			}
+			require.Same(t, test.expected, s.logoConfig(key, bounds))
+		})
+	}
+}
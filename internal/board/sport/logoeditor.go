@@ -0,0 +1,321 @@
+package sportboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+// LogoEditor is implemented by an API whose logo positions can be edited from the web UI
+type LogoEditor interface {
+	// LogoConfig returns the saved or default position of a logo, or nil if it has neither. saved
+	// is true when the position was saved from the logo editor.
+	LogoConfig(logoKey string, bounds image.Rectangle) (conf *logo.Config, saved bool)
+	// SaveLogoConfig saves a logo's position for the matrix size
+	SaveLogoConfig(logoKey string, conf *logo.Config, bounds image.Rectangle) error
+}
+
+type logoEditTeam struct {
+	ID           string `json:"id"`
+	Abbreviation string `json:"abbreviation"`
+	Name         string `json:"name"`
+}
+
+// logoEditRequest is a logo position to preview or save. Side is HOME for the logo on the left
+// of the scoreboard and AWAY for the one on the right.
+type logoEditRequest struct {
+	Team   string  `json:"team"`
+	Side   string  `json:"side"`
+	Width  int     `json:"width"`
+	Height int     `json:"height"`
+	XShift int     `json:"xShift"`
+	YShift int     `json:"yShift"`
+	Zoom   float64 `json:"zoom"`
+}
+
+func (r *logoEditRequest) validate() error {
+	r.Side = strings.ToUpper(r.Side)
+	if r.Team == "" {
+		return fmt.Errorf("team is required")
+	}
+	if r.Side != "HOME" && r.Side != "AWAY" {
+		return fmt.Errorf("side must be HOME or AWAY")
+	}
+	if r.Width < 1 || r.Height < 1 {
+		return fmt.Errorf("invalid matrix size %dx%d", r.Width, r.Height)
+	}
+	return nil
+}
+
+func (r *logoEditRequest) bounds() image.Rectangle {
+	return image.Rect(0, 0, r.Width, r.Height)
+}
+
+func (r *logoEditRequest) logoKey() string {
+	return fmt.Sprintf("%s_%s_%dx%d", r.Team, r.Side, r.Width, r.Height)
+}
+
+// logoEditQuery reads a logoEditRequest from the URL query. The shift and zoom are optional.
+func logoEditQuery(req *http.Request) (*logoEditRequest, error) {
+	q := req.URL.Query()
+	r := &logoEditRequest{
+		Team: q.Get("team"),
+		Side: q.Get("side"),
+	}
+
+	ints := map[string]*int{
+		"width":  &r.Width,
+		"height": &r.Height,
+		"xShift": &r.XShift,
+		"yShift": &r.YShift,
+	}
+	for name, v := range ints {
+		if q.Get(name) == "" {
+			continue
+		}
+		i, err := strconv.Atoi(q.Get(name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		*v = i
+	}
+
+	if z := q.Get("zoom"); z != "" {
+		f, err := strconv.ParseFloat(z, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid zoom: %w", err)
+		}
+		r.Zoom = f
+	}
+
+	return r, r.validate()
+}
+
+// logoEditorHandlers serve the web UI's logo position editor
+//
+//nolint:contextcheck
+func (s *SportBoard) logoEditorHandlers() []*board.HTTPHandler {
+	editor, ok := s.api.(LogoEditor)
+	if !ok {
+		return nil
+	}
+
+	return []*board.HTTPHandler{
+		{
+			Path: fmt.Sprintf("/%s/logos/teams", s.api.HTTPPathPrefix()),
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+
+				teams, err := s.api.GetTeams(ctx)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				var list []*logoEditTeam
+				for _, t := range teams {
+					list = append(list, &logoEditTeam{
+						ID:           t.GetID(),
+						Abbreviation: t.GetAbbreviation(),
+						Name:         t.GetDisplayName(),
+					})
+				}
+				sort.SliceStable(list, func(i, j int) bool {
+					return list[i].Abbreviation < list[j].Abbreviation
+				})
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(list)
+			},
+		},
+		{
+			Path: fmt.Sprintf("/%s/logos/config", s.api.HTTPPathPrefix()),
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				r, err := logoEditQuery(req)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+
+				_, conf, err := s.editLogo(ctx, r)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(conf)
+			},
+		},
+		{
+			Path: fmt.Sprintf("/%s/logos/preview", s.api.HTTPPathPrefix()),
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				r, err := logoEditQuery(req)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+
+				img, err := s.previewLogo(ctx, r)
+				if err != nil {
+					s.log.Error("failed to preview logo",
+						zap.String("key", r.logoKey()),
+						zap.Error(err),
+					)
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				w.Header().Set("Content-Type", "image/png")
+				w.Header().Set("Cache-Control", "no-store")
+				_ = png.Encode(w, img)
+			},
+		},
+		{
+			Path: fmt.Sprintf("/%s/logos/save", s.api.HTTPPathPrefix()),
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				var r *logoEditRequest
+				if err := json.NewDecoder(req.Body).Decode(&r); err != nil || r == nil {
+					http.Error(w, "invalid logo position", http.StatusBadRequest)
+					return
+				}
+				if err := r.validate(); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+
+				_, current, err := s.editLogo(ctx, r)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				conf := previewConfig(current, r)
+				if err := editor.SaveLogoConfig(r.logoKey(), conf, r.bounds()); err != nil {
+					s.log.Error("failed to save logo position",
+						zap.String("key", r.logoKey()),
+						zap.Error(err),
+					)
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				s.log.Info("saved logo position",
+					zap.String("key", r.logoKey()),
+					zap.Int("xShift", conf.Pt.X),
+					zap.Int("yShift", conf.Pt.Y),
+					zap.Float64("zoom", conf.Pt.Zoom),
+				)
+				s.clearLogoCache(r.logoKey())
+			},
+		},
+	}
+}
+
+// editLogo gets the logo being edited and the Config it's currently rendered with. A logo that's
+// fitted automatically returns its fitted Config, so that editing starts from what's on screen.
+func (s *SportBoard) editLogo(ctx context.Context, r *logoEditRequest) (*logo.Logo, *logo.Config, error) {
+	bounds := r.bounds()
+
+	l, err := s.api.GetLogo(ctx, r.logoKey(), s.logoConfig(r.logoKey(), bounds), bounds)
+	if err != nil {
+		return nil, nil, err
+	}
+	if l == nil {
+		return nil, nil, fmt.Errorf("logo %s was nil", r.logoKey())
+	}
+
+	conf, err := l.Fitted(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return l, conf, nil
+}
+
+// previewConfig is the current Config of the logo being edited with the requested shift and zoom
+func previewConfig(current *logo.Config, r *logoEditRequest) *logo.Config {
+	conf := &logo.Config{
+		Abbrev:   current.Abbrev,
+		XSize:    r.Width,
+		YSize:    r.Height,
+		FitImage: current.FitImage,
+		Trim:     current.Trim,
+		Pt: &logo.Pt{
+			X:    r.XShift,
+			Y:    r.YShift,
+			Zoom: r.Zoom,
+		},
+	}
+	if conf.Pt.Zoom <= 0 {
+		conf.Pt.Zoom = 1
+		if current.Pt != nil {
+			conf.Pt.Zoom = current.Pt.Zoom
+		}
+	}
+
+	return conf
+}
+
+// previewLogo renders a logo at the requested position on a blank matrix, exactly as the
+// scoreboard would place it
+func (s *SportBoard) previewLogo(ctx context.Context, r *logoEditRequest) (image.Image, error) {
+	bounds := r.bounds()
+
+	l, current, err := s.editLogo(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	preview := l.WithConfig(previewConfig(current, r))
+	preview.SetLogger(s.log)
+
+	var rendered image.Image
+	if r.Side == "HOME" {
+		rendered, _, err = s.drawLeftLogo(ctx, bounds, r.Team, preview)
+	} else {
+		rendered, _, err = s.drawRightLogo(ctx, bounds, r.Team, preview)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(bounds)
+	draw.Draw(img, bounds, image.Black, image.Point{}, draw.Src)
+	draw.Draw(img, rendered.Bounds(), rendered, rendered.Bounds().Min, draw.Over)
+
+	return img, nil
+}
+
+// clearLogoCache drops a logo so that it's fetched again with its new position
+func (s *SportBoard) clearLogoCache(logoKey string) {
+	s.drawLock.Lock()
+	delete(s.logoDrawCache, logoKey)
+	s.drawLock.Unlock()
+
+	s.logoLock.Lock()
+	delete(s.logos, logoKey)
+	s.logoLock.Unlock()
+}
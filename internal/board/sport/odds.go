@@ -0,0 +1,163 @@
+package sportboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/robbydyer/sports/internal/board"
+	linesboard "github.com/robbydyer/sports/internal/board/lines"
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+var (
+	awayProbabilityColor = color.RGBA{0, 0, 255, 255}
+	homeProbabilityColor = color.RGBA{255, 0, 0, 255}
+)
+
+// LinesGame is implemented by a Game with detailed betting lines
+type LinesGame interface {
+	GetLines() (*linesboard.Line, error)
+}
+
+// WinProbabilityGame is implemented by a Game that knows each team's chance of winning
+type WinProbabilityGame interface {
+	// GetWinProbability returns the home team's chance of winning, from 0 to 1
+	GetWinProbability(ctx context.Context) (float64, error)
+}
+
+// ColorTeam is implemented by a Team with a primary color
+type ColorTeam interface {
+	GetColor() color.Color
+}
+
+// oddsStr returns the favorite's spread and the over/under, such as "KC -3.5" and "O/U 47.5"
+func oddsStr(game Game) ([]string, error) {
+	if lg, ok := game.(LinesGame); ok {
+		if line, err := lg.GetLines(); err == nil && line.Home != nil && line.Away != nil {
+			fav := line.Home
+			if line.Away.Spread < line.Home.Spread {
+				fav = line.Away
+			}
+			lines := []string{fmt.Sprintf("%s %s", fav.Abbreviation, linesboard.FormatSpread(fav.Spread))}
+			if total := linesboard.FormatTotal(line.Total); total != "" {
+				lines = append(lines, fmt.Sprintf("O/U %s", total))
+			}
+			return lines, nil
+		}
+	}
+
+	team, spread, err := game.GetOdds()
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{fmt.Sprintf("%s %s", team, spread)}, nil
+}
+
+// oddsLayer writes an upcoming game's odds above the "VS"
+func (s *SportBoard) oddsLayer(canvas board.Canvas, game Game) (*rgbrender.TextLayer, error) {
+	// Get the writers up front, as the layers are prepared concurrently
+	scoreWriter, err := s.getScoreWriter(canvas.Bounds())
+	if err != nil {
+		return nil, err
+	}
+	scoreHeight := int(math.Ceil(scoreWriter.FontSize))
+	writer, err := s.getTimeWriter(canvas.Bounds())
+	if err != nil {
+		return nil, err
+	}
+
+	return rgbrender.NewTextLayer(
+		func(ctx context.Context) (*rgbrender.TextWriter, []string, error) {
+			odds, err := oddsStr(game)
+			if err != nil {
+				// Plenty of games don't have odds
+				return nil, nil, nil
+			}
+			return writer, odds, nil
+		},
+		func(canvas board.Canvas, writer *rgbrender.TextWriter, text []string) error {
+			if len(text) < 1 {
+				return nil
+			}
+			bounds := rgbrender.ZeroedBounds(canvas.Bounds())
+			bounds.Max.Y -= scoreHeight
+
+			return writer.WriteAlignedBoxed(
+				rgbrender.CenterBottom,
+				canvas,
+				bounds,
+				text,
+				s.timeColor(),
+				s.writeBoxColor(),
+			)
+		},
+	), nil
+}
+
+// winProbabilityLayer draws a live game's win probability as a bar along the bottom of the canvas,
+// each team's share on its own side
+func (s *SportBoard) winProbabilityLayer(canvas board.Canvas, game Game) *rgbrender.Layer {
+	wp, ok := game.(WinProbabilityGame)
+	if !ok {
+		return nil
+	}
+	canvasBounds := canvas.Bounds()
+
+	return rgbrender.NewLayer(
+		func(ctx context.Context) (image.Image, error) {
+			p, err := wp.GetWinProbability(ctx)
+			if err != nil {
+				// Not every league or game has a win probability
+				return nil, nil
+			}
+
+			home, err := game.HomeTeam()
+			if err != nil {
+				return nil, err
+			}
+			away, err := game.AwayTeam()
+			if err != nil {
+				return nil, err
+			}
+			homeClr := teamColor(home, homeProbabilityColor)
+			awayClr := teamColor(away, awayProbabilityColor)
+
+			img := image.NewRGBA(canvasBounds)
+			bounds := rgbrender.ZeroedBounds(canvasBounds)
+			barH := bounds.Dy() / 32
+			if barH < 1 {
+				barH = 1
+			}
+			bar := image.Rect(bounds.Min.X, bounds.Max.Y-barH, bounds.Max.X, bounds.Max.Y)
+
+			if s.homeSide() == left {
+				linesboard.DrawWinProbability(img, bar, p, homeClr, awayClr)
+			} else {
+				linesboard.DrawWinProbability(img, bar, 1-p, awayClr, homeClr)
+			}
+
+			return img, nil
+		},
+		func(canvas board.Canvas, img image.Image) error {
+			if img == nil {
+				return nil
+			}
+			draw.Draw(canvas, img.Bounds(), img, img.Bounds().Min, draw.Over)
+			return nil
+		},
+	)
+}
+
+func teamColor(t Team, fallback color.Color) color.Color {
+	if ct, ok := t.(ColorTeam); ok {
+		if clr := ct.GetColor(); clr != nil {
+			return clr
+		}
+	}
+	return fallback
+}
@@ -6,6 +6,7 @@ import (
 	"image"
 	"image/color"
 	"image/draw"
+	"math"
 	"strings"
 	"time"
 
@@ -22,8 +23,6 @@ const (
 )
 
 var (
-	red                   = color.RGBA{255, 0, 0, 255}
-	green                 = color.RGBA{0, 255, 0, 255}
 	infoLayerPriority     = rgbrender.BackgroundPriority + 2
 	counterLayerPriority  = rgbrender.ForegroundPriority
 	scoreLayerPriority    = rgbrender.BackgroundPriority + 3
@@ -71,8 +70,8 @@ LOOP:
 				s.api.League(),
 				"Loading...",
 			},
-			color.White,
-			color.Black,
+			s.theme().TextColor(),
+			s.theme().BackgroundColor(),
 		)
 		if err := canvas.Render(ctx); err != nil {
 			return
@@ -145,7 +144,7 @@ func (s *SportBoard) renderLiveGame(ctx context.Context, canvas board.Canvas, li
 				if err != nil {
 					return nil, nil, err
 				}
-				return writer, []string{quarter, clock}, nil
+				return writer, []string{s.locale().Label(quarter), clock}, nil
 			},
 			func(canvas board.Canvas, writer *rgbrender.TextWriter, text []string) error {
 				return writer.WriteAlignedBoxed(
@@ -153,7 +152,7 @@ func (s *SportBoard) renderLiveGame(ctx context.Context, canvas board.Canvas, li
 					canvas,
 					rgbrender.ZeroedBounds(canvas.Bounds()),
 					text,
-					s.config.TimeColor,
+					s.timeColor(),
 					s.writeBoxColor(),
 				)
 			},
@@ -195,47 +194,48 @@ func (s *SportBoard) renderLiveGame(ctx context.Context, canvas board.Canvas, li
 				prev := s.storeOrGetPreviousScore(liveGame.GetID(), a.Score(), h.Score())
 				var chars []string
 				var clrs []color.Color
+				th := s.theme()
 				if s.homeSide() == left {
 					chars = []string{
-						fmt.Sprintf("%d", hScore),
+						teamScore(h),
 						"-",
-						fmt.Sprintf("%d", aScore),
+						teamScore(a),
 					}
 					if prev.home.hasScored(hScore) {
-						clrs = append(clrs, red)
+						clrs = append(clrs, th.ScoredColor())
 						s.log.Debug("home team scored")
 					} else {
-						clrs = append(clrs, color.White)
+						clrs = append(clrs, s.teamScoreColor(h))
 					}
-					clrs = append(clrs, color.White)
+					clrs = append(clrs, s.scoreColor())
 					if prev.away.hasScored(aScore) {
-						clrs = append(clrs, red)
+						clrs = append(clrs, th.ScoredColor())
 						s.log.Debug("away team scored")
 					} else {
-						clrs = append(clrs, color.White)
+						clrs = append(clrs, s.teamScoreColor(a))
 					}
 				} else {
 					chars = []string{
-						fmt.Sprintf("%d", aScore),
+						teamScore(a),
 						"-",
-						fmt.Sprintf("%d", hScore),
+						teamScore(h),
 					}
 					if prev.away.hasScored(aScore) {
-						clrs = append(clrs, red)
+						clrs = append(clrs, th.ScoredColor())
 						s.log.Debug("away team scored")
 					} else {
-						clrs = append(clrs, color.White)
+						clrs = append(clrs, s.teamScoreColor(a))
 					}
-					clrs = append(clrs, color.White)
+					clrs = append(clrs, s.scoreColor())
 					if prev.home.hasScored(hScore) {
-						clrs = append(clrs, red)
+						clrs = append(clrs, th.ScoredColor())
 						s.log.Debug("home team scored")
 					} else {
-						clrs = append(clrs, color.White)
+						clrs = append(clrs, s.teamScoreColor(h))
 					}
 				}
 				clrCodes := &rgbrender.ColorChar{
-					BoxClr: color.Black,
+					BoxClr: th.BackgroundColor(),
 					Lines: []*rgbrender.ColorCharLine{
 						{
 							Chars: chars,
@@ -258,6 +258,18 @@ func (s *SportBoard) renderLiveGame(ctx context.Context, canvas board.Canvas, li
 		),
 	)
 
+	subDetail, err := s.subDetailLayer(canvas, liveGame)
+	if err != nil {
+		return err
+	}
+	layers.AddTextLayer(scoreLayerPriority, subDetail)
+
+	if s.config.ShowOdds.Load() {
+		if l := s.winProbabilityLayer(canvas, liveGame); l != nil {
+			layers.AddLayer(scoreLayerPriority, l)
+		}
+	}
+
 	if counter != nil {
 		layers.AddLayer(counterLayerPriority, counterLayer(counter))
 	}
@@ -316,19 +328,15 @@ func (s *SportBoard) renderUpcomingGame(ctx context.Context, canvas board.Canvas
 				dateStr := ""
 				if is, err := liveGame.IsPostponed(); err == nil && is {
 					s.log.Debug("game was postponed", zap.Int("game ID", liveGame.GetID()))
-					gameTimeStr = "PPD"
+					gameTimeStr = s.locale().Label("PPD")
 				} else {
 					gameTime, err := liveGame.GetStartTime(ctx)
 					if err != nil {
 						return nil, nil, err
 					}
-					gameTimeStr = gameTime.Local().Format("3:04PM")
-					if gameTime.Local().Format("01/02/2006") != time.Now().Local().Format("01/02/2006") {
-						dateStr = gameTime.Local().Format("01/02")
-					}
-
-					if s.config.Enable24Hour.Load() {
-						gameTimeStr = gameTime.Local().Format("15:04")
+					gameTimeStr = s.locale().Time(gameTime)
+					if !s.locale().IsToday(gameTime) {
+						dateStr = s.locale().ShortDate(gameTime)
 					}
 
 					s.log.Debug("game time",
@@ -344,7 +352,7 @@ func (s *SportBoard) renderUpcomingGame(ctx context.Context, canvas board.Canvas
 					canvas,
 					rgbrender.ZeroedBounds(canvas.Bounds()),
 					text,
-					s.config.TimeColor,
+					s.timeColor(),
 					s.writeBoxColor(),
 				)
 			},
@@ -365,13 +373,21 @@ func (s *SportBoard) renderUpcomingGame(ctx context.Context, canvas board.Canvas
 					canvas,
 					rgbrender.ZeroedBounds(canvas.Bounds()),
 					text,
-					s.config.ScoreColor,
+					s.scoreColor(),
 					s.writeBoxColor(),
 				)
 			},
 		),
 	)
 
+	if s.config.ShowOdds.Load() {
+		odds, err := s.oddsLayer(canvas, liveGame)
+		if err != nil {
+			return err
+		}
+		layers.AddTextLayer(scoreLayerPriority, odds)
+	}
+
 	select {
 	case <-ctx.Done():
 		return fmt.Errorf("context canceled")
@@ -424,7 +440,7 @@ func (s *SportBoard) renderCompleteGame(ctx context.Context, canvas board.Canvas
 				if err != nil {
 					return nil, nil, err
 				}
-				return writer, []string{"FINAL"}, nil
+				return writer, []string{s.finalStr(liveGame)}, nil
 			},
 			func(canvas board.Canvas, writer *rgbrender.TextWriter, text []string) error {
 				return writer.WriteAlignedBoxed(
@@ -432,7 +448,7 @@ func (s *SportBoard) renderCompleteGame(ctx context.Context, canvas board.Canvas
 					canvas,
 					rgbrender.ZeroedBounds(canvas.Bounds()),
 					text,
-					s.config.TimeColor,
+					s.timeColor(),
 					s.writeBoxColor(),
 				)
 			},
@@ -469,13 +485,19 @@ func (s *SportBoard) renderCompleteGame(ctx context.Context, canvas board.Canvas
 					canvas,
 					rgbrender.ZeroedBounds(canvas.Bounds()),
 					text,
-					s.config.ScoreColor,
+					s.scoreColor(),
 					s.writeBoxColor(),
 				)
 			},
 		),
 	)
 
+	subDetail, err := s.subDetailLayer(canvas, liveGame)
+	if err != nil {
+		return err
+	}
+	layers.AddTextLayer(scoreLayerPriority, subDetail)
+
 	if counter != nil {
 		layers.AddLayer(counterLayerPriority, counterLayer(counter))
 	}
@@ -483,6 +505,56 @@ func (s *SportBoard) renderCompleteGame(ctx context.Context, canvas board.Canvas
 	return layers.Draw(ctx, canvas)
 }
 
+// subDetailLayer writes the RichScoreTeam sub-details, such as overs or tries, just above the score
+func (s *SportBoard) subDetailLayer(canvas board.Canvas, liveGame Game) (*rgbrender.TextLayer, error) {
+	// Get the writers up front, as the layers are prepared concurrently
+	scoreWriter, err := s.getScoreWriter(canvas.Bounds())
+	if err != nil {
+		return nil, err
+	}
+	scoreHeight := int(math.Ceil(scoreWriter.FontSize))
+	writer, err := s.getTimeWriter(canvas.Bounds())
+	if err != nil {
+		return nil, err
+	}
+
+	return rgbrender.NewTextLayer(
+		func(ctx context.Context) (*rgbrender.TextWriter, []string, error) {
+			isFavorite, err := s.isFavoriteGame(liveGame)
+			if err != nil {
+				return nil, nil, err
+			}
+			if s.config.HideFavoriteScore.Load() && isFavorite {
+				return nil, nil, nil
+			}
+			detail, err := subDetailStr(liveGame, s.homeSide())
+			if err != nil {
+				return nil, nil, err
+			}
+			if detail == "" {
+				return nil, nil, nil
+			}
+			return writer, []string{detail}, nil
+		},
+		func(canvas board.Canvas, writer *rgbrender.TextWriter, text []string) error {
+			if len(text) < 1 {
+				return nil
+			}
+			bounds := rgbrender.ZeroedBounds(canvas.Bounds())
+			bounds.Max.Y -= scoreHeight
+
+			return writer.WriteAlignedBoxed(
+				rgbrender.CenterBottom,
+				canvas,
+				bounds,
+				text,
+				s.timeColor(),
+				s.writeBoxColor(),
+			)
+		},
+	), nil
+}
+
 func counterLayer(counter image.Image) *rgbrender.Layer {
 	if counter == nil {
 		return nil
@@ -511,7 +583,7 @@ func (s *SportBoard) logoLayers(liveGame Game, bounds image.Rectangle) ([]*rgbre
 		leftTeam, rightTeam = rightTeam, leftTeam
 	}
 
-	return []*rgbrender.Layer{
+	layers := []*rgbrender.Layer{
 		rgbrender.NewLayer(
 			func(ctx context.Context) (image.Image, error) {
 				l, err := s.RenderLeftLogo(ctx, bounds, leftTeam.GetID())
@@ -534,7 +606,7 @@ func (s *SportBoard) logoLayers(liveGame Game, bounds image.Rectangle) ([]*rgbre
 						canvas,
 						rgbrender.ZeroedBounds(bounds),
 						[]string{leftTeam.GetAbbreviation()},
-						color.White,
+						s.theme().TextColor(),
 					)
 					return nil
 				}
@@ -579,7 +651,7 @@ func (s *SportBoard) logoLayers(liveGame Game, bounds image.Rectangle) ([]*rgbre
 						canvas,
 						rgbrender.ZeroedBounds(bounds),
 						[]string{rightTeam.GetAbbreviation()},
-						color.White,
+						s.theme().TextColor(),
 					)
 					return nil
 				}
@@ -588,7 +660,13 @@ func (s *SportBoard) logoLayers(liveGame Game, bounds image.Rectangle) ([]*rgbre
 				return nil
 			},
 		),
-	}, nil
+	}
+
+	if s.useTeamColors() {
+		layers = append(layers, s.teamBorderLayer(bounds, leftTeam, rightTeam))
+	}
+
+	return layers, nil
 }
 
 func (s *SportBoard) gradientLayer(bounds image.Rectangle, scoreLen int) []*rgbrender.Layer {
@@ -623,7 +701,7 @@ func (s *SportBoard) gradientLayer(bounds image.Rectangle, scoreLen int) []*rgbr
 	return []*rgbrender.Layer{
 		rgbrender.NewLayer(
 			func(ctx context.Context) (image.Image, error) {
-				gradient := rgbrender.GradientXRectangle(gradientBounds, fillPct, color.Black, s.log)
+				gradient := rgbrender.GradientXRectangle(gradientBounds, fillPct, s.theme().BackgroundColor(), s.log)
 				return gradient, nil
 			},
 			func(canvas board.Canvas, img image.Image) error {
@@ -718,8 +796,8 @@ func (s *SportBoard) teamInfoLayers(canvas draw.Image, liveGame Game, bounds ima
 						canvas,
 						rankBounds,
 						[]string{rank},
-						green,
-						color.Black,
+						s.theme().FavoriteColor(),
+						s.theme().BackgroundColor(),
 					)
 				}
 				if record != "" && s.config.ShowRecord.Load() {
@@ -728,8 +806,8 @@ func (s *SportBoard) teamInfoLayers(canvas draw.Image, liveGame Game, bounds ima
 						canvas,
 						leftBounds,
 						[]string{record},
-						color.White,
-						color.Black,
+						s.theme().TextColor(),
+						s.theme().BackgroundColor(),
 					)
 				}
 				return nil
@@ -786,8 +864,8 @@ func (s *SportBoard) teamInfoLayers(canvas draw.Image, liveGame Game, bounds ima
 						canvas,
 						rankBounds,
 						[]string{rank},
-						green,
-						color.Black,
+						s.theme().FavoriteColor(),
+						s.theme().BackgroundColor(),
 					)
 				}
 				if record != "" && s.config.ShowRecord.Load() {
@@ -796,8 +874,8 @@ func (s *SportBoard) teamInfoLayers(canvas draw.Image, liveGame Game, bounds ima
 						canvas,
 						rightBounds,
 						[]string{record},
-						color.White,
-						color.Black,
+						s.theme().TextColor(),
+						s.theme().BackgroundColor(),
 					)
 				}
 				return nil
@@ -828,21 +906,17 @@ func (s *SportBoard) renderNoScheduled(ctx context.Context, canvas board.Canvas)
 		[]string{
 			s.api.League(),
 		},
-		color.White,
-		color.Black,
+		s.theme().TextColor(),
+		s.theme().BackgroundColor(),
 	)
 
 	_ = writer.WriteAlignedBoxed(
 		rgbrender.RightCenter,
 		canvas,
 		writeBox,
-		[]string{
-			"No",
-			"Games",
-			"Today",
-		},
-		color.White,
-		color.Black,
+		s.locale().Labels("No", "Games", "Today"),
+		s.theme().TextColor(),
+		s.theme().BackgroundColor(),
 	)
 
 	if err := canvas.Render(ctx); err != nil {
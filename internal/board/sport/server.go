@@ -52,6 +52,9 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.
 	if s.board.config.ShowLeagueLogo.CompareAndSwap(!req.Status.ShowLeagueLogo, req.Status.ShowLeagueLogo) {
 		clearDrawCache = true
 	}
+	if s.board.config.ShowOdds.CompareAndSwap(!req.Status.OddsEnabled, req.Status.OddsEnabled) {
+		cancelBoard = true
+	}
 
 	if clearDrawCache {
 		s.board.clearDrawCache()
@@ -75,6 +78,7 @@ func (s *Server) GetStatus(ctx context.Context, req *emptypb.Empty) (*pb.StatusR
 			UseGradient:       s.board.config.UseGradient.Load(),
 			LiveOnly:          s.board.config.LiveOnly.Load(),
 			ShowLeagueLogo:    s.board.config.ShowLeagueLogo.Load(),
+			OddsEnabled:       s.board.config.ShowOdds.Load(),
 		},
 	}, nil
 }
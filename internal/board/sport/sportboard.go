@@ -62,6 +62,8 @@ type SportBoard struct {
 	enabler              board.Enabler
 	detailedLiveRenderer DetailedLiveRender
 	leagueLogoGetter     logo.SourceGetter
+	boxScoreBoard        *statboard.StatBoard
+	reporter             board.Reporter
 	sync.Mutex
 }
 
@@ -75,6 +77,7 @@ type Config struct {
 	TodayFunc            Todayer
 	boardDelay           time.Duration
 	stickyDelay          *time.Duration
+	scrollDelay          time.Duration
 	TimeColor            color.Color
 	ScoreColor           color.Color
 	StartEnabled         *atomic.Bool      `json:"enabled"`
@@ -84,6 +87,9 @@ type Config struct {
 	ScoreFont            *FontConfig       `json:"scoreFont"`
 	TimeFont             *FontConfig       `json:"timeFont"`
 	LiveViewFont         *FontConfig       `json:"liveViewFont"`
+	TickerFont           *FontConfig       `json:"tickerFont"`
+	Theme                string            `json:"theme"`
+	TeamColors           *atomic.Bool      `json:"teamColors"`
 	LogoConfigs          []*logo.Config    `json:"logoConfigs"`
 	WatchTeams           []string          `json:"watchTeams"`
 	FavoriteTeams        []string          `json:"favoriteTeams"`
@@ -106,13 +112,15 @@ type Config struct {
 	Enable24Hour         *atomic.Bool      `json:"enable24Hour"`
 	AdvanceDays          int               `json:"advanceDays"`
 	PreviousDays         int               `json:"previousDays"`
+	ShowBoxScore         *atomic.Bool      `json:"showBoxScore"`
+	ShowOdds             *atomic.Bool      `json:"showOdds"`
+	TickerMode           *atomic.Bool      `json:"tickerMode"`
+	ScrollDelay          string            `json:"scrollDelay"`
+	TightScrollPadding   int               `json:"tightScrollPadding"`
 }
 
 // FontConfig ...
-type FontConfig struct {
-	Size      float64 `json:"size"`
-	LineSpace float64 `json:"lineSpace"`
-}
+type FontConfig = rgbrender.FontConfig
 
 // API ...
 type API interface {
@@ -142,6 +150,16 @@ type Team interface {
 	ConferenceName() string
 }
 
+// RichScoreTeam is an optional interface for a Team whose score doesn't fit in a single number,
+// such as cricket's runs and wickets or rugby's tries
+type RichScoreTeam interface {
+	Team
+	// ScoreDetail is the score as it's displayed, such as "187/4". Empty falls back to Score().
+	ScoreDetail() string
+	// ScoreSubDetail is shown beneath the score, such as "18.2 ov" or "3T"
+	ScoreSubDetail() string
+}
+
 // Game ...
 type Game interface {
 	GetID() int
@@ -158,6 +176,13 @@ type Game interface {
 	GetOdds() (string, string, error)
 }
 
+// RichScoreGame is an optional interface for a Game with a result summary, such as
+// "MI won by 5 wkts"
+type RichScoreGame interface {
+	Game
+	GetSummary() (string, error)
+}
+
 // SetDefaults sets config defaults
 func (c *Config) SetDefaults() {
 	if c.BoardDelay != "" {
@@ -170,12 +195,6 @@ func (c *Config) SetDefaults() {
 		c.boardDelay = 10 * time.Second
 	}
 
-	if c.TimeColor == nil {
-		c.TimeColor = color.White
-	}
-	if c.ScoreColor == nil {
-		c.ScoreColor = color.White
-	}
 	if c.HideFavoriteScore == nil {
 		c.HideFavoriteScore = atomic.NewBool(false)
 	}
@@ -212,9 +231,18 @@ func (c *Config) SetDefaults() {
 		c.ShowLeagueLogo = atomic.NewBool(false)
 	}
 
-	if c.Enable24Hour == nil {
-		c.Enable24Hour = atomic.NewBool(false)
+	if c.ShowBoxScore == nil {
+		c.ShowBoxScore = atomic.NewBool(false)
+	}
+
+	if c.ShowOdds == nil {
+		c.ShowOdds = atomic.NewBool(false)
 	}
+
+	if c.TickerMode == nil {
+		c.TickerMode = atomic.NewBool(false)
+	}
+	c.scrollDelay = parseScrollDelay(c.ScrollDelay)
 }
 
 // New ...
@@ -318,7 +346,7 @@ func New(ctx context.Context, api API, bounds image.Rectangle, today *time.Time,
 		s.log.Info("sportboard turning off",
 			zap.String("league", s.api.League()),
 		)
-		s.Enabler().Disable()
+		board.DisableScheduled(s.Enabler())
 	}); err != nil {
 		return nil, err
 	}
@@ -329,6 +357,14 @@ func New(ctx context.Context, api API, bounds image.Rectangle, today *time.Time,
 		}
 	}
 
+	if s.boxScoreBoard == nil {
+		if statAPI, ok := api.(statboard.API); ok {
+			if err := WithBoxScoreAPI(statAPI)(s); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return s, nil
 }
 
@@ -379,7 +415,7 @@ func (s *SportBoard) InBetween() bool {
 
 // ScrollMode ...
 func (s *SportBoard) ScrollMode() bool {
-	return false
+	return s.config.TickerMode.Load()
 }
 
 // SetLiveOnly sets this board to show only live games or not
@@ -432,6 +468,10 @@ func (s *SportBoard) callCancelBoard() {
 
 // Render ...
 func (s *SportBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	if canvas.Scrollable() && s.config.TickerMode.Load() {
+		return s.renderTicker(ctx, canvas)
+	}
+
 	err := s.render(ctx, canvas)
 	if err != nil {
 		return err
@@ -456,95 +496,11 @@ func (s *SportBoard) render(ctx context.Context, canvas board.Canvas) error {
 	defer loadCancel()
 	go s.renderLoading(loadCtx, canvas)
 
-	allGames, err := s.api.GetScheduledGames(s.renderCtx, s.config.TodayFunc())
+	games, err := s.watchedGames(s.renderCtx)
 	if err != nil {
-		s.log.Error("failed to get scheduled games",
-			zap.String("league", s.api.League()),
-			zap.Error(err),
-		)
-		return err
-	}
-
-	if len(allGames) < 1 {
-		s.log.Debug("no games scheduled",
-			zap.String("league", s.api.League()),
-		)
-		todays := s.config.TodayFunc()
-		today := ""
-		if len(todays) > 0 {
-			today = todays[0].String()
-		}
-		return fmt.Errorf("no games scheduled for %s on %s", s.api.League(), today)
-	}
-
-	if _, err := s.api.GetTeams(ctx); err != nil {
 		return err
 	}
 
-	// Determine which games are watched so that the game counter is accurate
-	if len(s.watchTeams) < 1 {
-		s.log.Debug("fetching watch teams",
-			zap.String("league", s.api.League()),
-		)
-		s.watchTeams = s.api.GetWatchTeams(s.config.WatchTeams, s.season())
-		s.log.Debug("watch teams",
-			zap.String("league", s.api.League()),
-			zap.Strings("teams", s.watchTeams),
-		)
-	}
-
-	var games []Game
-OUTER:
-	for _, game := range allGames {
-		home, err := game.HomeTeam()
-		if err != nil {
-			s.log.Error("failed to get home team", zap.Error(err))
-			continue OUTER
-		}
-		away, err := game.AwayTeam()
-		if err != nil {
-			s.log.Error("failed to get away team", zap.Error(err))
-			continue OUTER
-		}
-		s.log.Debug("checking teams for watching",
-			zap.String("home", home.GetAbbreviation()),
-			zap.String("home ID", home.GetID()),
-			zap.String("away", away.GetAbbreviation()),
-			zap.String("away ID", away.GetID()),
-		)
-		for _, watchTeamID := range s.watchTeams {
-			if home.GetID() == watchTeamID || away.GetID() == watchTeamID {
-				isLive, err := game.IsLive()
-				if err != nil {
-					s.log.Error("failed to determine if game is live",
-						zap.Error(err),
-					)
-					continue OUTER
-				}
-
-				if (s.config.LiveOnly.Load() && isLive) || !s.config.LiveOnly.Load() {
-					games = append(games, game)
-
-					// Ensures the daily data for this team has been fetched
-					_ = s.api.TeamRecord(s.renderCtx, home, s.season())
-					_ = s.api.TeamRecord(s.renderCtx, away, s.season())
-				}
-				continue OUTER
-			}
-		}
-	}
-
-	var todays []string
-	for _, t := range s.config.TodayFunc() {
-		todays = append(todays, t.String())
-	}
-	s.log.Debug("scheduled games today",
-		zap.Int("watched games", len(games)),
-		zap.Int("num games", len(allGames)),
-		zap.Strings("todays", todays),
-		zap.String("league", s.api.League()),
-	)
-
 	select {
 	case <-s.renderCtx.Done():
 		return context.Canceled
@@ -575,6 +531,7 @@ OUTER:
 	s.logCanvas(canvas, "sportboard Render() called canvas after grid")
 	if len(games) < 1 {
 		s.log.Debug("no scheduled games, not rendering", zap.String("league", s.api.League()))
+		s.reportNoGames()
 		if !s.config.ShowNoScheduledLogo.Load() {
 			loadCancel()
 			return fmt.Errorf("no schedule games for %s", s.api.League())
@@ -677,6 +634,8 @@ GAMES:
 		stickyStart := time.Now()
 		stickyDelay := s.getStickyDelay()
 
+		s.reportGame(cachedGame)
+
 	FAV:
 		for {
 			if err := s.renderGame(s.renderCtx, canvas, cachedGame, counter); err != nil {
@@ -695,6 +654,13 @@ GAMES:
 			case <-time.After(s.config.boardDelay):
 			}
 
+			if err := s.renderBoxScore(s.renderCtx, canvas, cachedGame); err != nil {
+				s.log.Error("failed to render box score",
+					zap.String("league", s.api.League()),
+					zap.Error(err),
+				)
+			}
+
 			if !(isFav && s.config.FavoriteSticky.Load()) {
 				break FAV
 			}
@@ -716,6 +682,100 @@ GAMES:
 	return nil
 }
 
+// watchedGames returns the scheduled games that include a watched team
+func (s *SportBoard) watchedGames(ctx context.Context) ([]Game, error) {
+	allGames, err := s.api.GetScheduledGames(ctx, s.config.TodayFunc())
+	if err != nil {
+		s.log.Error("failed to get scheduled games",
+			zap.String("league", s.api.League()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if len(allGames) < 1 {
+		s.log.Debug("no games scheduled",
+			zap.String("league", s.api.League()),
+		)
+		todays := s.config.TodayFunc()
+		today := ""
+		if len(todays) > 0 {
+			today = todays[0].String()
+		}
+		return nil, fmt.Errorf("no games scheduled for %s on %s", s.api.League(), today)
+	}
+
+	if _, err := s.api.GetTeams(ctx); err != nil {
+		return nil, err
+	}
+
+	// Determine which games are watched so that the game counter is accurate
+	if len(s.watchTeams) < 1 {
+		s.log.Debug("fetching watch teams",
+			zap.String("league", s.api.League()),
+		)
+		s.watchTeams = s.api.GetWatchTeams(s.config.WatchTeams, s.season())
+		s.log.Debug("watch teams",
+			zap.String("league", s.api.League()),
+			zap.Strings("teams", s.watchTeams),
+		)
+	}
+
+	var games []Game
+OUTER:
+	for _, game := range allGames {
+		home, err := game.HomeTeam()
+		if err != nil {
+			s.log.Error("failed to get home team", zap.Error(err))
+			continue OUTER
+		}
+		away, err := game.AwayTeam()
+		if err != nil {
+			s.log.Error("failed to get away team", zap.Error(err))
+			continue OUTER
+		}
+		s.log.Debug("checking teams for watching",
+			zap.String("home", home.GetAbbreviation()),
+			zap.String("home ID", home.GetID()),
+			zap.String("away", away.GetAbbreviation()),
+			zap.String("away ID", away.GetID()),
+		)
+		for _, watchTeamID := range s.watchTeams {
+			if home.GetID() == watchTeamID || away.GetID() == watchTeamID {
+				isLive, err := game.IsLive()
+				if err != nil {
+					s.log.Error("failed to determine if game is live",
+						zap.Error(err),
+					)
+					continue OUTER
+				}
+
+				if (s.config.LiveOnly.Load() && isLive) || !s.config.LiveOnly.Load() {
+					games = append(games, game)
+
+					// Ensures the daily data for this team has been fetched
+					_ = s.api.TeamRecord(ctx, home, s.season())
+					_ = s.api.TeamRecord(ctx, away, s.season())
+				}
+				continue OUTER
+			}
+		}
+	}
+
+	var todays []string
+	for _, t := range s.config.TodayFunc() {
+		todays = append(todays, t.String())
+	}
+	s.log.Debug("scheduled games today",
+		zap.Int("watched games", len(games)),
+		zap.Int("num games", len(allGames)),
+		zap.Strings("todays", todays),
+		zap.String("league", s.api.League()),
+	)
+
+	return games, nil
+}
+
 func (s *SportBoard) renderGrid(ctx context.Context, canvas board.Canvas, games []Game, cols int, rows int) error {
 	if len(games) < 1 {
 		return nil
@@ -861,7 +921,7 @@ func (s *SportBoard) doGrid(ctx context.Context, grid *rgbrender.Grid, canvas bo
 		return err
 	}
 
-	grid.FillPadded(canvas, color.White)
+	grid.FillPadded(canvas, s.theme().TextColor())
 
 	draw.Draw(canvas, canvas.Bounds(), counter, image.Point{}, draw.Over)
 
@@ -902,6 +962,8 @@ func (s *SportBoard) renderGame(ctx context.Context, canvas board.Canvas, liveGa
 		}
 	}
 
+	s.drawStaleMarker(canvas)
+
 	return nil
 }
 
@@ -0,0 +1,51 @@
+package sportboard
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+// SetReporter implements board.Reportable
+func (s *SportBoard) SetReporter(r board.Reporter) {
+	s.reporter = r
+}
+
+// reportGame reports the game the board is showing
+func (s *SportBoard) reportGame(game Game) {
+	if s.reporter == nil {
+		return
+	}
+
+	item := &board.Item{
+		ID:       strconv.Itoa(game.GetID()),
+		Duration: s.config.boardDelay,
+	}
+	away, awayErr := game.AwayTeam()
+	home, homeErr := game.HomeTeam()
+	if awayErr == nil && homeErr == nil {
+		item.Teams = []string{away.GetAbbreviation(), home.GetAbbreviation()}
+		item.Label = fmt.Sprintf("%s @ %s", away.GetAbbreviation(), home.GetAbbreviation())
+	}
+
+	s.reporter.Showing(s.Name(), item)
+}
+
+// reportNoGames reports that the board has no games to show
+func (s *SportBoard) reportNoGames() {
+	if s.reporter == nil {
+		return
+	}
+	if s.config.LiveOnly.Load() {
+		s.reporter.Skipped(s.Name(), board.SkipNoLiveGames)
+		return
+	}
+	s.reporter.Skipped(s.Name(), board.SkipNoGames)
+}
+
+// drawStaleMarker marks the top right corner of a game when its data is stale
+func (s *SportBoard) drawStaleMarker(canvas board.Canvas) {
+	board.DrawStaleMarker(s.api, canvas, rgbrender.ZeroedBounds(canvas.Bounds()))
+}
@@ -0,0 +1,98 @@
+package sportboard
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/theme"
+)
+
+// AlternateColorTeam is implemented by a Team with a secondary color
+type AlternateColorTeam interface {
+	GetAlternateColor() color.Color
+}
+
+// theme returns the board's theme, or the global theme if it doesn't choose one
+func (s *SportBoard) theme() *theme.Theme {
+	return theme.Resolve(s.config.Theme)
+}
+
+func (s *SportBoard) timeColor() color.Color {
+	if s.config.TimeColor != nil {
+		return s.config.TimeColor
+	}
+	return s.theme().TimeColor()
+}
+
+func (s *SportBoard) scoreColor() color.Color {
+	if s.config.ScoreColor != nil {
+		return s.config.ScoreColor
+	}
+	return s.theme().ScoreColor()
+}
+
+// useTeamColors determines if accents are drawn in team colors. The board's config overrides
+// its theme.
+func (s *SportBoard) useTeamColors() bool {
+	if s.config.TeamColors != nil {
+		return s.config.TeamColors.Load()
+	}
+	return s.theme().TeamColors
+}
+
+// teamAccent returns a team's color, adjusted to stand out against black, or nil if team
+// colored accents are off or the team has no color
+func (s *SportBoard) teamAccent(t Team) color.Color {
+	if !s.useTeamColors() || t == nil {
+		return nil
+	}
+
+	var primary, alternate color.Color
+	if ct, ok := t.(ColorTeam); ok {
+		primary = ct.GetColor()
+	}
+	if at, ok := t.(AlternateColorTeam); ok {
+		alternate = at.GetAlternateColor()
+	}
+
+	return s.theme().TeamColor(primary, alternate)
+}
+
+// teamScoreColor is the color of a team's score
+func (s *SportBoard) teamScoreColor(t Team) color.Color {
+	if clr := s.teamAccent(t); clr != nil {
+		return clr
+	}
+	return s.scoreColor()
+}
+
+// teamBorderLayer draws a line in each team's color along the bottom of its side of the board
+func (s *SportBoard) teamBorderLayer(bounds image.Rectangle, leftTeam Team, rightTeam Team) *rgbrender.Layer {
+	leftClr := s.teamAccent(leftTeam)
+	rightClr := s.teamAccent(rightTeam)
+
+	return rgbrender.NewLayer(
+		func(ctx context.Context) (image.Image, error) {
+			return nil, nil
+		},
+		func(canvas board.Canvas, img image.Image) error {
+			zeroed := rgbrender.ZeroedBounds(bounds)
+			mid := zeroed.Min.X + (zeroed.Dx() / 2)
+			gap := s.textAreaWidth(zeroed) / 2
+			y := zeroed.Max.Y - 1
+
+			if leftClr != nil {
+				draw.Draw(canvas, image.Rect(zeroed.Min.X, y, mid-gap, y+1), image.NewUniform(leftClr), image.Point{}, draw.Over)
+			}
+			if rightClr != nil {
+				draw.Draw(canvas, image.Rect(mid+gap, y, zeroed.Max.X, y+1), image.NewUniform(rightClr), image.Point{}, draw.Over)
+			}
+
+			return nil
+		},
+	)
+}
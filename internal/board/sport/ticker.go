@@ -0,0 +1,583 @@
+package sportboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/draw"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+	"github.com/robbydyer/sports/internal/util"
+)
+
+const (
+	tickerCellPad = 2
+	tickerName    = "Ticker"
+)
+
+var defaultScrollDelay = 15 * time.Millisecond
+
+// Ticker combines the ticker crawls of several SportBoards into one continuous scroll
+type Ticker struct {
+	config  *TickerConfig
+	boards  []*SportBoard
+	log     *zap.Logger
+	enabler board.Enabler
+}
+
+// TickerConfig ...
+type TickerConfig struct {
+	scrollDelay        time.Duration
+	StartEnabled       *atomic.Bool `json:"enabled"`
+	Leagues            []string     `json:"leagues"`
+	ScrollDelay        string       `json:"scrollDelay"`
+	TightScrollPadding int          `json:"tightScrollPadding"`
+	OnTimes            []string     `json:"onTimes"`
+	OffTimes           []string     `json:"offTimes"`
+}
+
+// SetDefaults ...
+func (c *TickerConfig) SetDefaults() {
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	c.scrollDelay = parseScrollDelay(c.ScrollDelay)
+}
+
+func parseScrollDelay(delay string) time.Duration {
+	if delay == "" {
+		return defaultScrollDelay
+	}
+	d, err := time.ParseDuration(delay)
+	if err != nil {
+		return defaultScrollDelay
+	}
+	return d
+}
+
+// NewTicker returns a Ticker board for the given SportBoards. The boards' games are shown
+// in the given order, regardless of whether each SportBoard is itself enabled.
+func NewTicker(config *TickerConfig, logger *zap.Logger, boards ...*SportBoard) (*Ticker, error) {
+	t := &Ticker{
+		config:  config,
+		boards:  boards,
+		log:     logger,
+		enabler: enabler.New(),
+	}
+
+	if config.StartEnabled.Load() {
+		t.enabler.Enable()
+	}
+
+	if err := util.SetCrons(config.OnTimes, func() {
+		t.log.Info("ticker turning on")
+		t.Enabler().Enable()
+	}); err != nil {
+		return nil, err
+	}
+	if err := util.SetCrons(config.OffTimes, func() {
+		t.log.Info("ticker turning off")
+		board.DisableScheduled(t.Enabler())
+	}); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Name ...
+func (t *Ticker) Name() string {
+	return tickerName
+}
+
+// Enabler ...
+func (t *Ticker) Enabler() board.Enabler {
+	return t.enabler
+}
+
+// WrappedBoards implements board.WrapperBoard
+func (t *Ticker) WrappedBoards() []board.Board {
+	boards := make([]board.Board, 0, len(t.boards))
+	for _, b := range t.boards {
+		boards = append(boards, b)
+	}
+	return boards
+}
+
+// InBetween ...
+func (t *Ticker) InBetween() bool {
+	return false
+}
+
+// ScrollMode ...
+func (t *Ticker) ScrollMode() bool {
+	return true
+}
+
+// GetHTTPHandlers ...
+func (t *Ticker) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+// GetRPCHandler ...
+func (t *Ticker) GetRPCHandler() (string, http.Handler) {
+	return "", nil
+}
+
+// Render ...
+func (t *Ticker) Render(ctx context.Context, canvas board.Canvas) error {
+	c, err := t.ScrollRender(ctx, canvas, t.config.TightScrollPadding)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return nil
+	}
+
+	defer func() {
+		if scr, ok := c.(*scrcnvs.ScrollCanvas); ok {
+			t.config.scrollDelay = scr.GetScrollSpeed()
+		}
+	}()
+
+	return c.Render(ctx)
+}
+
+// ScrollRender merges the ticker crawl of each league into a single ScrollCanvas
+func (t *Ticker) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	if !canvas.Scrollable() || !t.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok {
+		return nil, fmt.Errorf("ticker requires a scroll canvas")
+	}
+
+	merged, err := scrcnvs.NewScrollCanvas(base.Matrix, t.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(tickerName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	merged.SetScrollDirection(scrcnvs.RightToLeft)
+
+	for _, b := range t.boards {
+		select {
+		case <-ctx.Done():
+			return nil, context.Canceled
+		default:
+		}
+
+		c, err := b.tickerScroll(ctx, base, padding)
+		if err != nil {
+			t.log.Error("failed to render ticker for league",
+				zap.String("league", b.api.League()),
+				zap.Error(err),
+			)
+			continue
+		}
+		if c == nil {
+			continue
+		}
+
+		merged.AppendAndGC(c)
+	}
+
+	if merged.Len() < 1 {
+		return nil, nil
+	}
+
+	merged.SetScrollSpeed(t.config.scrollDelay)
+	base.SetScrollSpeed(t.config.scrollDelay)
+
+	go merged.MatchScroll(ctx, base)
+
+	return merged, nil
+}
+
+// ScrollRender renders every watched game as a compact ticker cell onto a ScrollCanvas
+func (s *SportBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	if !canvas.Scrollable() || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok {
+		return nil, fmt.Errorf("ticker mode requires a scroll canvas")
+	}
+
+	c, err := s.tickerScroll(ctx, base, padding)
+	if err != nil || c == nil {
+		return nil, err
+	}
+
+	c.SetScrollSpeed(s.config.scrollDelay)
+	base.SetScrollSpeed(s.config.scrollDelay)
+
+	go c.MatchScroll(ctx, base)
+
+	return c, nil
+}
+
+func (s *SportBoard) renderTicker(ctx context.Context, canvas board.Canvas) error {
+	if !s.Enabler().Enabled() {
+		s.log.Warn("skipping disabled board", zap.String("board", s.api.League()))
+		return nil
+	}
+
+	c, err := s.ScrollRender(ctx, canvas, s.config.TightScrollPadding)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return nil
+	}
+
+	defer func() {
+		if scr, ok := c.(*scrcnvs.ScrollCanvas); ok {
+			s.config.scrollDelay = scr.GetScrollSpeed()
+		}
+	}()
+
+	return c.Render(ctx)
+}
+
+// tickerScroll builds the ticker crawl for this league. Live game data is refreshed on each call.
+func (s *SportBoard) tickerScroll(ctx context.Context, base *scrcnvs.ScrollCanvas, padding int) (*scrcnvs.ScrollCanvas, error) {
+	games, err := s.watchedGames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(games) < 1 {
+		return nil, nil
+	}
+
+	s.preloadGames(ctx, games)
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.api.League()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticker scroll canvas: %w", err)
+	}
+	scrollCanvas.SetScrollDirection(scrcnvs.RightToLeft)
+
+	writer, err := s.getTickerWriter(base.Bounds())
+	if err != nil {
+		return nil, err
+	}
+
+	origWidth := base.GetWidth()
+	defer base.SetWidth(origWidth)
+
+	clearCanvas := func() {
+		draw.Draw(base, base.Bounds(), &image.Uniform{s.theme().BackgroundColor()}, image.Point{}, draw.Over)
+	}
+
+	if s.config.ShowLeagueLogo.Load() && s.leagueLogoGetter != nil {
+		if err := s.renderLeagueLogo(ctx, base); err != nil {
+			s.log.Error("failed to render league logo for ticker",
+				zap.String("league", s.api.League()),
+				zap.Error(err),
+			)
+		} else {
+			scrollCanvas.AddCanvas(base)
+		}
+		clearCanvas()
+	}
+
+GAMES:
+	for _, game := range games {
+		select {
+		case <-ctx.Done():
+			return nil, context.Canceled
+		default:
+		}
+
+		liveGame, err := s.getCachedGame(game.GetID())
+		if err != nil {
+			liveGame = game
+		}
+
+		if err := s.renderTickerCell(ctx, base, writer, liveGame); err != nil {
+			s.log.Error("failed to render ticker cell",
+				zap.String("league", s.api.League()),
+				zap.Int("game ID", game.GetID()),
+				zap.Error(err),
+			)
+			clearCanvas()
+			continue GAMES
+		}
+
+		scrollCanvas.AddCanvas(base)
+		if base.GetWidth() != origWidth {
+			base.SetWidth(origWidth)
+		}
+		clearCanvas()
+	}
+
+	return scrollCanvas, nil
+}
+
+// preloadGames fetches live data for all games in parallel
+func (s *SportBoard) preloadGames(ctx context.Context, games []Game) {
+	wg := sync.WaitGroup{}
+
+	for _, game := range games {
+		wg.Add(1)
+		go func(game Game) {
+			defer wg.Done()
+			p := make(chan struct{}, 1)
+			if err := s.preloadLiveGame(ctx, game, p); err != nil {
+				s.log.Error("error while loading live game", zap.Error(err), zap.Int("id", game.GetID()))
+			}
+		}(game)
+	}
+
+	wg.Wait()
+}
+
+// renderTickerCell draws a game as two compact rows of logo, abbreviation and score, followed by the game status
+func (s *SportBoard) renderTickerCell(ctx context.Context, canvas board.Canvas, writer *rgbrender.TextWriter, game Game) error {
+	away, err := game.AwayTeam()
+	if err != nil {
+		return err
+	}
+	home, err := game.HomeTeam()
+	if err != nil {
+		return err
+	}
+
+	status, showScore, err := s.tickerStatus(ctx, game)
+	if err != nil {
+		return err
+	}
+
+	if s.config.HideFavoriteScore.Load() {
+		if isFav, err := s.isFavoriteGame(game); err == nil && isFav {
+			showScore = false
+		}
+	}
+
+	teams := []Team{away, home}
+	scores := []string{"", ""}
+	if showScore {
+		scores = []string{teamScore(away), teamScore(home)}
+	}
+
+	widths, err := writer.MeasureStrings(canvas, append([]string{away.GetAbbreviation(), home.GetAbbreviation()}, scores...))
+	if err != nil {
+		return err
+	}
+	statusWidths, err := writer.MeasureStrings(canvas, status)
+	if err != nil {
+		return err
+	}
+
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+	rowH := zeroed.Dy() / 2
+
+	textX := rowH + tickerCellPad
+	scoreX := textX + maxInt(widths[0], widths[1]) + tickerCellPad
+	statusX := scoreX + maxInt(widths[2], widths[3]) + (tickerCellPad * 2)
+	cellW := statusX + maxInt(statusWidths...)
+
+	// With team colors, games are separated by a line in the home team's color
+	sepColor := s.teamAccent(home)
+	if s.useTeamColors() && sepColor == nil {
+		sepColor = s.theme().SecondaryColor()
+	}
+	textW := cellW
+	if sepColor != nil {
+		cellW += tickerCellPad + 1
+	}
+
+	if cellW > zeroed.Dx() {
+		canvas.SetWidth(cellW)
+		zeroed = rgbrender.ZeroedBounds(canvas.Bounds())
+	}
+
+	for i, team := range teams {
+		rowBounds := image.Rect(zeroed.Min.X, zeroed.Min.Y+(i*rowH), zeroed.Min.X+cellW, zeroed.Min.Y+((i+1)*rowH))
+
+		logoBounds := image.Rect(rowBounds.Min.X, rowBounds.Min.Y, rowBounds.Min.X+rowH, rowBounds.Max.Y)
+		if img, err := s.tickerLogo(ctx, team, rowH); err != nil {
+			s.log.Error("failed to get ticker logo",
+				zap.String("team", team.GetAbbreviation()),
+				zap.Error(err),
+			)
+		} else {
+			draw.Draw(canvas, logoBounds, img, image.Point{}, draw.Over)
+		}
+
+		textColor := s.theme().TextColor()
+		if s.isFavorite(team.GetAbbreviation()) {
+			textColor = s.theme().FavoriteColor()
+		}
+
+		if err := writer.WriteAligned(
+			rgbrender.LeftCenter,
+			canvas,
+			image.Rect(rowBounds.Min.X+textX, rowBounds.Min.Y, rowBounds.Min.X+scoreX, rowBounds.Max.Y),
+			[]string{team.GetAbbreviation()},
+			textColor,
+		); err != nil {
+			return err
+		}
+
+		if scores[i] == "" {
+			continue
+		}
+
+		if err := writer.WriteAligned(
+			rgbrender.RightCenter,
+			canvas,
+			image.Rect(rowBounds.Min.X+scoreX, rowBounds.Min.Y, rowBounds.Min.X+statusX-tickerCellPad, rowBounds.Max.Y),
+			[]string{scores[i]},
+			s.teamScoreColor(team),
+		); err != nil {
+			return err
+		}
+	}
+
+	if sepColor != nil {
+		sepX := zeroed.Max.X - 1
+		draw.Draw(canvas, image.Rect(sepX, zeroed.Min.Y, sepX+1, zeroed.Max.Y), image.NewUniform(sepColor), image.Point{}, draw.Over)
+	}
+
+	return writer.WriteAligned(
+		rgbrender.LeftCenter,
+		canvas,
+		image.Rect(zeroed.Min.X+statusX, zeroed.Min.Y, zeroed.Min.X+textW, zeroed.Max.Y),
+		status,
+		s.timeColor(),
+	)
+}
+
+// tickerStatus returns the lines describing a game's state, and whether scores should be shown
+func (s *SportBoard) tickerStatus(ctx context.Context, game Game) ([]string, bool, error) {
+	if is, err := game.IsPostponed(); err == nil && is {
+		return []string{s.locale().Label("PPD")}, false, nil
+	}
+
+	isLive, err := game.IsLive()
+	if err != nil {
+		return nil, false, err
+	}
+	if isLive {
+		quarter, err := game.GetQuarter()
+		if err != nil {
+			return nil, false, err
+		}
+		clock, err := game.GetClock()
+		if err != nil {
+			return nil, false, err
+		}
+		return []string{s.locale().Label(quarter), clock}, true, nil
+	}
+
+	isOver, err := game.IsComplete()
+	if err != nil {
+		return nil, false, err
+	}
+	if isOver {
+		return []string{s.locale().Label("FINAL")}, true, nil
+	}
+
+	gameTime, err := game.GetStartTime(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	lines := []string{s.locale().Time(gameTime)}
+	if !s.locale().IsToday(gameTime) {
+		lines = append(lines, s.locale().ShortDate(gameTime))
+	}
+
+	return lines, false, nil
+}
+
+func (s *SportBoard) tickerLogo(ctx context.Context, team Team, size int) (image.Image, error) {
+	bounds := image.Rect(0, 0, size, size)
+	logoKey := fmt.Sprintf("%s_TICKER_%dx%d", team.GetID(), size, size)
+
+	l, err := s.getLogoCache(logoKey)
+	if err != nil {
+		l, err = s.api.GetLogo(ctx, logoKey, s.logoConfig(logoKey, bounds), bounds)
+		if err != nil {
+			return nil, err
+		}
+		l.SetLogger(s.log)
+		s.setLogoCache(logoKey, l)
+	}
+
+	return l.GetThumbnail(ctx, bounds)
+}
+
+func (s *SportBoard) getTickerWriter(canvasBounds image.Rectangle) (*rgbrender.TextWriter, error) {
+	bounds := rgbrender.ZeroedBounds(canvasBounds)
+
+	k := fmt.Sprintf("ticker_%dx%d", bounds.Dx(), bounds.Dy())
+	if w, ok := s.timeWriters[k]; ok {
+		return w, nil
+	}
+
+	writer, err := rgbrender.DefaultTextWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	if bounds.Dy() > 32 {
+		writer.FontSize = 0.25 * float64(bounds.Dy())
+		writer.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
+	}
+
+	if err := writer.Configure(s.config.TickerFont); err != nil {
+		return nil, fmt.Errorf("failed to load font for ticker: %w", err)
+	}
+
+	s.timeWriters[k] = writer
+
+	return writer, nil
+}
+
+func maxInt(vals ...int) int {
+	m := 0
+	for _, v := range vals {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+// TickerBoards returns the SportBoards matching the given league names, in that order
+func TickerBoards(leagues []string, boards []board.Board) []*SportBoard {
+	var sportBoards []*SportBoard
+	for _, league := range leagues {
+		for _, b := range boards {
+			s, ok := b.(*SportBoard)
+			if !ok {
+				continue
+			}
+			if strings.EqualFold(s.api.League(), league) {
+				sportBoards = append(sportBoards, s)
+			}
+		}
+	}
+
+	return sportBoards
+}
@@ -0,0 +1,101 @@
+package sportboard
+
+import (
+	"context"
+	"errors"
+	"image"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/canvas"
+	"github.com/robbydyer/sports/internal/logo"
+	"github.com/robbydyer/sports/internal/matrix"
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+type tickerAPI struct {
+	API
+}
+
+func (a *tickerAPI) League() string { return "test" }
+
+func (a *tickerAPI) GetLogo(ctx context.Context, logoKey string, logoConf *logo.Config, bounds image.Rectangle) (*logo.Logo, error) {
+	return nil, errors.New("no logo")
+}
+
+type tickerTeam struct {
+	Team
+	abbrev string
+	score  int
+}
+
+func (t *tickerTeam) GetID() string           { return t.abbrev }
+func (t *tickerTeam) GetAbbreviation() string { return t.abbrev }
+func (t *tickerTeam) Score() int              { return t.score }
+
+type tickerGame struct {
+	Game
+	away *tickerTeam
+	home *tickerTeam
+}
+
+func (g *tickerGame) GetID() int                       { return 1 }
+func (g *tickerGame) AwayTeam() (Team, error)          { return g.away, nil }
+func (g *tickerGame) HomeTeam() (Team, error)          { return g.home, nil }
+func (g *tickerGame) IsPostponed() (bool, error)       { return false, nil }
+func (g *tickerGame) IsLive() (bool, error)            { return false, nil }
+func (g *tickerGame) IsComplete() (bool, error)        { return true, nil }
+func (g *tickerGame) GetQuarter() (string, error)      { return "", nil }
+func (g *tickerGame) GetClock() (string, error)        { return "", nil }
+func (g *tickerGame) GetOdds() (string, string, error) { return "", "", nil }
+
+func TestTickerCellRows(t *testing.T) {
+	t.Parallel()
+
+	conf := &Config{
+		// The home team is a favorite so its row is drawn in a different color
+		FavoriteTeams: []string{"HOM"},
+	}
+	conf.SetDefaults()
+
+	s := &SportBoard{
+		config:      conf,
+		api:         &tickerAPI{},
+		log:         zap.NewNop(),
+		logos:       make(map[string]*logo.Logo),
+		timeWriters: make(map[string]*rgbrender.TextWriter),
+	}
+
+	c := canvas.NewCanvas(matrix.NewConsoleMatrix(64, 32, io.Discard, zap.NewNop()))
+	writer, err := s.getTickerWriter(c.Bounds())
+	require.NoError(t, err)
+
+	game := &tickerGame{
+		away: &tickerTeam{abbrev: "AWY", score: 1},
+		home: &tickerTeam{abbrev: "HOM", score: 2},
+	}
+	require.NoError(t, s.renderTickerCell(context.Background(), c, writer, game))
+
+	rowH := c.Bounds().Dy() / 2
+
+	var away, home int
+	// Only look at the abbreviations, left of the status text. The home team's is green, as a favorite.
+	for x := rowH; x < rowH+12; x++ {
+		for y := 0; y < c.Bounds().Dy(); y++ {
+			r, g, _, _ := c.At(x, y).RGBA()
+			switch {
+			case r > g/2:
+				away++
+				require.Less(t, y, rowH, "away row drawn at y %d", y)
+			case g > 0:
+				home++
+				require.GreaterOrEqual(t, y, rowH, "home row drawn at y %d", y)
+			}
+		}
+	}
+	require.Positive(t, away)
+	require.Positive(t, home)
+}
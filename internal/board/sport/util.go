@@ -6,10 +6,12 @@ import (
 	"image/color"
 	"image/draw"
 	"math"
+	"strings"
 
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/locale"
 	"github.com/robbydyer/sports/internal/rgbrender"
 )
 
@@ -76,17 +78,10 @@ func (s *SportBoard) getTimeWriter(canvasBounds image.Rectangle) (*rgbrender.Tex
 		return nil, err
 	}
 
-	if s.config.TimeFont != nil {
-		s.log.Warn("using configured font size for time",
-			zap.Float64("configured", s.config.TimeFont.Size),
-		)
-		timeWriter.FontSize = s.config.TimeFont.Size
+	if bounds.Dy() <= 256 {
+		timeWriter.FontSize = 8.0
 	} else {
-		if bounds.Dy() <= 256 {
-			timeWriter.FontSize = 8.0
-		} else {
-			timeWriter.FontSize = 0.25 * float64(bounds.Dy())
-		}
+		timeWriter.FontSize = 0.25 * float64(bounds.Dy())
 	}
 
 	if bounds.Dy() <= 256 {
@@ -95,6 +90,16 @@ func (s *SportBoard) getTimeWriter(canvasBounds image.Rectangle) (*rgbrender.Tex
 		timeWriter.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
 	}
 
+	if s.config.TimeFont != nil {
+		s.log.Warn("using configured font for time",
+			zap.String("font", s.config.TimeFont.Name),
+			zap.Float64("configured", s.config.TimeFont.Size),
+		)
+		if err := timeWriter.Configure(s.config.TimeFont); err != nil {
+			return nil, fmt.Errorf("failed to load font for time: %w", err)
+		}
+	}
+
 	s.log.Debug("time writer font",
 		zap.Float64("size", timeWriter.FontSize),
 		zap.Int("Y correction", timeWriter.YStartCorrection),
@@ -133,18 +138,22 @@ func (s *SportBoard) getScoreWriter(canvasBounds image.Rectangle) (*rgbrender.Te
 			return nil, fmt.Errorf("failed to load font for score: %w", err)
 		}
 		size := 0.5 * float64(bounds.Dy())
-		if s.config.ScoreFont != nil {
-			s.log.Warn("Using configured font for Scores",
-				zap.Float64("configured", s.config.ScoreFont.Size),
-				zap.Float64("default", size),
-			)
-			size = s.config.ScoreFont.Size
-		}
 		scoreWriter = rgbrender.NewTextWriter(fnt, size)
 		yCorrect := math.Ceil(float64(3.0/32.0) * float64(bounds.Dy()))
 		scoreWriter.YStartCorrection = int(yCorrect * -1)
 	}
 
+	if s.config.ScoreFont != nil {
+		s.log.Warn("Using configured font for Scores",
+			zap.String("font", s.config.ScoreFont.Name),
+			zap.Float64("configured", s.config.ScoreFont.Size),
+			zap.Float64("default", scoreWriter.FontSize),
+		)
+		if err := scoreWriter.Configure(s.config.ScoreFont); err != nil {
+			return nil, fmt.Errorf("failed to load font for score: %w", err)
+		}
+	}
+
 	s.log.Debug("score writer font",
 		zap.Float64("size", scoreWriter.FontSize),
 		zap.Int("Y correction", scoreWriter.YStartCorrection),
@@ -219,7 +228,7 @@ func (s *SportBoard) writeBoxColor() color.Color {
 		return color.NRGBA{255, 255, 255, 0}
 	}
 
-	return color.Black
+	return s.theme().BackgroundColor()
 }
 
 func scoreStr(g Game, homeSide side) (string, error) {
@@ -233,9 +242,62 @@ func scoreStr(g Game, homeSide side) (string, error) {
 	}
 
 	if homeSide == left {
-		return fmt.Sprintf("%d-%d", h.Score(), a.Score()), nil
+		return fmt.Sprintf("%s-%s", teamScore(h), teamScore(a)), nil
 	}
-	return fmt.Sprintf("%d-%d", a.Score(), h.Score()), nil
+	return fmt.Sprintf("%s-%s", teamScore(a), teamScore(h)), nil
+}
+
+// teamScore returns a team's displayed score, using the RichScoreTeam detail when it has one
+func teamScore(t Team) string {
+	if r, ok := t.(RichScoreTeam); ok && r.ScoreDetail() != "" {
+		return r.ScoreDetail()
+	}
+	return fmt.Sprint(t.Score())
+}
+
+// subDetailStr returns the RichScoreTeam sub-details of both teams, ordered to match scoreStr.
+// An empty string is returned if neither team has any.
+func subDetailStr(g Game, homeSide side) (string, error) {
+	a, err := g.AwayTeam()
+	if err != nil {
+		return "", err
+	}
+	h, err := g.HomeTeam()
+	if err != nil {
+		return "", err
+	}
+
+	detail := func(t Team) string {
+		if r, ok := t.(RichScoreTeam); ok {
+			return r.ScoreSubDetail()
+		}
+		return ""
+	}
+
+	first, second := detail(a), detail(h)
+	if homeSide == left {
+		first, second = second, first
+	}
+	if first == "" && second == "" {
+		return "", nil
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%s  %s", first, second)), nil
+}
+
+// finalStr returns the summary of a RichScoreGame, or "FINAL"
+func (s *SportBoard) finalStr(g Game) string {
+	if r, ok := g.(RichScoreGame); ok {
+		if summary, err := r.GetSummary(); err == nil && summary != "" {
+			return summary
+		}
+	}
+	return s.locale().Label("FINAL")
+}
+
+// locale returns the global locale with the board's 24 hour setting
+func (s *SportBoard) locale() *locale.Locale {
+	return locale.Current().With24Hour(s.config.Enable24Hour)
 }
 
 func (s *SportBoard) season() string {
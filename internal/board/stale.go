@@ -0,0 +1,37 @@
+package board
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// staleMarkerSize is the width and height of the marker drawn on stale data
+const staleMarkerSize = 2
+
+// staleMarkerColor is amber
+var staleMarkerColor = color.RGBA{R: 255, G: 165, B: 0, A: 255}
+
+// StaleAPI is an optional interface for a board's API that knows when its data was served from
+// the cache because upstream couldn't be reached
+type StaleAPI interface {
+	Stale() bool
+}
+
+// IsStale determines if an API's data is stale. APIs that don't implement StaleAPI never are.
+func IsStale(api interface{}) bool {
+	if s, ok := api.(StaleAPI); ok {
+		return s.Stale()
+	}
+	return false
+}
+
+// DrawStaleMarker marks the top right corner of bounds on a canvas when an API's data is stale
+func DrawStaleMarker(api interface{}, canvas draw.Image, bounds image.Rectangle) {
+	if !IsStale(api) {
+		return
+	}
+
+	marker := image.Rect(bounds.Max.X-staleMarkerSize, bounds.Min.Y, bounds.Max.X, bounds.Min.Y+staleMarkerSize)
+	draw.Draw(canvas, marker, image.NewUniform(staleMarkerColor), image.Point{}, draw.Over)
+}
@@ -0,0 +1,138 @@
+package statboard
+
+import (
+	"context"
+	"image/color"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+)
+
+// rankedPlayer wraps a Player with its position in a leaderboard
+type rankedPlayer struct {
+	Player
+	rank int
+}
+
+// PrefixCol returns the player's leaderboard rank
+func (r *rankedPlayer) PrefixCol() string {
+	return strconv.Itoa(r.rank)
+}
+
+// GetTeamAbbreviation ...
+func (r *rankedPlayer) GetTeamAbbreviation() string {
+	if t, ok := r.Player.(TeamMember); ok {
+		return t.GetTeamAbbreviation()
+	}
+	return ""
+}
+
+// sortByRank sorts a list of leaders by their leaderboard rank
+func sortByRank(players []Player) []Player {
+	sort.SliceStable(players, func(i, j int) bool {
+		pI, iok := players[i].(*rankedPlayer)
+		pJ, jok := players[j].(*rankedPlayer)
+		if !iok || !jok {
+			return false
+		}
+		return pI.rank < pJ.rank
+	})
+
+	return players
+}
+
+func (s *StatBoard) renderLeaders(ctx context.Context, canvas board.Canvas, doUpdate bool, page pageFunc) error {
+	if len(s.config.Leaders) == 0 {
+		return nil
+	}
+
+	if s.leadersAPI == nil {
+		s.log.Error("league leaders are not supported",
+			zap.String("league", s.api.LeagueShortName()),
+		)
+		return nil
+	}
+
+	for _, stat := range s.config.Leaders {
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		default:
+		}
+
+		leaders, err := s.getLeaders(ctx, stat, doUpdate)
+		if err != nil {
+			s.log.Error("failed to get league leaders",
+				zap.Error(err),
+				zap.String("league", s.api.LeagueShortName()),
+				zap.String("stat", stat),
+			)
+			continue
+		}
+
+		s.log.Debug("rendering league leaders",
+			zap.String("league", s.api.LeagueShortName()),
+			zap.String("stat", stat),
+			zap.Int("num players", len(leaders)),
+		)
+
+		if err := s.doRender(ctx, canvas, leaders, &statTable{
+			stats:     []string{stat},
+			prefixCol: true,
+			sorter:    sortByRank,
+		}, page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *StatBoard) getLeaders(ctx context.Context, stat string, doUpdate bool) ([]Player, error) {
+	s.Lock()
+	leaders, ok := s.leaders[stat]
+	s.Unlock()
+
+	if ok && !doUpdate {
+		return leaders, nil
+	}
+
+	players, err := s.leadersAPI.GetLeaders(ctx, stat, s.config.LeadersLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	leaders = make([]Player, 0, len(players))
+	for i, p := range players {
+		leaders = append(leaders, &rankedPlayer{
+			Player: p,
+			rank:   i + 1,
+		})
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.leaders[stat] = leaders
+
+	return leaders, nil
+}
+
+// nameColor returns the color to draw a player's name in. Players on a favorite team are highlighted
+func (s *StatBoard) nameColor(player Player) color.Color {
+	t, ok := player.(TeamMember)
+	if !ok {
+		return s.theme().TextColor()
+	}
+
+	for _, fav := range s.config.FavoriteTeams {
+		if strings.EqualFold(fav, t.GetTeamAbbreviation()) {
+			return s.theme().HighlightColor()
+		}
+	}
+
+	return s.theme().TextColor()
+}
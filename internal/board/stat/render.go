@@ -3,7 +3,8 @@ package statboard
 import (
 	"context"
 	"fmt"
-	"image/color"
+	"image"
+	"image/draw"
 	"strings"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/robbydyer/sports/internal/board"
 	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 )
 
 func (s *StatBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
@@ -31,9 +33,13 @@ func (s *StatBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc
 	}
 }
 
+// pageFunc is called with each completed page of a stat table. The delay is how long the page
+// should be shown for.
+type pageFunc func(ctx context.Context, canvas board.Canvas, delay time.Duration) error
+
 // Render ...
 func (s *StatBoard) Render(ctx context.Context, canvas board.Canvas) error {
-	err := s.render(ctx, canvas)
+	err := s.render(ctx, canvas, s.showPage)
 	if err != nil {
 		return err
 	}
@@ -41,10 +47,65 @@ func (s *StatBoard) Render(ctx context.Context, canvas board.Canvas) error {
 	return nil
 }
 
+// ScrollRender renders each page of stats as a cell of a ScrollCanvas
+func (s *StatBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.Name()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.render(ctx, canvas, func(ctx context.Context, canvas board.Canvas, delay time.Duration) error {
+		scrollCanvas.AddCanvas(canvas)
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{s.theme().BackgroundColor()}, image.Point{}, draw.Over)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if scrollCanvas.Len() < 1 {
+		return nil, nil
+	}
+
+	go scrollCanvas.MatchScroll(ctx, base)
+
+	return scrollCanvas, nil
+}
+
+// ScrollMode ...
+func (s *StatBoard) ScrollMode() bool {
+	return false
+}
+
+// showPage renders a page to the canvas and holds it there for the given delay
+func (s *StatBoard) showPage(ctx context.Context, canvas board.Canvas, delay time.Duration) error {
+	if err := canvas.Render(ctx); err != nil {
+		s.log.Error("failed to render canvas", zap.Error(err))
+		return err
+	}
+
+	s.log.Debug("delaying stat board", zap.Int("seconds", int(delay.Seconds())))
+
+	select {
+	case <-ctx.Done():
+		return context.Canceled
+	case <-time.After(delay):
+	}
+
+	return nil
+}
+
 // Render ...
-func (s *StatBoard) render(ctx context.Context, canvas board.Canvas) error {
-	if len(s.config.Players) == 0 && len(s.config.Teams) == 0 {
-		return fmt.Errorf("no players or teams configured for stats %s", s.api.LeagueShortName())
+func (s *StatBoard) render(ctx context.Context, canvas board.Canvas, page pageFunc) error {
+	if len(s.config.Players) == 0 && len(s.config.Teams) == 0 && len(s.config.Leaders) == 0 {
+		return fmt.Errorf("no players, teams or leaders configured for stats %s", s.api.LeagueShortName())
 	}
 
 	boardCtx, boardCancel := context.WithCancel(ctx)
@@ -123,27 +184,21 @@ func (s *StatBoard) render(ctx context.Context, canvas board.Canvas) error {
 			zap.Int("num players", len(p)),
 		)
 
-		if err := s.doRender(boardCtx, canvas, p); err != nil {
+		table, err := s.categoryTable(boardCtx, cat)
+		if err != nil {
 			return err
 		}
-	}
 
-	return nil
-}
-
-func (s *StatBoard) doRender(ctx context.Context, canvas board.Canvas, players []Player) error {
-	if len(players) < 1 {
-		return nil
-	}
-	select {
-	case <-ctx.Done():
-		return context.Canceled
-	default:
+		if err := s.doRender(boardCtx, canvas, p, table, page); err != nil {
+			return err
+		}
 	}
 
-	// Assume all players passed to this func are in the same category
-	playerCategory := players[0].GetCategory()
+	return s.renderLeaders(boardCtx, canvas, doUpdate, page)
+}
 
+// categoryTable returns the table layout for a list of players in a given category
+func (s *StatBoard) categoryTable(ctx context.Context, playerCategory string) (*statTable, error) {
 	var stats []string
 	override, ok := s.config.StatOverride[playerCategory]
 	if ok && len(override) > 0 {
@@ -152,10 +207,30 @@ func (s *StatBoard) doRender(ctx context.Context, canvas board.Canvas, players [
 		var err error
 		stats, err = s.api.AvailableStats(ctx, playerCategory)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
+	return &statTable{
+		stats:     stats,
+		prefixCol: s.withPrefixCol,
+		sorter:    s.sorter,
+		limit:     s.config.LimitPlayers,
+	}, nil
+}
+
+func (s *StatBoard) doRender(ctx context.Context, canvas board.Canvas, players []Player, table *statTable, page pageFunc) error {
+	if len(players) < 1 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return context.Canceled
+	default:
+	}
+
+	stats := table.stats
+
 	s.log.Debug("statboard stats",
 		zap.String("league", s.api.LeagueShortName()),
 		zap.Strings("stats", stats),
@@ -166,17 +241,17 @@ func (s *StatBoard) doRender(ctx context.Context, canvas board.Canvas, players [
 		return err
 	}
 
-	players = s.sorter(players)
+	players = table.sorter(players)
 
-	if s.config.LimitPlayers > 0 && len(players) > s.config.LimitPlayers {
+	if table.limit > 0 && len(players) > table.limit {
 		s.log.Warn("limiting player cound",
 			zap.String("league", s.api.LeagueShortName()),
-			zap.Int("limit", s.config.LimitPlayers),
+			zap.Int("limit", table.limit),
 		)
-		players = players[0:s.config.LimitPlayers]
+		players = players[0:table.limit]
 	}
 
-	grid, err := s.getStatGrid(ctx, canvas, players, writer, stats)
+	grid, err := s.getStatGrid(ctx, canvas, players, writer, table)
 	if err != nil {
 		return err
 	}
@@ -210,7 +285,7 @@ func (s *StatBoard) doRender(ctx context.Context, canvas board.Canvas, players [
 		}
 
 		if row == 0 && s.withTitleRow {
-			if err := s.renderTitleRow(ctx, grid.GetRow(0), writer, stats); err != nil {
+			if err := s.renderTitleRow(ctx, grid.GetRow(0), writer, table); err != nil {
 				return err
 			}
 			row++
@@ -223,7 +298,7 @@ func (s *StatBoard) doRender(ctx context.Context, canvas board.Canvas, players [
 				zap.Int("players per grid", playersPerGrid),
 				zap.Int("num players", len(players)),
 			)
-			if err := s.renderPlayer(ctx, players[i], grid.GetRow(row), writer, stats, maxNameLength(canvas.Bounds())); err != nil {
+			if err := s.renderPlayer(ctx, players[i], grid.GetRow(row), writer, table, maxNameLength(canvas.Bounds())); err != nil {
 				s.log.Error("failed to render player", zap.Error(err))
 			}
 			row++
@@ -238,21 +313,12 @@ func (s *StatBoard) doRender(ctx context.Context, canvas board.Canvas, players [
 			return err
 		}
 
-		grid.FillPadded(canvas, color.White)
+		grid.FillPadded(canvas, s.theme().TextColor())
 
-		if err := canvas.Render(ctx); err != nil {
-			s.log.Error("failed to render canvas", zap.Error(err))
+		if err := page(ctx, canvas, delay); err != nil {
 			return err
 		}
 
-		s.log.Debug("delaying stat board", zap.Int("seconds", int(delay.Seconds())))
-
-		select {
-		case <-ctx.Done():
-			return context.Canceled
-		case <-time.After(delay):
-		}
-
 		row = 0
 
 		if err := grid.Clear(); err != nil {
@@ -272,15 +338,22 @@ func (s *StatBoard) doRender(ctx context.Context, canvas board.Canvas, players [
 	return nil
 }
 
-func (s *StatBoard) renderTitleRow(ctx context.Context, row []*rgbrender.Cell, writer *rgbrender.TextWriter, stats []string) error {
+func (s *StatBoard) renderTitleRow(ctx context.Context, row []*rgbrender.Cell, writer *rgbrender.TextWriter, table *statTable) error {
 	s.log.Debug("render stat title row")
+	adder := 1
+	if table.prefixCol {
+		adder = 2
+	}
 	for index, cell := range row {
 		select {
 		case <-ctx.Done():
 			return context.Canceled
 		default:
 		}
-		if (index == 0 && !s.withPrefixCol) || (index == 1 && s.withPrefixCol) {
+		if index == 0 && table.prefixCol {
+			continue
+		}
+		if (index == 0 && !table.prefixCol) || (index == 1 && table.prefixCol) {
 			if err := writer.WriteAligned(
 				rgbrender.LeftCenter,
 				cell.Canvas,
@@ -288,7 +361,7 @@ func (s *StatBoard) renderTitleRow(ctx context.Context, row []*rgbrender.Cell, w
 				[]string{
 					s.api.LeagueShortName(),
 				},
-				color.White,
+				s.theme().TextColor(),
 			); err != nil {
 				return err
 			}
@@ -300,9 +373,9 @@ func (s *StatBoard) renderTitleRow(ctx context.Context, row []*rgbrender.Cell, w
 			cell.Canvas,
 			cell.Canvas.Bounds(),
 			[]string{
-				s.api.StatShortName(stats[index-1]),
+				s.api.StatShortName(table.stats[index-adder]),
 			},
-			color.White,
+			s.theme().TextColor(),
 		); err != nil {
 			return err
 		}
@@ -311,21 +384,23 @@ func (s *StatBoard) renderTitleRow(ctx context.Context, row []*rgbrender.Cell, w
 	return nil
 }
 
-func (s *StatBoard) renderPlayer(ctx context.Context, player Player, row []*rgbrender.Cell, writer *rgbrender.TextWriter, stats []string, maxName int) error {
+func (s *StatBoard) renderPlayer(ctx context.Context, player Player, row []*rgbrender.Cell, writer *rgbrender.TextWriter, table *statTable, maxName int) error {
 	s.log.Debug("render player",
 		zap.String("name", player.LastName()),
 	)
 	adder := 1
-	if s.withPrefixCol {
+	if table.prefixCol {
 		adder = 2
 	}
+	stats := table.stats
+	nameColor := s.nameColor(player)
 	for index, cell := range row {
 		select {
 		case <-ctx.Done():
 			return context.Canceled
 		default:
 		}
-		if index == 0 && s.withPrefixCol {
+		if index == 0 && table.prefixCol {
 			if err := writer.WriteAligned(
 				rgbrender.LeftCenter,
 				cell.Canvas,
@@ -333,13 +408,13 @@ func (s *StatBoard) renderPlayer(ctx context.Context, player Player, row []*rgbr
 				[]string{
 					player.PrefixCol(),
 				},
-				color.White,
+				s.theme().TextColor(),
 			); err != nil {
 				return err
 			}
 			continue
 		}
-		if index == 0 || (index == 1 && s.withPrefixCol) {
+		if index == 0 || (index == 1 && table.prefixCol) {
 			if err := writer.WriteAligned(
 				rgbrender.LeftCenter,
 				cell.Canvas,
@@ -347,7 +422,7 @@ func (s *StatBoard) renderPlayer(ctx context.Context, player Player, row []*rgbr
 				[]string{
 					maxedStr(player.LastName(), maxName),
 				},
-				color.White,
+				nameColor,
 			); err != nil {
 				return err
 			}
@@ -356,7 +431,7 @@ func (s *StatBoard) renderPlayer(ctx context.Context, player Player, row []*rgbr
 		stat := player.GetStat(stats[index-adder])
 		clr := player.StatColor(stats[index-adder])
 		if clr == nil {
-			clr = color.White
+			clr = s.theme().TextColor()
 		}
 		if err := writer.WriteAligned(
 			rgbrender.LeftCenter,
@@ -373,3 +448,15 @@ func (s *StatBoard) renderPlayer(ctx context.Context, player Player, row []*rgbr
 
 	return nil
 }
+
+// RenderTable renders rows in the given order with a column for each stat. This allows
+// other boards to reuse the stat table layout.
+func (s *StatBoard) RenderTable(ctx context.Context, canvas board.Canvas, rows []Player, stats []string, prefixCol bool) error {
+	return s.doRender(ctx, canvas, rows, &statTable{
+		stats:     stats,
+		prefixCol: prefixCol,
+		sorter: func(players []Player) []Player {
+			return players
+		},
+	}, s.showPage)
+}
@@ -19,17 +19,23 @@ import (
 	"github.com/robbydyer/sports/internal/enabler"
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
 	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/theme"
 	"github.com/robbydyer/sports/internal/twirphelpers"
 	"github.com/robbydyer/sports/internal/util"
 )
 
-var defaultUpdateInterval = 5 * time.Minute
+var (
+	defaultUpdateInterval = 5 * time.Minute
+	defaultLeadersLimit   = 10
+)
 
 // StatBoard ...
 type StatBoard struct {
 	config        *Config
 	log           *zap.Logger
 	api           API
+	leadersAPI    LeadersAPI
+	leaders       map[string][]Player
 	writers       map[string]*rgbrender.TextWriter
 	sorter        Sorter
 	withTitleRow  bool
@@ -45,15 +51,20 @@ type StatBoard struct {
 type Config struct {
 	boardDelay     time.Duration
 	updateInterval time.Duration
-	BoardDelay     string              `json:"boardDelay"`
-	StartEnabled   *atomic.Bool        `json:"enabled"`
-	Players        []string            `json:"players"`
-	Teams          []string            `json:"teams"`
-	StatOverride   map[string][]string `json:"statOverride"`
-	LimitPlayers   int                 `json:"limitPlayers"`
-	UpdateInterval string              `json:"updateInterval"`
-	OnTimes        []string            `json:"onTimes"`
-	OffTimes       []string            `json:"offTimes"`
+	BoardDelay     string                `json:"boardDelay"`
+	StartEnabled   *atomic.Bool          `json:"enabled"`
+	Players        []string              `json:"players"`
+	Teams          []string              `json:"teams"`
+	StatOverride   map[string][]string   `json:"statOverride"`
+	LimitPlayers   int                   `json:"limitPlayers"`
+	UpdateInterval string                `json:"updateInterval"`
+	OnTimes        []string              `json:"onTimes"`
+	OffTimes       []string              `json:"offTimes"`
+	Leaders        []string              `json:"leaders"`
+	LeadersLimit   int                   `json:"leadersLimit"`
+	FavoriteTeams  []string              `json:"favoriteTeams"`
+	Font           *rgbrender.FontConfig `json:"font"`
+	Theme          string                `json:"theme"`
 }
 
 // OptionFunc provides options to the StatBoard that are not exposed in a Config
@@ -79,6 +90,16 @@ type API interface {
 	PlayerCategories() []string
 }
 
+// LeadersAPI is implemented by an API that can list the league-wide leaders in a stat
+type LeadersAPI interface {
+	GetLeaders(ctx context.Context, stat string, limit int) ([]Player, error)
+}
+
+// TeamMember is implemented by a Player that knows which team they play for
+type TeamMember interface {
+	GetTeamAbbreviation() string
+}
+
 // Player ...
 type Player interface {
 	FirstName() string
@@ -121,6 +142,10 @@ func (c *Config) SetDefaults() {
 	if c.StatOverride == nil {
 		c.StatOverride = make(map[string][]string)
 	}
+
+	if c.LeadersLimit <= 0 {
+		c.LeadersLimit = defaultLeadersLimit
+	}
 }
 
 // New ...
@@ -129,6 +154,7 @@ func New(ctx context.Context, api API, config *Config, logger *zap.Logger, opts
 		config:        config,
 		log:           logger,
 		api:           api,
+		leaders:       make(map[string][]Player),
 		writers:       make(map[string]*rgbrender.TextWriter),
 		withTitleRow:  true,
 		withPrefixCol: false,
@@ -150,6 +176,12 @@ func New(ctx context.Context, api API, config *Config, logger *zap.Logger, opts
 		s.sorter = defaultSorter
 	}
 
+	if s.leadersAPI == nil {
+		if l, ok := api.(LeadersAPI); ok {
+			s.leadersAPI = l
+		}
+	}
+
 	if err := util.SetCrons(config.OnTimes, func() {
 		s.log.Warn("statboard turning on",
 			zap.String("league", s.api.LeagueShortName()),
@@ -162,7 +194,7 @@ func New(ctx context.Context, api API, config *Config, logger *zap.Logger, opts
 		s.log.Warn("statboard turning off",
 			zap.String("league", s.api.LeagueShortName()),
 		)
-		s.Enabler().Disable()
+		board.DisableScheduled(s.Enabler())
 	}); err != nil {
 		return nil, err
 	}
@@ -212,6 +244,11 @@ func (s *StatBoard) Name() string {
 	return fmt.Sprintf("StatBoard: %s", s.api.LeagueShortName())
 }
 
+// theme returns the board's theme, or the global theme if it doesn't choose one
+func (s *StatBoard) theme() *theme.Theme {
+	return theme.Resolve(s.config.Theme)
+}
+
 // Clear ...
 func (s *StatBoard) Clear() error {
 	return nil
@@ -245,3 +282,11 @@ func WithPrefixCol(with bool) OptionFunc {
 		return nil
 	}
 }
+
+// WithLeadersAPI sets the source of league leaders, for when the stat API itself doesn't provide them
+func WithLeadersAPI(l LeadersAPI) OptionFunc {
+	return func(s *StatBoard) error {
+		s.leadersAPI = l
+		return nil
+	}
+}
@@ -16,6 +16,14 @@ import (
 
 const padSize = float64(0.005)
 
+// statTable describes the layout of a single table of players
+type statTable struct {
+	stats     []string
+	prefixCol bool
+	sorter    Sorter
+	limit     int
+}
+
 func (s *StatBoard) getWriter(bounds image.Rectangle) (*rgbrender.TextWriter, error) {
 	s.Lock()
 	defer s.Unlock()
@@ -38,6 +46,10 @@ func (s *StatBoard) getWriter(bounds image.Rectangle) (*rgbrender.TextWriter, er
 
 	writer.YStartCorrection = (-1 * int(padSize*float64(bounds.Dy()))) + writer.YStartCorrection
 
+	if err := writer.Configure(s.config.Font); err != nil {
+		return nil, err
+	}
+
 	s.log.Debug("statboard writer font",
 		zap.Float64("size", writer.FontSize),
 		zap.String("canvas", fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy())),
@@ -114,7 +126,8 @@ func getGridRatios(writer StringMeasurer, canvas draw.Image, strs []string) ([]f
 }
 
 // getStatPlaceholders gets a list of strings with longest stat lengths
-func (s *StatBoard) getStatPlaceholders(ctx context.Context, bounds image.Rectangle, players []Player, stats []string) ([]string, error) {
+func (s *StatBoard) getStatPlaceholders(ctx context.Context, bounds image.Rectangle, players []Player, table *statTable) ([]string, error) {
+	stats := table.stats
 	maxName := 0
 	prefixCol := 0
 	statCols := make([]int, len(stats))
@@ -130,7 +143,7 @@ func (s *StatBoard) getStatPlaceholders(ctx context.Context, bounds image.Rectan
 			maxName = len(player.LastName())
 		}
 
-		if s.withPrefixCol {
+		if table.prefixCol {
 			prefix := player.PrefixCol()
 			if len(prefix) > prefixCol {
 				prefixCol = len(prefix)
@@ -170,8 +183,8 @@ func (s *StatBoard) getStatPlaceholders(ctx context.Context, bounds image.Rectan
 	return strs, nil
 }
 
-func (s *StatBoard) getStatGrid(ctx context.Context, canvas board.Canvas, players []Player, writer *rgbrender.TextWriter, stats []string) (*rgbrender.Grid, error) {
-	strs, err := s.getStatPlaceholders(ctx, rgbrender.ZeroedBounds(canvas.Bounds()), players, stats)
+func (s *StatBoard) getStatGrid(ctx context.Context, canvas board.Canvas, players []Player, writer *rgbrender.TextWriter, table *statTable) (*rgbrender.Grid, error) {
+	strs, err := s.getStatPlaceholders(ctx, rgbrender.ZeroedBounds(canvas.Bounds()), players, table)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +232,8 @@ func maxNameLength(canvas image.Rectangle) int {
 }
 
 func maxedStr(str string, maxLen int) string {
-	if maxLen <= 0 || len(str) <= maxLen {
+	runes := []rune(str)
+	if maxLen <= 0 || len(runes) <= maxLen {
 		return str
 	}
 
@@ -231,5 +245,5 @@ func maxedStr(str string, maxLen int) string {
 		j = int(math.Floor(start)) - 1
 	}
 
-	return fmt.Sprintf("%s..%s", str[0:i], str[len(str)-j:])
+	return fmt.Sprintf("%s..%s", string(runes[0:i]), string(runes[len(runes)-j:]))
 }
@@ -50,6 +50,18 @@ func TestMaxedStr(t *testing.T) {
 			len:      7,
 			expected: "bill..ff",
 		},
+		{
+			name:     "multibyte",
+			in:       "Šćepanović",
+			len:      4,
+			expected: "Šć..ć",
+		},
+		{
+			name:     "multibyte fits",
+			in:       "Dončić",
+			len:      6,
+			expected: "Dončić",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,49 @@
+package board
+
+import "time"
+
+// Reasons a board was skipped
+const (
+	SkipDisabled    = "disabled"
+	SkipOffHours    = "off_hours"
+	SkipNoGames     = "no_games"
+	SkipNoLiveGames = "no_live_games"
+	SkipError       = "error"
+)
+
+// Item is what a board is currently showing, such as a game
+type Item struct {
+	ID    string
+	Label string
+	Teams []string
+	// Duration is how long the item is shown for
+	Duration time.Duration
+}
+
+// Reporter is notified of what boards are showing, so clients can see what's on screen and why
+type Reporter interface {
+	// Showing reports the item a board started showing
+	Showing(board string, item *Item)
+	// Skipped reports that a board had nothing to show, with one of the Skip reasons
+	Skipped(board string, reason string)
+}
+
+// Reportable is a Board that reports what it's showing
+type Reportable interface {
+	SetReporter(Reporter)
+}
+
+// ScheduledEnabler is an Enabler that knows if it was disabled by a board's off times
+type ScheduledEnabler interface {
+	Enabler
+	DisableScheduled() bool
+	DisabledBySchedule() bool
+}
+
+// DisableScheduled disables a board for its scheduled off times
+func DisableScheduled(e Enabler) bool {
+	if s, ok := e.(ScheduledEnabler); ok {
+		return s.DisableScheduled()
+	}
+	return e.Disable()
+}
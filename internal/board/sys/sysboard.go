@@ -3,7 +3,9 @@ package sysboard
 import (
 	"context"
 	"fmt"
+	"image"
 	"image/color"
+	"image/draw"
 	"io/fs"
 	"net/http"
 	"os"
@@ -23,6 +25,7 @@ import (
 	"github.com/robbydyer/sports/internal/enabler"
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
 	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
 	"github.com/robbydyer/sports/internal/twirphelpers"
 	"github.com/robbydyer/sports/internal/util"
 )
@@ -42,10 +45,11 @@ type SysBoard struct {
 // Config ...
 type Config struct {
 	boardDelay   time.Duration
-	StartEnabled *atomic.Bool `json:"enabled"`
-	BoardDelay   string       `json:"boardDelay"`
-	OnTimes      []string     `json:"onTimes"`
-	OffTimes     []string     `json:"offTimes"`
+	StartEnabled *atomic.Bool          `json:"enabled"`
+	BoardDelay   string                `json:"boardDelay"`
+	OnTimes      []string              `json:"onTimes"`
+	OffTimes     []string              `json:"offTimes"`
+	Font         *rgbrender.FontConfig `json:"font"`
 }
 
 // SetDefaults ...
@@ -96,7 +100,7 @@ func New(logger *zap.Logger, config *Config) (*SysBoard, error) {
 	}
 	if err := util.SetCrons(config.OffTimes, func() {
 		s.log.Info("sysboard turning off")
-		s.Enabler().Disable()
+		board.DisableScheduled(s.Enabler())
 	}); err != nil {
 		return nil, err
 	}
@@ -128,6 +132,11 @@ func (s *SysBoard) textWriter(canvasHeight int) (*rgbrender.TextWriter, error) {
 		s.textWriters[canvasHeight].FontSize = 0.25 * float64(canvasHeight)
 	}
 
+	if err := s.textWriters[canvasHeight].Configure(s.config.Font); err != nil {
+		delete(s.textWriters, canvasHeight)
+		return nil, err
+	}
+
 	return s.textWriters[canvasHeight], nil
 }
 
@@ -138,7 +147,48 @@ func (s *SysBoard) Name() string {
 
 // ScrollRender ...
 func (s *SysBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
-	return nil, nil
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	things, err := s.sysInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.Name()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := s.textWriter(base.Bounds().Dx())
+	if err != nil {
+		return nil, err
+	}
+
+	// Each stat gets its own cell, so they scroll by in a single line
+	for _, thing := range things {
+		if err := writer.WriteAligned(
+			rgbrender.CenterCenter,
+			base,
+			rgbrender.ZeroedBounds(base.Bounds()),
+			[]string{thing},
+			color.White,
+		); err != nil {
+			return nil, err
+		}
+
+		scrollCanvas.AddCanvas(base)
+		draw.Draw(base, base.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+	}
+
+	go scrollCanvas.MatchScroll(ctx, base)
+
+	return scrollCanvas, nil
 }
 
 // Render ...
@@ -152,21 +202,50 @@ func (s *SysBoard) Render(ctx context.Context, canvas board.Canvas) error {
 		return err
 	}
 
-	mem, err := memory.Get()
+	things, err := s.sysInfo()
 	if err != nil {
 		return err
 	}
 
+	if err := writer.WriteAligned(
+		rgbrender.CenterCenter,
+		canvas,
+		canvas.Bounds(),
+		things,
+		color.White,
+	); err != nil {
+		return err
+	}
+
+	if err := canvas.Render(ctx); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(s.config.boardDelay):
+	}
+
+	return nil
+}
+
+// sysInfo returns the lines of system stats to display
+func (s *SysBoard) sysInfo() ([]string, error) {
+	mem, err := memory.Get()
+	if err != nil {
+		return nil, err
+	}
+
 	memPct := int64(float64(mem.Used) / float64(mem.Total) * 100)
 
 	before, err := cpu.Get()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	time.Sleep(1 * time.Second)
 	after, err := cpu.Get()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	cpuPct := int64(float64(after.User-before.User) / float64(after.Total-before.Total) * 100)
@@ -193,26 +272,7 @@ func (s *SysBoard) Render(ctx context.Context, canvas board.Canvas) error {
 		things = append(things, fmt.Sprintf("CPU Temp: %d", cpuTemp))
 	}
 
-	if err := writer.WriteAligned(
-		rgbrender.CenterCenter,
-		canvas,
-		canvas.Bounds(),
-		things,
-		color.White,
-	); err != nil {
-		return err
-	}
-
-	if err := canvas.Render(ctx); err != nil {
-		return err
-	}
-
-	select {
-	case <-ctx.Done():
-	case <-time.After(s.config.boardDelay):
-	}
-
-	return nil
+	return things, nil
 }
 
 func (s *SysBoard) Enabler() board.Enabler {
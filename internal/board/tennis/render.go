@@ -0,0 +1,370 @@
+package tennisboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/locale"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var (
+	headerColor  = color.RGBA{255, 255, 0, 255}
+	liveColor    = color.RGBA{0, 255, 0, 255}
+	loserColor   = color.RGBA{130, 130, 130, 255}
+	servingColor = color.RGBA{255, 255, 0, 255}
+)
+
+// Render ...
+//
+//nolint:contextcheck
+func (s *TennisBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	s.boardCtx, s.boardCancel = context.WithCancel(ctx)
+
+	matches, err := s.getMatches(s.boardCtx)
+	if err != nil {
+		return err
+	}
+
+	writer, err := s.getWriter(rgbrender.ZeroedBounds(canvas.Bounds()))
+	if err != nil {
+		return err
+	}
+
+MATCHES:
+	for _, m := range matches {
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		default:
+		}
+
+		img, err := s.renderMatch(s.boardCtx, canvas.Bounds(), writer, m)
+		if err != nil {
+			s.log.Error("failed to render tennis match",
+				zap.String("match", m.ID),
+				zap.Error(err),
+			)
+			continue MATCHES
+		}
+
+		draw.Draw(canvas, img.Bounds(), img, image.Point{}, draw.Over)
+		board.DrawStaleMarker(s.api, canvas, rgbrender.ZeroedBounds(canvas.Bounds()))
+
+		if err := canvas.Render(s.boardCtx); err != nil {
+			s.log.Error("failed to render tennis board",
+				zap.Error(err),
+			)
+			continue MATCHES
+		}
+
+		select {
+		case <-s.boardCtx.Done():
+			return context.Canceled
+		case <-time.After(s.config.boardDelay):
+		}
+	}
+
+	return nil
+}
+
+// ScrollRender renders each match as a cell of a ScrollCanvas
+func (s *TennisBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	base, ok := canvas.(*scrcnvs.ScrollCanvas)
+	if !ok || !s.Enabler().Enabled() {
+		return nil, nil
+	}
+
+	matches, err := s.getMatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := s.getWriter(rgbrender.ZeroedBounds(canvas.Bounds()))
+	if err != nil {
+		return nil, err
+	}
+
+	scrollCanvas, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(padding),
+		scrcnvs.WithName(s.Name()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+MATCHES:
+	for _, m := range matches {
+		select {
+		case <-ctx.Done():
+			return nil, context.Canceled
+		default:
+		}
+
+		img, err := s.renderMatch(ctx, canvas.Bounds(), writer, m)
+		if err != nil {
+			s.log.Error("failed to render tennis match",
+				zap.String("match", m.ID),
+				zap.Error(err),
+			)
+			continue MATCHES
+		}
+
+		scrollCanvas.AddCanvas(img)
+	}
+
+	if scrollCanvas.Len() < 1 {
+		return nil, nil
+	}
+
+	go scrollCanvas.MatchScroll(ctx, base)
+
+	return scrollCanvas, nil
+}
+
+// getMatches refreshes matches once the update interval has passed, and returns the watched matches
+func (s *TennisBoard) getMatches(ctx context.Context) ([]*Match, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.matches == nil || time.Since(s.lastUpdate) > s.config.updateInterval {
+		matches, err := s.api.GetMatches(ctx)
+		if err != nil {
+			if s.matches == nil {
+				return nil, err
+			}
+			s.log.Error("failed to update tennis matches, using cached",
+				zap.String("league", s.api.LeagueShortName()),
+				zap.Error(err),
+			)
+		} else {
+			s.matches = matches
+			s.lastUpdate = time.Now()
+		}
+	}
+
+	watched := s.watchedMatches(s.matches)
+
+	s.log.Debug("tennis matches",
+		zap.String("league", s.api.LeagueShortName()),
+		zap.Int("total", len(s.matches)),
+		zap.Int("watched", len(watched)),
+	)
+
+	return watched, nil
+}
+
+// renderMatch draws a header row with the round and match status, then a grid row for each
+// player of flag, name, games per set and current game points
+func (s *TennisBoard) renderMatch(ctx context.Context, bounds image.Rectangle, writer *rgbrender.TextWriter, m *Match) (draw.Image, error) {
+	if len(m.Players) != 2 {
+		return nil, fmt.Errorf("match has %d players", len(m.Players))
+	}
+
+	zeroed := rgbrender.ZeroedBounds(bounds)
+	cnv := board.NewBlankCanvas(zeroed.Dx(), zeroed.Dy(), s.log)
+
+	numSets := 0
+	for _, p := range m.Players {
+		if len(p.Sets) > numSets {
+			numSets = len(p.Sets)
+		}
+	}
+
+	lineHeight := int(math.Ceil(writer.FontSize))
+	width := float64(zeroed.Dx())
+
+	flagW := 0.0
+	if s.config.ShowFlags.Load() {
+		flagW = math.Floor(float64(lineHeight)*1.5) / width
+	}
+	setW := math.Floor(width/10) / width
+	pointsW := 0.0
+	if m.Live {
+		pointsW = math.Floor(width/7) / width
+	}
+	nameW := 1.0 - flagW - (setW * float64(numSets)) - pointsW
+
+	colRatios := []float64{flagW, nameW}
+	for i := 0; i < numSets; i++ {
+		colRatios = append(colRatios, setW)
+	}
+	colRatios = append(colRatios, pointsW)
+
+	grid, err := rgbrender.NewGrid(
+		cnv,
+		len(colRatios),
+		3,
+		s.log,
+		rgbrender.WithCellRatios(colRatios, []float64{1.0 / 3.0, 1.0 / 3.0, 1.0 / 3.0}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for row, p := range m.Players {
+		opponent := m.Players[1-row]
+		cells := grid.GetRow(row + 1)
+
+		nameColor := color.Color(color.White)
+		if m.Completed && !p.Winner {
+			nameColor = loserColor
+		}
+
+		if flagW > 0 {
+			if err := s.drawFlag(ctx, cells[0], p.Country); err != nil {
+				s.log.Debug("no tennis flag",
+					zap.String("country", p.Country),
+					zap.Error(err),
+				)
+			}
+		}
+
+		nameCell := cells[1]
+		nameBounds := nameCell.Canvas.Bounds()
+		if p.Serving && m.Live {
+			dot := nameBounds.Dy() / 5
+			if dot < 2 {
+				dot = 2
+			}
+			midY := nameBounds.Min.Y + (nameBounds.Dy() / 2)
+			draw.Draw(nameCell.Canvas,
+				image.Rect(nameBounds.Max.X-dot, midY-(dot/2), nameBounds.Max.X, midY-(dot/2)+dot),
+				&image.Uniform{servingColor}, image.Point{}, draw.Over,
+			)
+			nameBounds.Max.X -= dot + 1
+		}
+		name, err := truncate(writer, nameCell.Canvas, p.LastName(), nameBounds.Dx())
+		if err != nil {
+			return nil, err
+		}
+		if err := writer.WriteAligned(rgbrender.LeftCenter, nameCell.Canvas, nameBounds, []string{name}, nameColor); err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < numSets; i++ {
+			if i >= len(p.Sets) {
+				continue
+			}
+			setColor := color.Color(color.White)
+			inProgress := m.Live && i == numSets-1
+			if !inProgress && i < len(opponent.Sets) && p.Sets[i].Games < opponent.Sets[i].Games {
+				setColor = loserColor
+			}
+			cell := cells[2+i]
+			if err := writer.WriteAligned(rgbrender.CenterCenter, cell.Canvas, cell.Canvas.Bounds(), []string{fmt.Sprint(p.Sets[i].Games)}, setColor); err != nil {
+				return nil, err
+			}
+		}
+
+		if pointsW > 0 && p.Points != "" {
+			cell := cells[len(cells)-1]
+			if err := writer.WriteAligned(rgbrender.RightCenter, cell.Canvas, cell.Canvas.Bounds(), []string{p.Points}, liveColor); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := grid.DrawToBase(cnv); err != nil {
+		return nil, err
+	}
+
+	if err := s.writeHeader(cnv, writer, m, image.Rect(0, 0, zeroed.Dx(), zeroed.Dy()/3)); err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(bounds)
+	draw.Draw(img, zeroed, cnv, image.Point{}, draw.Over)
+
+	return img, nil
+}
+
+// writeHeader writes the round on the left and the match status on the right
+func (s *TennisBoard) writeHeader(canvas draw.Image, writer *rgbrender.TextWriter, m *Match, bounds image.Rectangle) error {
+	status := locale.Current().Label(m.Status)
+	statusColor := color.Color(color.White)
+	switch {
+	case m.Live:
+		statusColor = liveColor
+	case !m.Completed:
+		status = locale.Current().Time(m.Start)
+	}
+
+	if err := writer.WriteAligned(rgbrender.RightCenter, canvas, bounds, []string{status}, statusColor); err != nil {
+		return err
+	}
+
+	w, err := writer.MeasureStrings(canvas, []string{status})
+	if err != nil {
+		return err
+	}
+
+	round, err := truncate(writer, canvas, m.Round, bounds.Dx()-w[0]-2)
+	if err != nil {
+		return err
+	}
+
+	return writer.WriteAligned(rgbrender.LeftCenter, canvas, bounds, []string{round}, headerColor)
+}
+
+func (s *TennisBoard) drawFlag(ctx context.Context, cell *rgbrender.Cell, country string) error {
+	if country == "" {
+		return nil
+	}
+
+	bounds := cell.Canvas.Bounds()
+
+	s.Lock()
+	flag, ok := s.flags[country]
+	s.Unlock()
+	if !ok {
+		var err error
+		flag, err = s.api.GetFlag(ctx, country, bounds)
+		if err != nil {
+			return err
+		}
+		s.Lock()
+		s.flags[country] = flag
+		s.Unlock()
+	}
+
+	thumb, err := flag.GetThumbnail(ctx, bounds)
+	if err != nil {
+		return err
+	}
+
+	align, err := rgbrender.AlignPosition(rgbrender.CenterCenter, bounds, thumb.Bounds().Dx(), thumb.Bounds().Dy())
+	if err != nil {
+		return err
+	}
+
+	draw.Draw(cell.Canvas, align, thumb, thumb.Bounds().Min, draw.Over)
+
+	return nil
+}
+
+// truncate shortens a string until it fits within the given pixel width
+func truncate(writer *rgbrender.TextWriter, img draw.Image, str string, width int) (string, error) {
+	r := []rune(str)
+	for len(r) > 0 {
+		w, err := writer.MeasureStrings(img, []string{string(r)})
+		if err != nil {
+			return "", err
+		}
+		if w[0] <= width {
+			break
+		}
+		r = r[:len(r)-1]
+	}
+
+	return string(r), nil
+}
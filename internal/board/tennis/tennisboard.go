@@ -0,0 +1,313 @@
+package tennisboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+	"github.com/robbydyer/sports/internal/logo"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/twirphelpers"
+	"github.com/robbydyer/sports/internal/util"
+
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+)
+
+const defaultMatchLimit = 10
+
+// TennisBoard shows live, scheduled and completed tennis matches
+type TennisBoard struct {
+	config      *Config
+	api         API
+	log         *zap.Logger
+	writer      *rgbrender.TextWriter
+	matches     []*Match
+	flags       map[string]*logo.Logo
+	lastUpdate  time.Time
+	rpcServer   pb.TwirpServer
+	boardCtx    context.Context
+	boardCancel context.CancelFunc
+	enabler     board.Enabler
+	sync.Mutex
+}
+
+// Config ...
+type Config struct {
+	boardDelay      time.Duration
+	updateInterval  time.Duration
+	StartEnabled    *atomic.Bool          `json:"enabled"`
+	BoardDelay      string                `json:"boardDelay"`
+	UpdateInterval  string                `json:"updateInterval"`
+	OnTimes         []string              `json:"onTimes"`
+	OffTimes        []string              `json:"offTimes"`
+	FavoritePlayers []string              `json:"favoritePlayers"`
+	FavoritesOnly   *atomic.Bool          `json:"favoritesOnly"`
+	ShowSchedule    *atomic.Bool          `json:"showSchedule"`
+	ShowResults     *atomic.Bool          `json:"showResults"`
+	ShowDoubles     *atomic.Bool          `json:"showDoubles"`
+	ShowFlags       *atomic.Bool          `json:"showFlags"`
+	MatchLimit      int                   `json:"matchLimit"`
+	Font            *rgbrender.FontConfig `json:"font"`
+}
+
+// API ...
+type API interface {
+	LeagueShortName() string
+	HTTPPathPrefix() string
+	GetMatches(ctx context.Context) ([]*Match, error)
+	GetFlag(ctx context.Context, country string, bounds image.Rectangle) (*logo.Logo, error)
+}
+
+// Match is a single tennis match
+type Match struct {
+	ID         string
+	Tournament string
+	// Round is a short round name, such as "QF"
+	Round   string
+	Court   string
+	Start   time.Time
+	Live    bool
+	Doubles bool
+	// Completed is true for finished, retired and walkover matches
+	Completed bool
+	// Status is a short description of the match state, such as "Set 3" or "Ret."
+	Status  string
+	Players []*Player
+}
+
+// Player is one side of a match. For doubles, this is the team.
+type Player struct {
+	ID   string
+	Name string
+	// Country is the IOC or ISO 3166 alpha-3 code used for the player's flag
+	Country string
+	Seed    string
+	Sets    []*Set
+	// Points is the score in the current game, such as "30" or "AD"
+	Points  string
+	Serving bool
+	Winner  bool
+}
+
+// Set is a player's games in a set
+type Set struct {
+	Games int
+	// Tiebreak is the player's tiebreak points, if the set went to a tiebreak
+	Tiebreak int
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err != nil {
+			c.boardDelay = 10 * time.Second
+		} else {
+			c.boardDelay = d
+		}
+	} else {
+		c.boardDelay = 10 * time.Second
+	}
+
+	if c.UpdateInterval != "" {
+		d, err := time.ParseDuration(c.UpdateInterval)
+		if err != nil {
+			c.updateInterval = 1 * time.Minute
+		} else {
+			c.updateInterval = d
+		}
+	} else {
+		c.updateInterval = 1 * time.Minute
+	}
+
+	if c.StartEnabled == nil {
+		c.StartEnabled = atomic.NewBool(false)
+	}
+	if c.FavoritesOnly == nil {
+		c.FavoritesOnly = atomic.NewBool(false)
+	}
+	if c.ShowSchedule == nil {
+		c.ShowSchedule = atomic.NewBool(true)
+	}
+	if c.ShowResults == nil {
+		c.ShowResults = atomic.NewBool(true)
+	}
+	if c.ShowDoubles == nil {
+		c.ShowDoubles = atomic.NewBool(false)
+	}
+	if c.ShowFlags == nil {
+		c.ShowFlags = atomic.NewBool(true)
+	}
+	if c.MatchLimit == 0 {
+		c.MatchLimit = defaultMatchLimit
+	}
+}
+
+// New ...
+func New(api API, logger *zap.Logger, config *Config) (*TennisBoard, error) {
+	s := &TennisBoard{
+		config:  config,
+		api:     api,
+		log:     logger,
+		flags:   make(map[string]*logo.Logo),
+		enabler: enabler.New(),
+	}
+
+	if config.StartEnabled.Load() {
+		s.enabler.Enable()
+	}
+
+	s.log.Info("Register Tennis Board",
+		zap.String("league", api.LeagueShortName()),
+		zap.String("board name", s.Name()),
+	)
+
+	if err := util.SetCrons(config.OnTimes, func() {
+		s.log.Info("tennisboard turning on")
+		s.Enabler().Enable()
+	}); err != nil {
+		return nil, err
+	}
+	if err := util.SetCrons(config.OffTimes, func() {
+		s.log.Info("tennisboard turning off")
+		board.DisableScheduled(s.Enabler())
+	}); err != nil {
+		return nil, err
+	}
+
+	svr := &Server{
+		board: s,
+	}
+	prfx := s.api.HTTPPathPrefix()
+	if !strings.HasPrefix(prfx, "/") {
+		prfx = fmt.Sprintf("/%s", prfx)
+	}
+	s.rpcServer = pb.NewBasicBoardServer(svr,
+		twirp.WithServerPathPrefix(fmt.Sprintf("/tennis%s", prfx)),
+		twirp.ChainHooks(
+			twirphelpers.GetDefaultHooks(s, s.log),
+		),
+	)
+
+	return s, nil
+}
+
+// Name ...
+func (s *TennisBoard) Name() string {
+	return fmt.Sprintf("Tennis: %s", s.api.LeagueShortName())
+}
+
+// Enabler ...
+func (s *TennisBoard) Enabler() board.Enabler {
+	return s.enabler
+}
+
+// InBetween ...
+func (s *TennisBoard) InBetween() bool {
+	return false
+}
+
+// ScrollMode ...
+func (s *TennisBoard) ScrollMode() bool {
+	return false
+}
+
+// GetHTTPHandlers ...
+func (s *TennisBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	return nil, nil
+}
+
+// GetRPCHandler ...
+func (s *TennisBoard) GetRPCHandler() (string, http.Handler) {
+	return s.rpcServer.PathPrefix(), s.rpcServer
+}
+
+// LastName ...
+func (p *Player) LastName() string {
+	// Doubles teams are already "Last / Last"
+	if strings.Contains(p.Name, "/") {
+		return p.Name
+	}
+	parts := strings.Fields(p.Name)
+	if len(parts) < 2 {
+		return p.Name
+	}
+	return strings.Join(parts[1:], " ")
+}
+
+func (s *TennisBoard) isFavorite(m *Match) bool {
+	for _, p := range m.Players {
+		for _, fav := range s.config.FavoritePlayers {
+			if strings.EqualFold(fav, p.Name) || strings.EqualFold(fav, p.LastName()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// watchedMatches filters matches by the board's config and orders them live, then order of play,
+// then most recent results. Favorites come first within each group.
+func (s *TennisBoard) watchedMatches(matches []*Match) []*Match {
+	watched := []*Match{}
+	for _, m := range matches {
+		switch {
+		case m.Doubles && !s.config.ShowDoubles.Load():
+			continue
+		case m.Completed && !s.config.ShowResults.Load():
+			continue
+		case !m.Live && !m.Completed && !s.config.ShowSchedule.Load():
+			continue
+		case s.config.FavoritesOnly.Load() && !s.isFavorite(m):
+			continue
+		}
+		watched = append(watched, m)
+	}
+
+	sortMatches(watched, s.isFavorite)
+
+	if s.config.MatchLimit > 0 && len(watched) > s.config.MatchLimit {
+		watched = watched[0:s.config.MatchLimit]
+	}
+
+	return watched
+}
+
+func sortMatches(matches []*Match, isFavorite func(*Match) bool) {
+	group := func(m *Match) int {
+		switch {
+		case m.Live:
+			return 0
+		case m.Completed:
+			return 2
+		}
+		return 1
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		gi, gj := group(matches[i]), group(matches[j])
+		if gi != gj {
+			return gi < gj
+		}
+		fi, fj := isFavorite(matches[i]), isFavorite(matches[j])
+		if fi != fj {
+			return fi
+		}
+		if gi == 2 {
+			return matches[i].Start.After(matches[j].Start)
+		}
+		return matches[i].Start.Before(matches[j].Start)
+	})
+}
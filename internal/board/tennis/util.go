@@ -0,0 +1,34 @@
+package tennisboard
+
+import (
+	"image"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+func (s *TennisBoard) getWriter(bounds image.Rectangle) (*rgbrender.TextWriter, error) {
+	if s.writer != nil {
+		return s.writer, nil
+	}
+
+	var err error
+	s.writer, err = rgbrender.DefaultTextWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	if bounds.Dy() <= 256 {
+		s.writer.FontSize = 8.0
+		s.writer.YStartCorrection = -2
+	} else {
+		s.writer.FontSize = 0.25 * float64(bounds.Dy())
+		s.writer.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
+	}
+
+	if err := s.writer.Configure(s.config.Font); err != nil {
+		s.writer = nil
+		return nil, err
+	}
+
+	return s.writer, nil
+}
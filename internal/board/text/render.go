@@ -8,6 +8,7 @@ import (
 	"image/draw"
 	"strings"
 
+	"github.com/disintegration/imaging"
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/internal/board"
@@ -55,11 +56,12 @@ func (s *TextBoard) renderLogo(ctx context.Context, canvas board.Canvas) error {
 	}
 
 	draw.Draw(canvas, zeroed, i, image.Point{}, draw.Over)
+	board.DrawStaleMarker(s.api, canvas, zeroed)
 
 	return nil
 }
 
-func (s *TextBoard) doRender(canvas board.Canvas, text string) error {
+func (s *TextBoard) doRender(canvas board.Canvas, text string, clr color.Color) error {
 	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
 	lengths, err := s.writer.MeasureStrings(canvas, []string{text})
 	if err != nil {
@@ -90,8 +92,45 @@ func (s *TextBoard) doRender(canvas board.Canvas, text string) error {
 		canvas,
 		bounds,
 		[]string{text},
-		color.White,
+		clr,
 	)
 
 	return nil
 }
+
+// renderStoryImage draws a story's image at the height of the canvas, shrinking the canvas
+// to the image's width
+func (s *TextBoard) renderStoryImage(ctx context.Context, canvas board.Canvas, story *Story) error {
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+
+	key := fmt.Sprintf("%s_%dx%d", story.ImageURL, zeroed.Dx(), zeroed.Dy())
+
+	s.Lock()
+	img, ok := s.images[key]
+	s.Unlock()
+
+	if !ok {
+		api, ok := s.api.(StoryAPI)
+		if !ok {
+			return fmt.Errorf("API does not support story images")
+		}
+		src, err := api.GetStoryImage(ctx, story)
+		if err != nil {
+			return err
+		}
+
+		// Story images are usually landscape, so allow up to twice the canvas height in width
+		img = imaging.Fit(src, zeroed.Dy()*2, zeroed.Dy(), imaging.Lanczos)
+
+		s.Lock()
+		s.images[key] = img
+		s.Unlock()
+	}
+
+	canvas.SetWidth(img.Bounds().Dx())
+
+	y := zeroed.Min.Y + ((zeroed.Dy() - img.Bounds().Dy()) / 2)
+	draw.Draw(canvas, image.Rect(zeroed.Min.X, y, zeroed.Min.X+img.Bounds().Dx(), y+img.Bounds().Dy()), img, img.Bounds().Min, draw.Over)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package textboard
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// getStories gets stories from a StoryAPI, filtered by the favorite teams and max age. Other
+// APIs' text is returned as headline-only stories.
+func (s *TextBoard) getStories(ctx context.Context) ([]*Story, error) {
+	api, ok := s.api.(StoryAPI)
+	if !ok {
+		texts, err := s.api.GetText(ctx)
+		if err != nil {
+			return nil, err
+		}
+		stories := make([]*Story, 0, len(texts))
+		for _, t := range texts {
+			stories = append(stories, &Story{Headline: t})
+		}
+		return stories, nil
+	}
+
+	stories, err := api.GetStories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterStories(stories, s.config.FavoriteTeams, s.config.maxAge, time.Now()), nil
+}
+
+// filterStories keeps stories about one of the favorite teams, published within maxAge of now.
+// An empty favorites list or a zero maxAge doesn't filter. Stories without a publish time are kept.
+func filterStories(stories []*Story, favorites []string, maxAge time.Duration, now time.Time) []*Story {
+	filtered := []*Story{}
+	for _, story := range stories {
+		if maxAge > 0 && !story.Published.IsZero() && now.Sub(story.Published) > maxAge {
+			continue
+		}
+		if len(favorites) > 0 && !story.isAbout(favorites) {
+			continue
+		}
+		filtered = append(filtered, story)
+	}
+
+	return filtered
+}
+
+func (s *Story) isAbout(teams []string) bool {
+	for _, team := range teams {
+		for _, t := range s.Teams {
+			if strings.EqualFold(strings.TrimSpace(team), t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pruneImages drops cached images of stories that are no longer shown
+func (s *TextBoard) pruneImages(stories []*Story) {
+	s.Lock()
+	defer s.Unlock()
+
+	current := map[string]struct{}{}
+	for _, story := range stories {
+		current[story.ImageURL] = struct{}{}
+	}
+
+	for key := range s.images {
+		url := key[:strings.LastIndex(key, "_")]
+		if _, ok := current[url]; !ok {
+			delete(s.images, key)
+		}
+	}
+}
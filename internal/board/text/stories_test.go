@@ -0,0 +1,64 @@
+package textboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterStories(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 4, 5, 12, 0, 0, 0, time.UTC)
+	stories := []*Story{
+		{Headline: "fresh", Published: now.Add(-1 * time.Hour), Teams: []string{"1", "Atlanta Falcons", "ATL"}},
+		{Headline: "stale", Published: now.Add(-48 * time.Hour), Teams: []string{"ATL"}},
+		{Headline: "other team", Published: now.Add(-1 * time.Hour), Teams: []string{"NO"}},
+		{Headline: "undated"},
+	}
+
+	headlines := func(s []*Story) []string {
+		h := []string{}
+		for _, story := range s {
+			h = append(h, story.Headline)
+		}
+		return h
+	}
+
+	tests := []struct {
+		name      string
+		favorites []string
+		maxAge    time.Duration
+		expected  []string
+	}{
+		{
+			name:     "no filter",
+			expected: []string{"fresh", "stale", "other team", "undated"},
+		},
+		{
+			name:     "recency",
+			maxAge:   24 * time.Hour,
+			expected: []string{"fresh", "other team", "undated"},
+		},
+		{
+			name:      "favorites",
+			favorites: []string{"atl"},
+			expected:  []string{"fresh", "stale"},
+		},
+		{
+			name:      "favorites and recency",
+			favorites: []string{"Atlanta Falcons", "NO"},
+			maxAge:    24 * time.Hour,
+			expected:  []string{"fresh", "other team"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, test.expected, headlines(filterStories(stories, test.favorites, test.maxAge, now)))
+		})
+	}
+}
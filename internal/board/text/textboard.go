@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"image"
-	"image/color"
 	"image/draw"
 	"strings"
 	"sync"
@@ -21,11 +20,14 @@ import (
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
 	"github.com/robbydyer/sports/internal/rgbrender"
 	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+	"github.com/robbydyer/sports/internal/theme"
 	"github.com/robbydyer/sports/internal/twirphelpers"
 	"github.com/robbydyer/sports/internal/util"
 )
 
-var defaultScrollDelay = 15 * time.Millisecond
+var (
+	defaultScrollDelay = 15 * time.Millisecond
+)
 
 // TextBoard displays stocks
 type TextBoard struct {
@@ -37,6 +39,7 @@ type TextBoard struct {
 	cancelBoard chan struct{}
 	rpcServer   pb.TwirpServer
 	logos       map[string]*logo.Logo
+	images      map[string]image.Image
 	enabler     board.Enabler
 	sync.Mutex
 }
@@ -47,15 +50,21 @@ type Config struct {
 	updateInterval     time.Duration
 	scrollDelay        time.Duration
 	halfSizeLogo       bool
-	StartEnabled       *atomic.Bool `json:"enabled"`
-	BoardDelay         string       `json:"boardDelay"`
-	UpdateInterval     string       `json:"updateInterval"`
-	TightScrollPadding int          `json:"tightScrollPadding"`
-	ScrollDelay        string       `json:"scrollDelay"`
-	OnTimes            []string     `json:"onTimes"`
-	OffTimes           []string     `json:"offTimes"`
-	UseLogos           *atomic.Bool `json:"useLogos"`
-	Max                *int         `json:"max"`
+	StartEnabled       *atomic.Bool          `json:"enabled"`
+	BoardDelay         string                `json:"boardDelay"`
+	UpdateInterval     string                `json:"updateInterval"`
+	TightScrollPadding int                   `json:"tightScrollPadding"`
+	ScrollDelay        string                `json:"scrollDelay"`
+	OnTimes            []string              `json:"onTimes"`
+	OffTimes           []string              `json:"offTimes"`
+	UseLogos           *atomic.Bool          `json:"useLogos"`
+	Max                *int                  `json:"max"`
+	ShowSummary        *atomic.Bool          `json:"showSummary"`
+	FavoriteTeams      []string              `json:"favoriteTeams"`
+	MaxAge             string                `json:"maxAge"`
+	Font               *rgbrender.FontConfig `json:"font"`
+	Theme              string                `json:"theme"`
+	maxAge             time.Duration
 }
 
 // OptionFunc ...
@@ -68,6 +77,23 @@ type API interface {
 	HTTPPathPrefix() string
 }
 
+// StoryAPI is an optional interface for an API with more detail than headline text
+type StoryAPI interface {
+	API
+	GetStories(ctx context.Context) ([]*Story, error)
+	GetStoryImage(ctx context.Context, story *Story) (image.Image, error)
+}
+
+// Story is a news story
+type Story struct {
+	Headline    string
+	Description string
+	Published   time.Time
+	ImageURL    string
+	// Teams are the IDs, abbreviations or names of the teams a story is about
+	Teams []string
+}
+
 // SetDefaults ...
 func (c *Config) SetDefaults() {
 	if c.StartEnabled == nil {
@@ -108,16 +134,42 @@ func (c *Config) SetDefaults() {
 	if c.UseLogos == nil {
 		c.UseLogos = atomic.NewBool(true)
 	}
+	if c.ShowSummary == nil {
+		c.ShowSummary = atomic.NewBool(false)
+	}
+
+	if c.MaxAge != "" {
+		d, err := time.ParseDuration(c.MaxAge)
+		if err == nil {
+			c.maxAge = d
+		}
+	}
+}
+
+// validate checks the settings that SetDefaults can't fall back from
+func (c *Config) validate() error {
+	if c.MaxAge != "" {
+		if _, err := time.ParseDuration(c.MaxAge); err != nil {
+			return fmt.Errorf("invalid maxAge: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // New ...
 func New(api API, config *Config, log *zap.Logger, opts ...OptionFunc) (*TextBoard, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
 	s := &TextBoard{
 		config:      config,
 		api:         api,
 		log:         log,
 		cancelBoard: make(chan struct{}),
 		logos:       make(map[string]*logo.Logo),
+		images:      make(map[string]image.Image),
 		enabler:     enabler.New(),
 	}
 
@@ -133,7 +185,7 @@ func New(api API, config *Config, log *zap.Logger, opts ...OptionFunc) (*TextBoa
 	}
 	if err := util.SetCrons(config.OffTimes, func() {
 		s.log.Info("textboard turning off")
-		s.Enabler().Disable()
+		board.DisableScheduled(s.Enabler())
 	}); err != nil {
 		return nil, err
 	}
@@ -177,7 +229,12 @@ func (s *TextBoard) InBetween() bool {
 
 // Name ...
 func (s *TextBoard) Name() string {
-	return "Texts"
+	return fmt.Sprintf("Texts: %s", s.api.HTTPPathPrefix())
+}
+
+// theme returns the board's theme, or the global theme if it doesn't choose one
+func (s *TextBoard) theme() *theme.Theme {
+	return theme.Resolve(s.config.Theme)
 }
 
 func (s *TextBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
@@ -241,12 +298,12 @@ func (s *TextBoard) render(ctx context.Context, canvas board.Canvas) (board.Canv
 
 	go s.enablerCancel(boardCtx, boardCancel)
 
-	texts, err := s.api.GetText(ctx)
+	stories, err := s.getStories(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(texts) < 1 {
+	if len(stories) < 1 {
 		return nil, nil
 	}
 
@@ -266,6 +323,11 @@ func (s *TextBoard) render(ctx context.Context, canvas board.Canvas) (board.Canv
 			s.writer.YStartCorrection = -1 * ((bounds.Dy() / 32) + 1)
 		}
 
+		if err := s.writer.Configure(s.config.Font); err != nil {
+			s.writer = nil
+			return nil, err
+		}
+
 		s.log.Debug("created writer for textboard",
 			zap.Float64("font size", s.writer.FontSize),
 			zap.Int("Y Start correction", s.writer.YStartCorrection),
@@ -304,28 +366,50 @@ func (s *TextBoard) render(ctx context.Context, canvas board.Canvas) (board.Canv
 		canvas.SetWidth(origWidth)
 	}()
 
+	showSummary := s.config.ShowSummary.Load()
+	if showSummary {
+		s.pruneImages(stories)
+	}
+
 TEXT:
-	for _, text := range texts {
+	for _, story := range stories {
 		select {
 		case <-boardCtx.Done():
 			return nil, context.Canceled
 		default:
 		}
 		num++
-		if s.config.UseLogos.Load() {
+
+		drewImage := false
+		if showSummary && story.ImageURL != "" {
+			if err := s.renderStoryImage(boardCtx, canvas, story); err != nil {
+				s.log.Error("failed to render story image",
+					zap.String("url", story.ImageURL),
+					zap.Error(err),
+				)
+				canvas.SetWidth(origWidth)
+			} else {
+				drewImage = true
+				scrollCanvas.AddCanvas(canvas)
+				draw.Draw(canvas, canvas.Bounds(), &image.Uniform{s.theme().BackgroundColor()}, image.Point{}, draw.Over)
+				canvas.SetWidth(origWidth)
+			}
+		}
+
+		if !drewImage && s.config.UseLogos.Load() {
 			if err := s.renderLogo(boardCtx, canvas); err != nil {
 				s.log.Error("failed to render news logo",
 					zap.Error(err),
 				)
 			}
 			scrollCanvas.AddCanvas(canvas)
-			draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+			draw.Draw(canvas, canvas.Bounds(), &image.Uniform{s.theme().BackgroundColor()}, image.Point{}, draw.Over)
 		}
 
 		s.log.Debug("render text",
-			zap.String("text", text),
+			zap.String("text", story.Headline),
 		)
-		if err := s.doRender(canvas, text); err != nil {
+		if err := s.doRender(canvas, story.Headline, s.theme().TextColor()); err != nil {
 			s.log.Error("failed to render text",
 				zap.Error(err),
 			)
@@ -333,7 +417,18 @@ TEXT:
 		}
 
 		scrollCanvas.AddCanvas(canvas)
-		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{s.theme().BackgroundColor()}, image.Point{}, draw.Over)
+
+		if showSummary && story.Description != "" {
+			if err := s.doRender(canvas, story.Description, s.theme().SecondaryColor()); err != nil {
+				s.log.Error("failed to render story summary",
+					zap.Error(err),
+				)
+			} else {
+				scrollCanvas.AddCanvas(canvas)
+				draw.Draw(canvas, canvas.Bounds(), &image.Uniform{s.theme().BackgroundColor()}, image.Point{}, draw.Over)
+			}
+		}
 
 		if s.config.Max != nil && num >= *s.config.Max {
 			s.log.Debug("max number of headlines reached, skipping remainder",
@@ -0,0 +1,56 @@
+package textboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		maxAge string
+		valid  bool
+	}{
+		{name: "unset", maxAge: "", valid: true},
+		{name: "duration", maxAge: "48h", valid: true},
+		{name: "days", maxAge: "2d", valid: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			c := &Config{MaxAge: test.maxAge}
+			c.SetDefaults()
+			if test.valid {
+				require.NoError(t, c.validate())
+			} else {
+				require.Error(t, c.validate())
+			}
+		})
+	}
+}
+
+type nameAPI struct {
+	API
+	prefix string
+}
+
+func (a *nameAPI) HTTPPathPrefix() string {
+	return a.prefix
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+
+	names := make(map[string]struct{})
+	for _, prefix := range []string{"nfl", "nba", "feed/hackernews"} {
+		s := &TextBoard{api: &nameAPI{prefix: prefix}}
+		names[s.Name()] = struct{}{}
+	}
+	require.Len(t, names, 3)
+	require.Contains(t, names, "Texts: feed/hackernews")
+}
@@ -1,44 +1,102 @@
 package config
 
 import (
+	"fmt"
+	"os"
+
+	yaml "github.com/ghodss/yaml"
+
 	calendarboard "github.com/robbydyer/sports/internal/board/calendar"
 	clock "github.com/robbydyer/sports/internal/board/clock"
+	fightboard "github.com/robbydyer/sports/internal/board/fight"
+	golfboard "github.com/robbydyer/sports/internal/board/golf"
 	imageboard "github.com/robbydyer/sports/internal/board/image"
+	linesboard "github.com/robbydyer/sports/internal/board/lines"
+	pollboard "github.com/robbydyer/sports/internal/board/poll"
 	racingboard "github.com/robbydyer/sports/internal/board/racing"
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
 	statboard "github.com/robbydyer/sports/internal/board/stat"
 	sysboard "github.com/robbydyer/sports/internal/board/sys"
+	tennisboard "github.com/robbydyer/sports/internal/board/tennis"
+	"github.com/robbydyer/sports/internal/espnboard"
+	"github.com/robbydyer/sports/internal/feed"
+	"github.com/robbydyer/sports/internal/fetch"
+	"github.com/robbydyer/sports/internal/locale"
 	"github.com/robbydyer/sports/internal/sportsmatrix"
+	"github.com/robbydyer/sports/internal/theme"
 )
 
 // Config holds configuration for the RGB matrix and all of its supported Boards
 type Config struct {
-	Debug              bool                  `json:"debug"`
-	EnableNHL          bool                  `json:"enableNHL,omitempty"`
-	NHLConfig          *sportboard.Config    `json:"nhlConfig,omitempty"`
-	MLBConfig          *sportboard.Config    `json:"mlbConfig,omitempty"`
-	NCAAMConfig        *sportboard.Config    `json:"ncaamConfig,omitempty"`
-	NCAAFConfig        *sportboard.Config    `json:"ncaafConfig,omitempty"`
-	NBAConfig          *sportboard.Config    `json:"nbaConfig,omitempty"`
-	NFLConfig          *sportboard.Config    `json:"nflConfig,omitempty"`
-	MLSConfig          *sportboard.Config    `json:"mlsConfig,omitempty"`
-	EPLConfig          *sportboard.Config    `json:"eplConfig,omitempty"`
-	DFLConfig          *sportboard.Config    `json:"dflConfig,omitempty"`
-	DFBConfig          *sportboard.Config    `json:"dfbConfig,omitempty"`
-	UEFAConfig         *sportboard.Config    `json:"uefaConfig,omitempty"`
-	FIFAConfig         *sportboard.Config    `json:"fifaConfig,omitempty"`
-	ImageConfig        *imageboard.Config    `json:"imageConfig"`
-	ClockConfig        *clock.Config         `json:"clockConfig"`
-	SysConfig          *sysboard.Config      `json:"sysConfig"`
-	PGA                *statboard.Config     `json:"pga"`
-	SportsMatrixConfig *sportsmatrix.Config  `json:"sportsMatrixConfig,omitempty"`
-	F1Config           *racingboard.Config   `json:"f1Config"`
-	IRLConfig          *racingboard.Config   `json:"irlConfig"`
-	CalenderConfig     *calendarboard.Config `json:"calendarConfig"`
-	NCAAWConfig        *sportboard.Config    `json:"ncaawConfig,omitempty"`
-	WNBAConfig         *sportboard.Config    `json:"wnbaConfig,omitempty"`
-	LigueConfig        *sportboard.Config    `json:"ligueConfig,omitempty"`
-	SerieaConfig       *sportboard.Config    `json:"serieaConfig,omitempty"`
-	LaligaConfig       *sportboard.Config    `json:"laligaConfig,omitempty"`
-	XFLConfig          *sportboard.Config    `json:"xflConfig,omitempty"`
+	Debug              bool                           `json:"debug"`
+	EnableNHL          bool                           `json:"enableNHL,omitempty"`
+	NHLConfig          *sportboard.Config             `json:"nhlConfig,omitempty"`
+	MLBConfig          *sportboard.Config             `json:"mlbConfig,omitempty"`
+	NCAAMConfig        *sportboard.Config             `json:"ncaamConfig,omitempty"`
+	NCAAFConfig        *sportboard.Config             `json:"ncaafConfig,omitempty"`
+	NBAConfig          *sportboard.Config             `json:"nbaConfig,omitempty"`
+	NFLConfig          *sportboard.Config             `json:"nflConfig,omitempty"`
+	MLSConfig          *sportboard.Config             `json:"mlsConfig,omitempty"`
+	EPLConfig          *sportboard.Config             `json:"eplConfig,omitempty"`
+	DFLConfig          *sportboard.Config             `json:"dflConfig,omitempty"`
+	DFBConfig          *sportboard.Config             `json:"dfbConfig,omitempty"`
+	UEFAConfig         *sportboard.Config             `json:"uefaConfig,omitempty"`
+	FIFAConfig         *sportboard.Config             `json:"fifaConfig,omitempty"`
+	ImageConfig        *imageboard.Config             `json:"imageConfig"`
+	ClockConfig        *clock.Config                  `json:"clockConfig"`
+	SysConfig          *sysboard.Config               `json:"sysConfig"`
+	PGA                *statboard.Config              `json:"pga"`
+	SportsMatrixConfig *sportsmatrix.Config           `json:"sportsMatrixConfig,omitempty"`
+	F1Config           *racingboard.Config            `json:"f1Config"`
+	IRLConfig          *racingboard.Config            `json:"irlConfig"`
+	CalenderConfig     *calendarboard.Config          `json:"calendarConfig"`
+	NCAAWConfig        *sportboard.Config             `json:"ncaawConfig,omitempty"`
+	WNBAConfig         *sportboard.Config             `json:"wnbaConfig,omitempty"`
+	LigueConfig        *sportboard.Config             `json:"ligueConfig,omitempty"`
+	SerieaConfig       *sportboard.Config             `json:"serieaConfig,omitempty"`
+	LaligaConfig       *sportboard.Config             `json:"laligaConfig,omitempty"`
+	XFLConfig          *sportboard.Config             `json:"xflConfig,omitempty"`
+	IPLConfig          *sportboard.Config             `json:"iplConfig,omitempty"`
+	IntlCricketConfig  *sportboard.Config             `json:"intlcricketConfig,omitempty"`
+	SixNationsConfig   *sportboard.Config             `json:"sixnationsConfig,omitempty"`
+	SuperRugbyConfig   *sportboard.Config             `json:"superrugbyConfig,omitempty"`
+	TickerConfig       *sportboard.TickerConfig       `json:"ticker,omitempty"`
+	RacingConfigs      map[string]*racingboard.Config `json:"racingConfigs"`
+	GolfConfigs        map[string]*golfboard.Config   `json:"golfConfigs"`
+	TennisConfigs      map[string]*tennisboard.Config `json:"tennisConfigs"`
+	FightConfigs       map[string]*fightboard.Config  `json:"fightConfigs"`
+	PollConfigs        map[string]*pollboard.Config   `json:"pollConfigs"`
+	LinesConfigs       map[string]*linesboard.Config  `json:"linesConfigs"`
+	ESPNLeagues        []*ESPNLeague                  `json:"espnLeagues"`
+	ESPNLeaguesFile    string                         `json:"espnLeaguesFile"`
+	Feeds              map[string]*feed.Config        `json:"feeds"`
+	LogoPositionDir    string                         `json:"logoPositionDir"`
+	LogoImageDir       string                         `json:"logoImageDir"`
+	Theme              string                         `json:"theme"`
+	Themes             []*theme.Theme                 `json:"themes"`
+	Locale             *locale.Config                 `json:"locale"`
+	FallbackFonts      []string                       `json:"fallbackFonts"`
+	Transliterate      bool                           `json:"transliterate"`
+	Fetch              *fetch.Config                  `json:"fetch"`
+}
+
+// ESPNLeague is an ESPN league defined in the config rather than built in, along with its board config
+type ESPNLeague struct {
+	espnboard.LeagueDefinition
+	Board *sportboard.Config `json:"board"`
+}
+
+// LoadESPNLeagues reads a YAML or JSON list of ESPNLeague from a file
+func LoadESPNLeagues(filename string) ([]*ESPNLeague, error) {
+	dat, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var leagues []*ESPNLeague
+	if err := yaml.Unmarshal(dat, &leagues); err != nil {
+		return nil, fmt.Errorf("failed to parse ESPN leagues file %s: %w", filename, err)
+	}
+
+	return leagues, nil
 }
@@ -4,12 +4,14 @@ import "go.uber.org/atomic"
 
 type Enabler struct {
 	enabled             *atomic.Bool
+	scheduled           *atomic.Bool
 	stateChangeCallback func()
 }
 
 func New() *Enabler {
 	return &Enabler{
-		enabled: atomic.NewBool(false),
+		enabled:   atomic.NewBool(false),
+		scheduled: atomic.NewBool(false),
 	}
 }
 
@@ -26,6 +28,7 @@ func (e *Enabler) Disable() bool {
 }
 
 func (e *Enabler) Store(set bool) bool {
+	e.scheduled.Store(false)
 	if e.enabled.CompareAndSwap(!set, set) {
 		if e.stateChangeCallback != nil {
 			e.stateChangeCallback()
@@ -35,6 +38,18 @@ func (e *Enabler) Store(set bool) bool {
 	return false
 }
 
+// DisableScheduled disables for a board's scheduled off times
+func (e *Enabler) DisableScheduled() bool {
+	changed := e.Store(false)
+	e.scheduled.Store(true)
+	return changed
+}
+
+// DisabledBySchedule determines if the last change was disabling for a board's scheduled off times
+func (e *Enabler) DisabledBySchedule() bool {
+	return !e.Enabled() && e.scheduled.Load()
+}
+
 func (e *Enabler) SetStateChangeCallback(s func()) {
 	e.stateChangeCallback = s
 }
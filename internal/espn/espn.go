@@ -17,6 +17,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/rgbrender"
 	"github.com/robbydyer/sports/internal/util"
 )
@@ -259,7 +260,7 @@ func pullTeams(ctx context.Context, sport string, league string) ([]byte, error)
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -0,0 +1,392 @@
+package espnboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	"github.com/robbydyer/sports/internal/fetch"
+)
+
+const (
+	leaderStatCol  = "STAT"
+	leaderValueCol = "VAL"
+)
+
+// boxScoreTeamStats are the team stats compared in a box score, keyed by sport. If none of
+// these are found in a game summary, all of the summary's team stats are used.
+var boxScoreTeamStats = map[string][]string{
+	"football":   {"firstDowns", "totalYards", "netPassingYards", "rushingYards", "turnovers", "possessionTime"},
+	"basketball": {"fieldGoalPct", "threePointFieldGoalPct", "totalRebounds", "assists", "turnovers"},
+	"hockey":     {"shotsTotal", "powerPlayGoals", "faceoffPercent", "hits", "blockedShots"},
+	"soccer":     {"possessionPct", "totalShots", "shotsOnTarget", "wonCorners", "foulsCommitted", "saves"},
+	"baseball":   {"hits", "homeRuns", "RBIs", "walks", "strikeouts"},
+}
+
+var boxScoreShortNames = map[string]string{
+	"firstdowns":             "1D",
+	"totalyards":             "YDS",
+	"netpassingyards":        "PASS",
+	"rushingyards":           "RUSH",
+	"turnovers":              "TO",
+	"possessiontime":         "TOP",
+	"fieldgoalpct":           "FG%",
+	"threepointfieldgoalpct": "3P%",
+	"totalrebounds":          "REB",
+	"assists":                "AST",
+	"shotstotal":             "SOG",
+	"powerplaygoals":         "PPG",
+	"faceoffpercent":         "FO%",
+	"hits":                   "H",
+	"blockedshots":           "BLK",
+	"possessionpct":          "POS%",
+	"totalshots":             "SHOT",
+	"shotsontarget":          "SOT",
+	"woncorners":             "CRN",
+	"foulscommitted":         "FLS",
+	"saves":                  "SV",
+	"homeruns":               "HR",
+	"rbis":                   "RBI",
+	"walks":                  "BB",
+	"strikeouts":             "K",
+}
+
+type summaryStat struct {
+	Name         string         `json:"name"`
+	Label        string         `json:"label"`
+	Abbreviation string         `json:"abbreviation"`
+	DisplayValue string         `json:"displayValue"`
+	Stats        []*summaryStat `json:"stats"`
+}
+
+type summaryAthlete struct {
+	LastName    string `json:"lastName"`
+	DisplayName string `json:"displayName"`
+	ShortName   string `json:"shortName"`
+}
+
+type summaryData struct {
+	Boxscore struct {
+		Teams []struct {
+			HomeAway string `json:"homeAway"`
+			Team     struct {
+				Abbreviation string `json:"abbreviation"`
+			} `json:"team"`
+			Statistics []*summaryStat `json:"statistics"`
+		} `json:"teams"`
+	} `json:"boxscore"`
+	Leaders []struct {
+		Team struct {
+			Abbreviation string `json:"abbreviation"`
+		} `json:"team"`
+		Leaders []struct {
+			Name             string `json:"name"`
+			ShortDisplayName string `json:"shortDisplayName"`
+			Abbreviation     string `json:"abbreviation"`
+			Leaders          []struct {
+				DisplayValue string          `json:"displayValue"`
+				Athlete      *summaryAthlete `json:"athlete"`
+			} `json:"leaders"`
+		} `json:"leaders"`
+	} `json:"leaders"`
+}
+
+// boxScoreRow is a single row of a box score table
+type boxScoreRow struct {
+	name   string
+	prefix string
+	stats  map[string]string
+}
+
+// GetBoxScore gets the live box score for a game from the ESPN game summary
+func (g *Game) GetBoxScore(ctx context.Context) (*sportboard.BoxScore, error) {
+	body, err := g.getSummary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sport := strings.Split(g.leaguer.APIPath(), "/")[0]
+
+	return parseBoxScore(body, boxScoreTeamStats[sport])
+}
+
+// summaryTTL is how long a game summary is reused. The box score, win probability and lines
+// layers are all drawn from it on every render of a live game.
+const summaryTTL = 30 * time.Second
+
+type gameSummary struct {
+	body    []byte
+	fetched time.Time
+}
+
+// getSummary gets the ESPN game summary, which has the box score and win probability. Summaries
+// are cached per game for summaryTTL.
+func (g *Game) getSummary(ctx context.Context) ([]byte, error) {
+	if g.espnBoard == nil {
+		return g.fetchSummary(ctx)
+	}
+
+	e := g.espnBoard
+	e.summaryLock.Lock()
+	cached, ok := e.summaries[g.ID]
+	e.summaryLock.Unlock()
+	if ok && time.Since(cached.fetched) < summaryTTL {
+		return cached.body, nil
+	}
+
+	body, err := g.fetchSummary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e.summaryLock.Lock()
+	defer e.summaryLock.Unlock()
+	for id, s := range e.summaries {
+		if time.Since(s.fetched) >= summaryTTL {
+			delete(e.summaries, id)
+		}
+	}
+	e.summaries[g.ID] = &gameSummary{
+		body:    body,
+		fetched: time.Now(),
+	}
+
+	return body, nil
+}
+
+func (g *Game) fetchSummary(ctx context.Context) ([]byte, error) {
+	// http://site.api.espn.com/apis/site/v2/sports/football/nfl/summary?event=401547417
+	uri, err := url.Parse(fmt.Sprintf("http://site.api.espn.com/apis/site/v2/sports/%s/summary", g.leaguer.APIPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	v := uri.Query()
+	v.Set("event", g.ID)
+	uri.RawQuery = v.Encode()
+
+	if g.espnBoard != nil {
+		g.espnBoard.log.Debug("fetching game summary",
+			zap.String("league", g.leaguer.League()),
+			zap.String("uri", uri.String()),
+		)
+	}
+
+	req, err := http.NewRequest("GET", uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req = req.WithContext(ctx)
+
+	client := fetch.Client()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET game summary: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return body, nil
+}
+
+func parseBoxScore(dat []byte, teamStats []string) (*sportboard.BoxScore, error) {
+	var d *summaryData
+	if err := json.Unmarshal(dat, &d); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal game summary: %w", err)
+	}
+
+	teams := d.Boxscore.Teams
+	if len(teams) != 2 {
+		return nil, fmt.Errorf("no box score available")
+	}
+	if strings.EqualFold(teams[0].HomeAway, "home") {
+		teams[0], teams[1] = teams[1], teams[0]
+	}
+
+	box := &sportboard.BoxScore{
+		LeaderColumns: []string{leaderStatCol, leaderValueCol},
+	}
+
+	away := flattenSummaryStats(teams[0].Statistics)
+	home := flattenSummaryStats(teams[1].Statistics)
+
+	var order []*summaryStat
+	for _, name := range teamStats {
+		if st, ok := away[strings.ToLower(name)]; ok {
+			order = append(order, st)
+		}
+	}
+	if len(order) == 0 {
+		order = flattenedOrder(teams[0].Statistics)
+	}
+
+	for _, st := range order {
+		homeVal := ""
+		if h, ok := home[strings.ToLower(st.Name)]; ok {
+			homeVal = h.DisplayValue
+		}
+		box.TeamStats = append(box.TeamStats, &boxScoreRow{
+			name: summaryStatShortName(st),
+			stats: map[string]string{
+				teams[0].Team.Abbreviation: st.DisplayValue,
+				teams[1].Team.Abbreviation: homeVal,
+			},
+		})
+	}
+	box.TeamStatColumns = []string{teams[0].Team.Abbreviation, teams[1].Team.Abbreviation}
+
+	for _, team := range d.Leaders {
+		for _, cat := range team.Leaders {
+			if len(cat.Leaders) < 1 || cat.Leaders[0].Athlete == nil {
+				continue
+			}
+			leader := cat.Leaders[0]
+
+			label := cat.ShortDisplayName
+			if label == "" {
+				label = cat.Abbreviation
+			}
+			if label == "" {
+				label = cat.Name
+			}
+
+			box.Leaders = append(box.Leaders, &boxScoreRow{
+				name:   leader.Athlete.lastName(),
+				prefix: team.Team.Abbreviation,
+				stats: map[string]string{
+					leaderStatCol:  label,
+					leaderValueCol: compactLeaderValue(leader.DisplayValue),
+				},
+			})
+		}
+	}
+
+	return box, nil
+}
+
+// flattenSummaryStats maps stats by lowercased name. Some sports group team stats
+// into categories, such as batting and pitching. The first occurrence of a stat wins.
+func flattenSummaryStats(stats []*summaryStat) map[string]*summaryStat {
+	flat := make(map[string]*summaryStat)
+	for _, st := range flattenedOrder(stats) {
+		k := strings.ToLower(st.Name)
+		if _, ok := flat[k]; !ok {
+			flat[k] = st
+		}
+	}
+	return flat
+}
+
+func flattenedOrder(stats []*summaryStat) []*summaryStat {
+	var flat []*summaryStat
+	for _, st := range stats {
+		if len(st.Stats) > 0 {
+			flat = append(flat, flattenedOrder(st.Stats)...)
+			continue
+		}
+		flat = append(flat, st)
+	}
+	return flat
+}
+
+func summaryStatShortName(st *summaryStat) string {
+	if s, ok := boxScoreShortNames[strings.ToLower(st.Name)]; ok {
+		return s
+	}
+	if st.Abbreviation != "" {
+		return st.Abbreviation
+	}
+	if st.Label != "" {
+		return st.Label
+	}
+	return st.Name
+}
+
+// compactLeaderValue shortens multi-part leader values, such as "18/25, 250 YDS, 2 TD",
+// to the part that fits best in a table cell
+func compactLeaderValue(val string) string {
+	parts := strings.Split(val, ",")
+	if len(parts) < 2 {
+		return strings.TrimSpace(val)
+	}
+	for _, p := range parts {
+		if strings.Contains(p, "YDS") {
+			return strings.TrimSpace(p)
+		}
+	}
+	return strings.TrimSpace(parts[0])
+}
+
+func (a *summaryAthlete) lastName() string {
+	if a.LastName != "" {
+		return a.LastName
+	}
+	name := a.DisplayName
+	if name == "" {
+		name = a.ShortName
+	}
+	parts := strings.Fields(name)
+	if len(parts) < 1 {
+		return ""
+	}
+	return parts[len(parts)-1]
+}
+
+// FirstName ...
+func (r *boxScoreRow) FirstName() string {
+	return ""
+}
+
+// LastName ...
+func (r *boxScoreRow) LastName() string {
+	return r.name
+}
+
+// GetStat ...
+func (r *boxScoreRow) GetStat(stat string) string {
+	return r.stats[stat]
+}
+
+// StatColor ...
+func (r *boxScoreRow) StatColor(stat string) color.Color {
+	return color.White
+}
+
+// Position ...
+func (r *boxScoreRow) Position() string {
+	return ""
+}
+
+// GetCategory ...
+func (r *boxScoreRow) GetCategory() string {
+	return ""
+}
+
+// UpdateStats ...
+func (r *boxScoreRow) UpdateStats(ctx context.Context) error {
+	return nil
+}
+
+// PrefixCol ...
+func (r *boxScoreRow) PrefixCol() string {
+	return r.prefix
+}
+
+// GetTeamAbbreviation ...
+func (r *boxScoreRow) GetTeamAbbreviation() string {
+	return r.prefix
+}
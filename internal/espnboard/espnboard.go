@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/logo"
 )
 
@@ -48,6 +49,7 @@ type ESPNBoard struct {
 	logos            map[string]*logo.Logo
 	logoConfOnce     map[string]struct{}
 	defaultLogoConf  *[]*logo.Config
+	savedLogoKeys    map[string]struct{}
 	allTeamIDs       []string
 	logoLock         sync.RWMutex
 	logoLockers      map[string]*sync.Mutex
@@ -60,6 +62,11 @@ type ESPNBoard struct {
 	offSeason        map[string]bool
 	mockLiveGames    map[string][]byte
 	mockSchedule     []byte
+	rosters          map[string][]*Player
+	statLabels       map[string]string
+	rosterLock       sync.Mutex
+	summaries        map[string]*gameSummary
+	summaryLock      sync.Mutex
 	sync.Mutex
 }
 
@@ -83,6 +90,7 @@ func New(ctx context.Context, leaguer Leaguer, logger *zap.Logger, r rankSetter,
 		logos:            make(map[string]*logo.Logo),
 		logoConfOnce:     make(map[string]struct{}),
 		defaultLogoConf:  &[]*logo.Config{},
+		savedLogoKeys:    make(map[string]struct{}),
 		logoLockers:      make(map[string]*sync.Mutex),
 		conferenceNames:  make(map[string]struct{}),
 		rankSetter:       r,
@@ -91,6 +99,9 @@ func New(ctx context.Context, leaguer Leaguer, logger *zap.Logger, r rankSetter,
 		ranksSet:         atomic.NewBool(false),
 		lastScheduleCall: make(map[string]*time.Time),
 		offSeason:        make(map[string]bool),
+		rosters:          make(map[string][]*Player),
+		statLabels:       make(map[string]string),
+		summaries:        make(map[string]*gameSummary),
 	}
 
 	c := cron.New()
@@ -127,6 +138,16 @@ func (e *ESPNBoard) CacheClear(ctx context.Context) {
 	for k := range e.offSeason {
 		delete(e.offSeason, k)
 	}
+	e.rosterLock.Lock()
+	defer e.rosterLock.Unlock()
+	for k := range e.rosters {
+		delete(e.rosters, k)
+	}
+}
+
+// Stale implements board.StaleAPI
+func (e *ESPNBoard) Stale() bool {
+	return fetch.Stale(fmt.Sprintf("http://site.api.espn.com/apis/site/v2/sports/%s/scoreboard", e.leaguer.APIPath()))
 }
 
 // GetTeams ...
@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/rgbrender"
 )
 
@@ -29,6 +30,16 @@ type schedule struct {
 	Events []*event `json:"events"`
 }
 
+type competitor struct {
+	HomeAway   string `json:"homeAway"`
+	Team       *Team  `json:"team"`
+	Score      string `json:"score"`
+	Statistics []*struct {
+		Name         string `json:"name"`
+		DisplayValue string `json:"displayValue"`
+	} `json:"statistics"`
+}
+
 type baseballSituation struct {
 	Balls    int  `json:"balls"`
 	Strikes  int  `json:"strikes"`
@@ -36,6 +47,12 @@ type baseballSituation struct {
 	OnSecond bool `json:"onSecond"`
 	OnThird  bool `json:"onThird"`
 	Outs     int  `json:"outs"`
+	// LastPlay is set for all sports, despite the name. It has the live win probability in football.
+	LastPlay *struct {
+		Probability *struct {
+			HomeWinPercentage *float64 `json:"homeWinPercentage"`
+		} `json:"probability"`
+	} `json:"lastPlay"`
 }
 
 type event struct {
@@ -43,13 +60,9 @@ type event struct {
 	Date         string  `json:"date"`
 	Status       *status `json:"status"`
 	Competitions []struct {
-		Competitors []struct {
-			HomeAway string `json:"homeAway"`
-			Team     *Team  `json:"team"`
-			Score    string `json:"score"`
-		}
-		Odds      []*Odds            `json:"odds"`
-		Situation *baseballSituation `json:"situation"`
+		Competitors []*competitor      `json:"competitors"`
+		Odds        []*Odds            `json:"odds"`
+		Situation   *baseballSituation `json:"situation"`
 	} `json:"competitions"`
 }
 
@@ -60,8 +73,14 @@ type Odds struct {
 		Name     string `json:"name"`
 		Priority int    `json:"priority"`
 	} `json:"provider"`
-	Details   string  `json:"details"`
-	OverUnder float64 `json:"overUnder"`
+	Details      string       `json:"details"`
+	OverUnder    float64      `json:"overUnder"`
+	Spread       float64      `json:"spread"`
+	HomeTeamOdds *teamOdds    `json:"homeTeamOdds"`
+	AwayTeamOdds *teamOdds    `json:"awayTeamOdds"`
+	Moneyline    *oddsMarket  `json:"moneyline"`
+	PointSpread  *oddsMarket  `json:"pointSpread"`
+	Total        *totalMarket `json:"total"`
 }
 
 // Game ...
@@ -87,6 +106,7 @@ type status struct {
 		State       string `json:"state"`
 		ShortDetail string `json:"shortDetail"`
 	} `json:"type"`
+	Summary string `json:"summary"`
 }
 
 // GetID ...
@@ -220,6 +240,9 @@ func (g *Game) GetQuarter() (string, error) {
 			}
 		}
 	}
+	if r, ok := g.leaguer.(richScoreLeaguer); ok {
+		return r.periodName(g.status.Period), nil
+	}
 	return strconv.Itoa(g.status.Period), nil
 }
 
@@ -233,9 +256,23 @@ func (g *Game) GetClock() (string, error) {
 			}
 		}
 	}
+	if s, ok := g.leaguer.(sessionLeaguer); ok {
+		return s.session(g.status), nil
+	}
 	return g.status.DisplayClock, nil
 }
 
+// GetSummary implements sportboard.RichScoreGame
+func (g *Game) GetSummary() (string, error) {
+	if g.status == nil {
+		return "", nil
+	}
+	if _, ok := g.leaguer.(richScoreLeaguer); !ok {
+		return "", nil
+	}
+	return shortSummary(g.status.Summary, g.Home, g.Away), nil
+}
+
 func (g *Game) getMockUpdate() (sportboard.Game, error) {
 	var event *event
 
@@ -287,7 +324,7 @@ func (g *Game) GetUpdate(ctx context.Context) (sportboard.Game, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	req = req.WithContext(ctx)
 
@@ -328,17 +365,27 @@ func (g *Game) GetStartTime(ctx context.Context) (time.Time, error) {
 
 // GetOdds ...
 func (g *Game) GetOdds() (string, string, error) {
-	if len(g.odds) == 0 {
+	odd := g.primaryOdds()
+	if odd == nil {
 		return "", "", fmt.Errorf("no odds for game %d", g.GetID())
 	}
 
+	return extractOverUnder(odd.Details)
+}
+
+// primaryOdds returns the odds of the highest priority provider
+func (g *Game) primaryOdds() *Odds {
+	if len(g.odds) == 0 {
+		return nil
+	}
+
 	for _, odd := range g.odds {
-		if odd.Provider.Priority == 1 || odd.Provider.Priority == 0 {
-			return extractOverUnder(odd.Details)
+		if odd.Provider == nil || odd.Provider.Priority == 1 || odd.Provider.Priority == 0 {
+			return odd
 		}
 	}
 
-	return extractOverUnder(g.odds[0].Details)
+	return g.odds[0]
 }
 
 func extractOverUnder(details string) (string, string, error) {
@@ -426,7 +473,7 @@ func (e *ESPNBoard) GetGames(ctx context.Context, dateStr string) ([]*Game, erro
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	req = req.WithContext(ctx)
 
@@ -487,6 +534,12 @@ func gameFromEvent(event *event, b *ESPNBoard) (*Game, error) {
 				game.Away = team.Team
 				game.Away.Points = team.Score
 			}
+			if b == nil {
+				continue
+			}
+			if r, ok := b.leaguer.(richScoreLeaguer); ok {
+				r.setRichScore(team)
+			}
 		}
 	}
 
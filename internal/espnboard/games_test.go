@@ -78,3 +78,47 @@ func TestExtractOverUnder(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBoxScore(t *testing.T) {
+	t.Parallel()
+
+	dat := `{
+		"boxscore":{"teams":[
+			{"homeAway":"home","team":{"abbreviation":"KC"},"statistics":[
+				{"name":"firstDowns","displayValue":"22","label":"1st Downs"},
+				{"name":"totalYards","displayValue":"380","label":"Total Yards"},
+				{"name":"sacks","displayValue":"2","label":"Sacks"}
+			]},
+			{"homeAway":"away","team":{"abbreviation":"BUF"},"statistics":[
+				{"name":"firstDowns","displayValue":"18","label":"1st Downs"},
+				{"name":"totalYards","displayValue":"301","label":"Total Yards"},
+				{"name":"sacks","displayValue":"1","label":"Sacks"}
+			]}
+		]},
+		"leaders":[
+			{"team":{"abbreviation":"BUF"},"leaders":[
+				{"name":"passingYards","shortDisplayName":"PASS","leaders":[
+					{"displayValue":"18/25, 250 YDS, 2 TD","athlete":{"displayName":"Josh Allen"}}
+				]}
+			]}
+		]
+	}`
+
+	box, err := parseBoxScore([]byte(dat), []string{"totalYards", "firstDowns"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"BUF", "KC"}, box.TeamStatColumns)
+	require.Len(t, box.TeamStats, 2)
+	require.Equal(t, "YDS", box.TeamStats[0].LastName())
+	require.Equal(t, "301", box.TeamStats[0].GetStat("BUF"))
+	require.Equal(t, "380", box.TeamStats[0].GetStat("KC"))
+
+	require.Len(t, box.Leaders, 1)
+	require.Equal(t, "Allen", box.Leaders[0].LastName())
+	require.Equal(t, "BUF", box.Leaders[0].PrefixCol())
+	require.Equal(t, "PASS", box.Leaders[0].GetStat(leaderStatCol))
+	require.Equal(t, "250 YDS", box.Leaders[0].GetStat(leaderValueCol))
+
+	box, err = parseBoxScore([]byte(dat), []string{"unknown"})
+	require.NoError(t, err)
+	require.Len(t, box.TeamStats, 3)
+}
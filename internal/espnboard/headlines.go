@@ -6,16 +6,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"image"
-	"image/png"
+	// Register decoders for league logo files
+	_ "image/jpeg"
+	_ "image/png"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	textboard "github.com/robbydyer/sports/internal/board/text"
+	"github.com/robbydyer/sports/internal/fetch"
 )
 
 // Headlines ...
@@ -24,26 +31,58 @@ type Headlines struct {
 	log            *zap.Logger
 	updateInterval time.Duration
 	lastUpdate     time.Time
-	lastHeadlines  []string
+	lastStories    []*textboard.Story
 	logo           image.Image
+	teamSource     TeamSource
 	sync.Mutex
 }
 
 type news struct {
 	Articles []*struct {
-		Headline string `json:"headline"`
-		Type     string `json:"type"`
+		Headline    string `json:"headline"`
+		Description string `json:"description"`
+		Published   string `json:"published"`
+		Type        string `json:"type"`
+		Images      []*struct {
+			URL string `json:"url"`
+		} `json:"images"`
+		Categories []*struct {
+			Type        string `json:"type"`
+			TeamID      int    `json:"teamId"`
+			Description string `json:"description"`
+		} `json:"categories"`
 	} `json:"articles"`
 }
 
+// TeamSource provides a league's teams
+type TeamSource interface {
+	GetTeams(ctx context.Context) ([]sportboard.Team, error)
+}
+
+// HeadlinesOption ...
+type HeadlinesOption func(h *Headlines)
+
+// WithTeamSource adds team abbreviations to stories, so they can be filtered by favorite teams
+func WithTeamSource(src TeamSource) HeadlinesOption {
+	return func(h *Headlines) {
+		h.teamSource = src
+	}
+}
+
 // NewHeadlines ...
-func NewHeadlines(leaguer Leaguer, logger *zap.Logger) *Headlines {
-	return &Headlines{
+func NewHeadlines(leaguer Leaguer, logger *zap.Logger, opts ...HeadlinesOption) *Headlines {
+	h := &Headlines{
 		leaguer:        leaguer,
 		log:            logger,
 		updateInterval: 1 * time.Hour,
 		logo:           nil,
 	}
+
+	for _, o := range opts {
+		o(h)
+	}
+
+	return h
 }
 
 // HTTPPathPrefix ...
@@ -51,6 +90,11 @@ func (h *Headlines) HTTPPathPrefix() string {
 	return h.leaguer.HTTPPathPrefix()
 }
 
+// Stale implements board.StaleAPI
+func (h *Headlines) Stale() bool {
+	return fetch.Stale(fmt.Sprintf("http://site.api.espn.com/apis/site/v2/sports/%s", h.leaguer.HeadlinePath()))
+}
+
 // GetLogo ...
 func (h *Headlines) GetLogo(ctx context.Context) (image.Image, error) {
 	h.Lock()
@@ -62,15 +106,25 @@ func (h *Headlines) GetLogo(ctx context.Context) (image.Image, error) {
 		)
 		return h.logo, nil
 	}
-	assetfile := fmt.Sprintf("assets/league_logos/%s.png", strings.ToLower(h.leaguer.HTTPPathPrefix()))
+	var dat []byte
+	if d, ok := h.leaguer.(*LeagueDefinition); ok && d.LogoFile != "" {
+		var err error
+		dat, err = os.ReadFile(d.LogoFile)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		assetfile := fmt.Sprintf("assets/league_logos/%s.png", strings.ToLower(h.leaguer.HTTPPathPrefix()))
 
-	dat, err := assets.ReadFile(assetfile)
-	if err != nil {
-		return nil, err
+		var err error
+		dat, err = assets.ReadFile(assetfile)
+		if err != nil {
+			return nil, err
+		}
 	}
 	r := bytes.NewReader(dat)
 
-	l, err := png.Decode(r)
+	l, _, err := image.Decode(r)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode logo for %s: %w", h.leaguer.League(), err)
 	}
@@ -81,11 +135,30 @@ func (h *Headlines) GetLogo(ctx context.Context) (image.Image, error) {
 
 // GetText ...
 func (h *Headlines) GetText(ctx context.Context) ([]string, error) {
-	path := h.leaguer.HeadlinePath()
-	if len(h.lastHeadlines) > 0 && time.Since(h.lastUpdate) < h.updateInterval {
-		return h.lastHeadlines, nil
+	stories, err := h.GetStories(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	texts := make([]string, 0, len(stories))
+	for _, story := range stories {
+		texts = append(texts, story.Headline)
+	}
+
+	return texts, nil
+}
+
+// GetStories gets the league's news stories, with headline news first
+func (h *Headlines) GetStories(ctx context.Context) ([]*textboard.Story, error) {
+	h.Lock()
+	if len(h.lastStories) > 0 && time.Since(h.lastUpdate) < h.updateInterval {
+		defer h.Unlock()
+		return h.lastStories, nil
+	}
+	h.Unlock()
+
+	path := h.leaguer.HeadlinePath()
+
 	uri, err := url.Parse(fmt.Sprintf("http://site.api.espn.com/apis/site/v2/sports/%s", path))
 	if err != nil {
 		return nil, err
@@ -97,7 +170,7 @@ func (h *Headlines) GetText(ctx context.Context) ([]string, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	h.log.Info("Updating headlines from API",
 		zap.String("url", uri.String()),
@@ -118,48 +191,123 @@ func (h *Headlines) GetText(ctx context.Context) ([]string, error) {
 		return nil, err
 	}
 
-	type headline struct {
-		str      string
+	stories := storiesFromNews(n, h.teamAbbreviations(ctx))
+
+	h.Lock()
+	defer h.Unlock()
+
+	h.lastStories = stories
+	h.lastUpdate = time.Now()
+
+	return h.lastStories, nil
+}
+
+// GetStoryImage ...
+func (h *Headlines) GetStoryImage(ctx context.Context, story *textboard.Story) (image.Image, error) {
+	req, err := http.NewRequest("GET", story.ImageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := fetch.Client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get story image: %s", resp.Status)
+	}
+
+	img, _, err := image.Decode(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode story image: %w", err)
+	}
+
+	return img, nil
+}
+
+// teamAbbreviations maps team IDs to abbreviations, if the Headlines has a team source
+func (h *Headlines) teamAbbreviations(ctx context.Context) map[string]string {
+	abbrevs := map[string]string{}
+	if h.teamSource == nil {
+		return abbrevs
+	}
+
+	teams, err := h.teamSource.GetTeams(ctx)
+	if err != nil {
+		h.log.Error("failed to get teams for headlines",
+			zap.String("league", h.leaguer.League()),
+			zap.Error(err),
+		)
+		return abbrevs
+	}
+	for _, t := range teams {
+		abbrevs[t.GetID()] = t.GetAbbreviation()
+	}
+
+	return abbrevs
+}
+
+// storiesFromNews converts news articles to stories, sorted headline news first
+func storiesFromNews(n *news, abbrevs map[string]string) []*textboard.Story {
+	type prioritized struct {
+		story    *textboard.Story
 		priority int
 	}
-	headlines := []headline{}
+	stories := []prioritized{}
 
 	for _, article := range n.Articles {
-		t := strings.ToLower(article.Type)
-
-		head := headline{
-			str: article.Headline,
+		p := prioritized{
+			story: &textboard.Story{
+				Headline:    article.Headline,
+				Description: article.Description,
+			},
 		}
-		switch t {
+
+		switch strings.ToLower(article.Type) {
 		case "headlinenews":
-			head.priority = 1
+			p.priority = 1
 		case "story":
-			head.priority = 2
+			p.priority = 2
 		default:
-			head.priority = 3
+			p.priority = 3
 		}
 
-		h.log.Debug("adding headline",
-			zap.Int("priority", head.priority),
-			zap.String("headline", head.str),
-		)
-		headlines = append(headlines, head)
+		if t, err := time.Parse(time.RFC3339, article.Published); err == nil {
+			p.story.Published = t
+		}
+		if len(article.Images) > 0 {
+			p.story.ImageURL = article.Images[0].URL
+		}
+
+		for _, c := range article.Categories {
+			if !strings.EqualFold(c.Type, "team") {
+				continue
+			}
+			id := fmt.Sprint(c.TeamID)
+			p.story.Teams = append(p.story.Teams, id)
+			if c.Description != "" {
+				p.story.Teams = append(p.story.Teams, c.Description)
+			}
+			if abbrev, ok := abbrevs[id]; ok {
+				p.story.Teams = append(p.story.Teams, abbrev)
+			}
+		}
+
+		stories = append(stories, p)
 	}
 
 	// sort headlines based on priority
-	sort.SliceStable(headlines, func(i int, j int) bool {
-		return headlines[i].priority < headlines[j].priority
+	sort.SliceStable(stories, func(i int, j int) bool {
+		return stories[i].priority < stories[j].priority
 	})
 
-	h.lastHeadlines = make([]string, len(headlines))
-
-	index := 0
-	for _, head := range headlines {
-		h.lastHeadlines[index] = head.str
-		index++
+	sorted := make([]*textboard.Story, 0, len(stories))
+	for _, p := range stories {
+		sorted = append(sorted, p.story)
 	}
 
-	h.lastUpdate = time.Now()
-
-	return h.lastHeadlines, nil
+	return sorted
 }
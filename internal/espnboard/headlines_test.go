@@ -0,0 +1,41 @@
+package espnboard
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoriesFromNews(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`{"articles": [
+	{
+		"headline": "Preview",
+		"description": "Falcons host the Saints",
+		"published": "2023-04-05T12:00:00Z",
+		"type": "Preview",
+		"categories": [{"type": "team", "teamId": 1, "description": "Atlanta Falcons"}, {"type": "league", "description": "NFL"}]
+	},
+	{
+		"headline": "Big trade",
+		"type": "HeadlineNews",
+		"images": [{"url": "https://a.espncdn.com/trade.jpg"}]
+	}
+]}`)
+
+	var n *news
+	require.NoError(t, json.Unmarshal(dat, &n))
+
+	stories := storiesFromNews(n, map[string]string{"1": "ATL"})
+	require.Len(t, stories, 2)
+
+	require.Equal(t, "Big trade", stories[0].Headline)
+	require.Equal(t, "https://a.espncdn.com/trade.jpg", stories[0].ImageURL)
+
+	require.Equal(t, "Preview", stories[1].Headline)
+	require.Equal(t, "Falcons host the Saints", stories[1].Description)
+	require.Equal(t, 2023, stories[1].Published.Year())
+	require.Equal(t, []string{"1", "Atlanta Falcons", "ATL"}, stories[1].Teams)
+}
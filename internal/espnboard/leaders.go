@@ -0,0 +1,136 @@
+package espnboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	statboard "github.com/robbydyer/sports/internal/board/stat"
+	"github.com/robbydyer/sports/internal/fetch"
+)
+
+type leaderData struct {
+	Leaders struct {
+		Categories []*leaderCategory `json:"categories"`
+	} `json:"leaders"`
+}
+
+type leaderCategory struct {
+	Name         string `json:"name"`
+	DisplayName  string `json:"displayName"`
+	Abbreviation string `json:"abbreviation"`
+	Leaders      []struct {
+		DisplayValue string  `json:"displayValue"`
+		Athlete      *Player `json:"athlete"`
+		Team         *struct {
+			Abbreviation string `json:"abbreviation"`
+		} `json:"team"`
+	} `json:"leaders"`
+}
+
+// GetLeaders returns the league-wide leaders for a given stat
+func (e *ESPNBoard) GetLeaders(ctx context.Context, stat string, limit int) ([]statboard.Player, error) {
+	// https://site.api.espn.com/apis/site/v3/sports/football/nfl/leaders
+	uri, err := url.Parse(fmt.Sprintf("https://site.api.espn.com/apis/site/v3/sports/%s/leaders", e.leaguer.APIPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	v := uri.Query()
+	v.Set("limit", strconv.Itoa(limit))
+	uri.RawQuery = v.Encode()
+
+	e.log.Debug("fetching league leaders",
+		zap.String("league", e.League()),
+		zap.String("stat", stat),
+		zap.String("uri", uri.String()),
+	)
+
+	req, err := http.NewRequest("GET", uri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	client := fetch.Client()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	leaders, abbrev, err := parseLeaders(body, stat, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if abbrev != "" {
+		e.rosterLock.Lock()
+		e.statLabels[stat] = abbrev
+		e.rosterLock.Unlock()
+	}
+
+	players := []statboard.Player{}
+	for _, p := range leaders {
+		p.espnBoard = e
+		p.category = categoryForPosition(e.sport(), p.Position())
+		players = append(players, p)
+	}
+
+	return players, nil
+}
+
+// parseLeaders finds the leader category matching the given stat name or abbreviation. The
+// returned Players only have the single leader stat set. The category's abbreviation is
+// also returned.
+func parseLeaders(dat []byte, stat string, limit int) ([]*Player, string, error) {
+	var d *leaderData
+	if err := json.Unmarshal(dat, &d); err != nil {
+		return nil, "", err
+	}
+
+	var cat *leaderCategory
+	for _, c := range d.Leaders.Categories {
+		if strings.EqualFold(c.Name, stat) || strings.EqualFold(c.Abbreviation, stat) {
+			cat = c
+			break
+		}
+	}
+
+	if cat == nil {
+		return nil, "", fmt.Errorf("no leader category for stat '%s'", stat)
+	}
+
+	players := []*Player{}
+	for _, l := range cat.Leaders {
+		if limit > 0 && len(players) >= limit {
+			break
+		}
+		if l.Athlete == nil {
+			continue
+		}
+		p := l.Athlete
+		p.stats = map[string]string{
+			stat: l.DisplayValue,
+		}
+		if l.Team != nil {
+			p.TeamAbbreviation = l.Team.Abbreviation
+		}
+		players = append(players, p)
+	}
+
+	return players, cat.Abbreviation, nil
+}
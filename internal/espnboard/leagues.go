@@ -10,8 +10,30 @@ import (
 	"go.uber.org/zap"
 )
 
-// GetLeaguer ...
+// GetLeaguer returns a built in Leaguer, or one added with RegisterLeague
 func GetLeaguer(league string) (Leaguer, error) {
+	league = strings.Trim(strings.ToLower(league), " ")
+	if l, err := builtinLeaguer(league); err == nil {
+		return l, nil
+	}
+	if l, ok := registeredLeaguer(league); ok {
+		return l, nil
+	}
+
+	return nil, fmt.Errorf("invalid league '%s'", league)
+}
+
+// NewFromLeague returns the API of a built in or registered league, using the default rank setters
+func NewFromLeague(ctx context.Context, league string, logger *zap.Logger) (*ESPNBoard, error) {
+	l, err := GetLeaguer(league)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(ctx, l, logger, defaultRankSetter, defaultRankSetter)
+}
+
+func builtinLeaguer(league string) (Leaguer, error) {
 	switch strings.Trim(strings.ToLower(league), " ") {
 	case "nfl":
 		return &nfl{}, nil
@@ -49,6 +71,14 @@ func GetLeaguer(league string) (Leaguer, error) {
 		return &laliga{}, nil
 	case "xfl":
 		return &xfl{}, nil
+	case "ipl":
+		return &ipl{}, nil
+	case "intlcricket":
+		return &intlCricket{}, nil
+	case "sixnations":
+		return &sixNations{}, nil
+	case "superrugby":
+		return &superRugby{}, nil
 	}
 
 	return nil, fmt.Errorf("invalid league '%s'", league)
@@ -701,3 +731,148 @@ func (n *xfl) SetScoreboardQuery(v url.Values) {
 func NewXFL(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
 	return New(ctx, &xfl{}, logger, defaultRankSetter, defaultRankSetter)
 }
+
+type ipl struct {
+	cricket
+}
+
+func (n *ipl) League() string {
+	return "IPL"
+}
+
+func (n *ipl) APIPath() string {
+	return "cricket/8048"
+}
+
+func (n *ipl) TeamEndpoints() []string {
+	return []string{filepath.Join(n.APIPath(), "teams")}
+}
+
+func (n *ipl) HTTPPathPrefix() string {
+	return "ipl"
+}
+
+func (n *ipl) HeadlinePath() string {
+	return fmt.Sprintf("%s/news", n.APIPath())
+}
+
+func (n *ipl) HomeSideSwap() bool {
+	return true
+}
+
+func (n *ipl) SetScoreboardQuery(v url.Values) {
+}
+
+// NewIPL ...
+func NewIPL(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
+	return New(ctx, &ipl{}, logger, defaultRankSetter, defaultRankSetter)
+}
+
+// intlCricket is men's international cricket
+type intlCricket struct {
+	cricket
+}
+
+func (n *intlCricket) League() string {
+	return "International Cricket"
+}
+
+func (n *intlCricket) APIPath() string {
+	return "cricket/8039"
+}
+
+func (n *intlCricket) TeamEndpoints() []string {
+	return []string{filepath.Join(n.APIPath(), "teams")}
+}
+
+func (n *intlCricket) HTTPPathPrefix() string {
+	return "intlcricket"
+}
+
+func (n *intlCricket) HeadlinePath() string {
+	return fmt.Sprintf("%s/news", n.APIPath())
+}
+
+func (n *intlCricket) HomeSideSwap() bool {
+	return true
+}
+
+func (n *intlCricket) SetScoreboardQuery(v url.Values) {
+}
+
+// NewInternationalCricket ...
+func NewInternationalCricket(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
+	return New(ctx, &intlCricket{}, logger, defaultRankSetter, defaultRankSetter)
+}
+
+type sixNations struct {
+	rugby
+}
+
+func (n *sixNations) League() string {
+	return "Six Nations"
+}
+
+func (n *sixNations) APIPath() string {
+	return "rugby/180659"
+}
+
+func (n *sixNations) TeamEndpoints() []string {
+	return []string{filepath.Join(n.APIPath(), "teams")}
+}
+
+func (n *sixNations) HTTPPathPrefix() string {
+	return "sixnations"
+}
+
+func (n *sixNations) HeadlinePath() string {
+	return fmt.Sprintf("%s/news", n.APIPath())
+}
+
+func (n *sixNations) HomeSideSwap() bool {
+	return true
+}
+
+func (n *sixNations) SetScoreboardQuery(v url.Values) {
+}
+
+// NewSixNations ...
+func NewSixNations(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
+	return New(ctx, &sixNations{}, logger, defaultRankSetter, defaultRankSetter)
+}
+
+type superRugby struct {
+	rugby
+}
+
+func (n *superRugby) League() string {
+	return "Super Rugby"
+}
+
+func (n *superRugby) APIPath() string {
+	return "rugby/242041"
+}
+
+func (n *superRugby) TeamEndpoints() []string {
+	return []string{filepath.Join(n.APIPath(), "teams")}
+}
+
+func (n *superRugby) HTTPPathPrefix() string {
+	return "superrugby"
+}
+
+func (n *superRugby) HeadlinePath() string {
+	return fmt.Sprintf("%s/news", n.APIPath())
+}
+
+func (n *superRugby) HomeSideSwap() bool {
+	return true
+}
+
+func (n *superRugby) SetScoreboardQuery(v url.Values) {
+}
+
+// NewSuperRugby ...
+func NewSuperRugby(ctx context.Context, logger *zap.Logger) (*ESPNBoard, error) {
+	return New(ctx, &superRugby{}, logger, defaultRankSetter, defaultRankSetter)
+}
@@ -7,6 +7,7 @@ import (
 	"image/png"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
@@ -62,25 +63,19 @@ func (e *ESPNBoard) GetLogo(ctx context.Context, logoKey string, logoConf *logo.
 	teamID := p[1]
 	dimKey := p[3]
 
-	e.logoLock.Lock()
-	_, ok := e.logoConfOnce[dimKey]
-	if !ok {
-		e.log.Debug("loading default logo configs",
-			zap.Int("x", bounds.Dx()),
-			zap.Int("y", bounds.Dy()),
-		)
-		if err := e.loadDefaultLogoConfigs(bounds); err != nil {
-			// Log the error, but don't return. We'll just use defaults
-			e.log.Warn("no defaults defined for logos", zap.String("league", e.leaguer.League()))
-		}
-		e.logoConfOnce[dimKey] = struct{}{}
-	}
-	e.logoLock.Unlock()
+	e.ensureLogoConfigs(dimKey, bounds)
 
 	var l *logo.Logo
-	defer e.setLogoCache(logoKey, l)
+	defer func() {
+		if l != nil {
+			e.setLogoCache(logoKey, l)
+		}
+	}()
 
 	logoGetter := func(ctx context.Context) (image.Image, error) {
+		if img, err := e.userLogo(ctx, teamID, bounds); err != nil || img != nil {
+			return img, err
+		}
 		return e.GetLogoSource(ctx, teamID, logoSearch(e.leaguer, teamID))
 	}
 
@@ -90,18 +85,17 @@ func (e *ESPNBoard) GetLogo(ctx context.Context, logoKey string, logoConf *logo.
 		return l, nil
 	}
 
-	for _, d := range *e.defaultLogoConf {
-		if d.Abbrev == logoKey {
-			l = logo.New(logoKey, logoGetter, cacheDir, bounds, d)
+	if d := e.findLogoConfig(logoKey); d != nil {
+		l = logo.New(logoKey, logoGetter, cacheDir, bounds, d)
 
-			return l, nil
-		}
+		return l, nil
 	}
 
 	c := &logo.Config{
 		Abbrev: logoKey,
 		XSize:  bounds.Dx(),
 		YSize:  bounds.Dy(),
+		Auto:   logo.ScoreboardKey(logoKey),
 		Pt: &logo.Pt{
 			X:    0,
 			Y:    0,
@@ -109,16 +103,65 @@ func (e *ESPNBoard) GetLogo(ctx context.Context, logoKey string, logoConf *logo.
 		},
 	}
 
-	*e.defaultLogoConf = append(*e.defaultLogoConf, c)
-
 	l = logo.New(logoKey, logoGetter, cacheDir, bounds, c)
 
 	return l, nil
 }
 
+// ensureLogoConfigs loads the saved and default logo positions for a matrix size the first time
+// it's used
+func (e *ESPNBoard) ensureLogoConfigs(dimKey string, bounds image.Rectangle) {
+	e.logoLock.Lock()
+	defer e.logoLock.Unlock()
+
+	if _, ok := e.logoConfOnce[dimKey]; ok {
+		return
+	}
+
+	e.log.Debug("loading default logo configs",
+		zap.Int("x", bounds.Dx()),
+		zap.Int("y", bounds.Dy()),
+	)
+	if err := e.loadDefaultLogoConfigs(bounds); err != nil {
+		// Log the error, but don't return. We'll just use defaults
+		e.log.Warn("no defaults defined for logos", zap.String("league", e.leaguer.League()))
+	}
+	e.logoConfOnce[dimKey] = struct{}{}
+}
+
+func (e *ESPNBoard) findLogoConfig(logoKey string) *logo.Config {
+	e.logoLock.RLock()
+	defer e.logoLock.RUnlock()
+
+	for _, d := range *e.defaultLogoConf {
+		if d.Abbrev == logoKey {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// loadDefaultLogoConfigs loads the logo positions saved in the position directory, followed by
+// the embedded defaults. The first position found for a logo is used.
 func (e *ESPNBoard) loadDefaultLogoConfigs(bounds image.Rectangle) error {
+	saved, err := readLogoPositions(bounds)
+	if err != nil {
+		e.log.Error("failed to read saved logo positions",
+			zap.String("file", logoPositionFile(bounds)),
+			zap.Error(err),
+		)
+	}
+	*e.defaultLogoConf = append(*e.defaultLogoConf, saved...)
+	for _, c := range saved {
+		e.savedLogoKeys[c.Abbrev] = struct{}{}
+	}
+
 	dat, err := assets.ReadFile(fmt.Sprintf("assets/logopos_%dx%d.yaml", bounds.Dx(), bounds.Dy()))
 	if err != nil {
+		if len(saved) > 0 {
+			return nil
+		}
 		return err
 	}
 
@@ -131,6 +174,79 @@ func (e *ESPNBoard) loadDefaultLogoConfigs(bounds image.Rectangle) error {
 	return nil
 }
 
+// userLogo returns the user supplied logo for a team, or nil if there isn't one
+func (e *ESPNBoard) userLogo(ctx context.Context, teamID string, bounds image.Rectangle) (image.Image, error) {
+	img, err := logo.LoadOverride(e.leaguer.HTTPPathPrefix(), bounds, e.logoKeys(ctx, teamID)...)
+	if err != nil {
+		return nil, err
+	}
+	if img != nil {
+		e.log.Debug("using user supplied logo",
+			zap.String("league", e.leaguer.League()),
+			zap.String("team", teamID),
+		)
+	}
+	return img, nil
+}
+
+// logoKeys are the names a team's user supplied logo may have: its ID, then its abbreviation
+func (e *ESPNBoard) logoKeys(ctx context.Context, teamID string) []string {
+	keys := []string{teamID}
+	if t, err := e.TeamFromID(ctx, teamID); err == nil {
+		keys = append(keys, t.GetAbbreviation())
+	}
+	return keys
+}
+
+// LogoSources of a team's logo
+const (
+	LogoSourceESPN     = "espn"
+	LogoSourceFallback = "fallback"
+)
+
+// LogoSource is where a team's logo comes from. Teams with a fallback source have their
+// abbreviation drawn in place of a logo.
+type LogoSource struct {
+	Team   *Team
+	Source string
+	File   string
+}
+
+// LogoSources returns where each of the league's teams gets its logo from at the given matrix size
+func (e *ESPNBoard) LogoSources(ctx context.Context, bounds image.Rectangle) ([]*LogoSource, error) {
+	teams, err := e.getTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	var sources []*LogoSource
+	for _, t := range teams {
+		if _, ok := seen[t.ID]; ok {
+			continue
+		}
+		seen[t.ID] = struct{}{}
+
+		s := &LogoSource{
+			Team:   t,
+			Source: LogoSourceFallback,
+		}
+		if file, kind := logo.FindOverride(e.leaguer.HTTPPathPrefix(), bounds, t.ID, t.Abbreviation); kind != logo.OverrideNone {
+			s.Source = fmt.Sprintf("user %s", kind)
+			s.File = file
+		} else if len(t.Logos) > 0 {
+			s.Source = LogoSourceESPN
+		}
+		sources = append(sources, s)
+	}
+
+	sort.SliceStable(sources, func(i, j int) bool {
+		return strings.ToLower(sources[i].Team.Abbreviation) < strings.ToLower(sources[j].Team.Abbreviation)
+	})
+
+	return sources, nil
+}
+
 func logoSearch(leaguer Leaguer, teamID string) string {
 	switch leaguer.(type) {
 	case *ncaaf:
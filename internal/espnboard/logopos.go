@@ -0,0 +1,164 @@
+package espnboard
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"sync"
+
+	yaml "github.com/ghodss/yaml"
+
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+// DefaultLogoPositionDir is where logo positions saved from the web UI are kept
+const DefaultLogoPositionDir = "/etc/sportsmatrix_logopos"
+
+var (
+	logoPositionDir  = DefaultLogoPositionDir
+	logoPositionLock sync.RWMutex
+)
+
+// SetLogoPositionDir sets the directory of the per matrix size logo position files, which are
+// loaded ahead of the embedded defaults
+func SetLogoPositionDir(dir string) {
+	logoPositionLock.Lock()
+	defer logoPositionLock.Unlock()
+	logoPositionDir = dir
+}
+
+func logoPositionFile(bounds image.Rectangle) string {
+	return filepath.Join(logoPositionDir, fmt.Sprintf("logopos_%dx%d.yaml", bounds.Dx(), bounds.Dy()))
+}
+
+// readLogoPositions reads the saved logo positions for a matrix size. A missing file isn't an error.
+func readLogoPositions(bounds image.Rectangle) ([]*logo.Config, error) {
+	logoPositionLock.RLock()
+	defer logoPositionLock.RUnlock()
+
+	return readLogoPositionFile(logoPositionFile(bounds))
+}
+
+func readLogoPositionFile(file string) ([]*logo.Config, error) {
+	dat, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var confs []*logo.Config
+	if err := yaml.Unmarshal(dat, &confs); err != nil {
+		return nil, fmt.Errorf("failed to parse logo positions in %s: %w", file, err)
+	}
+
+	return confs, nil
+}
+
+// saveLogoPosition adds or replaces a logo position in the position file for a matrix size. All
+// leagues share the file, as each logo's key is prefixed with its league.
+func saveLogoPosition(conf *logo.Config, bounds image.Rectangle) error {
+	logoPositionLock.Lock()
+	defer logoPositionLock.Unlock()
+
+	return saveLogoPositionFile(logoPositionFile(bounds), conf)
+}
+
+func saveLogoPositionFile(file string, conf *logo.Config) error {
+	confs, err := readLogoPositionFile(file)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i, c := range confs {
+		if c.Abbrev == conf.Abbrev {
+			confs[i] = conf
+			found = true
+			break
+		}
+	}
+	if !found {
+		confs = append(confs, conf)
+	}
+
+	dat, err := yaml.Marshal(confs)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return fmt.Errorf("failed to create logo position directory: %w", err)
+	}
+
+	return os.WriteFile(file, dat, 0o644)
+}
+
+// LogoConfig returns the saved or default position of a logo, or nil if it has neither. saved is
+// true when the position was saved from the logo editor.
+func (e *ESPNBoard) LogoConfig(logoKey string, bounds image.Rectangle) (conf *logo.Config, saved bool) {
+	e.ensureLogoConfigs(fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy()), bounds)
+
+	logoKey = fmt.Sprintf("%s_%s", e.leaguer.HTTPPathPrefix(), logoKey)
+	conf = e.findLogoConfig(logoKey)
+
+	e.logoLock.RLock()
+	defer e.logoLock.RUnlock()
+	_, saved = e.savedLogoKeys[logoKey]
+
+	return conf, saved && conf != nil
+}
+
+// SaveLogoConfig saves a logo's position to the position file for the matrix size. The logo is
+// rendered with the new position from then on.
+func (e *ESPNBoard) SaveLogoConfig(logoKey string, conf *logo.Config, bounds image.Rectangle) error {
+	if conf == nil || conf.Pt == nil {
+		return fmt.Errorf("logo position is required")
+	}
+
+	logoKey = fmt.Sprintf("%s_%s", e.leaguer.HTTPPathPrefix(), logoKey)
+	e.ensureLogoConfigs(fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy()), bounds)
+
+	c := &logo.Config{
+		Abbrev:   logoKey,
+		XSize:    bounds.Dx(),
+		YSize:    bounds.Dy(),
+		FitImage: conf.FitImage,
+		Trim:     conf.Trim,
+		Pt: &logo.Pt{
+			X:    conf.Pt.X,
+			Y:    conf.Pt.Y,
+			Zoom: conf.Pt.Zoom,
+		},
+	}
+
+	if err := saveLogoPosition(c, bounds); err != nil {
+		return fmt.Errorf("failed to save logo position: %w", err)
+	}
+
+	e.logoLock.Lock()
+	found := false
+	for i, d := range *e.defaultLogoConf {
+		if d.Abbrev == logoKey {
+			(*e.defaultLogoConf)[i] = c
+			found = true
+			break
+		}
+	}
+	if !found {
+		*e.defaultLogoConf = append([]*logo.Config{c}, *e.defaultLogoConf...)
+	}
+	e.savedLogoKeys[logoKey] = struct{}{}
+	delete(e.logos, logoKey)
+	e.logoLock.Unlock()
+
+	cacheDir, err := e.logoCacheDir()
+	if err != nil {
+		return err
+	}
+
+	return logo.New(logoKey, nil, cacheDir, bounds, c).ClearThumbnail()
+}
@@ -0,0 +1,45 @@
+package espnboard
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+func TestSaveLogoPositionFile(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "logopos", "logopos_64x32.yaml")
+
+	confs, err := readLogoPositionFile(file)
+	require.NoError(t, err)
+	require.Empty(t, confs)
+
+	require.NoError(t, saveLogoPositionFile(file, &logo.Config{
+		Abbrev: "ncaaf_333_HOME_64x32",
+		Pt:     &logo.Pt{X: 1, Y: -2, Zoom: 0.9},
+	}))
+	require.NoError(t, saveLogoPositionFile(file, &logo.Config{
+		Abbrev: "nfl_12_AWAY_64x32",
+		Pt:     &logo.Pt{Zoom: 1},
+	}))
+	require.NoError(t, saveLogoPositionFile(file, &logo.Config{
+		Abbrev: "ncaaf_333_HOME_64x32",
+		Pt:     &logo.Pt{X: 3, Y: -2, Zoom: 1.1},
+	}))
+
+	confs, err = readLogoPositionFile(file)
+	require.NoError(t, err)
+	require.Len(t, confs, 2)
+	require.Equal(t, "ncaaf_333_HOME_64x32", confs[0].Abbrev)
+	require.Equal(t, &logo.Pt{X: 3, Y: -2, Zoom: 1.1}, confs[0].Pt)
+	require.Equal(t, "nfl_12_AWAY_64x32", confs[1].Abbrev)
+
+	dat, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Contains(t, string(dat), "xShift: 3")
+}
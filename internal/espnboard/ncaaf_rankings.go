@@ -3,25 +3,36 @@ package espnboard
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+
+	"github.com/robbydyer/sports/internal/fetch"
 )
 
 var preferedPolls = []string{"cfp", "ap", "usa"}
 
 type ncaafRankingsData struct {
-	Rankings []*struct {
-		Type   string `json:"type"`
-		Season *struct {
-			Year int `json:"year"`
-		} `json:"season"`
-		Ranks []*ncaafRanks `json:"ranks"`
-	}
+	Rankings []*ncaafRanking `json:"rankings"`
+}
+
+type ncaafRanking struct {
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	ShortName string `json:"shortName"`
+	Season    *struct {
+		Year int `json:"year"`
+	} `json:"season"`
+	Occurrence *struct {
+		DisplayValue string `json:"displayValue"`
+	} `json:"occurrence"`
+	Ranks []*ncaafRanks `json:"ranks"`
 }
 
 type ncaafRanks struct {
@@ -64,47 +75,11 @@ func (n *ncaaf) setRankings(ctx context.Context, e *ESPNBoard, season string, te
 	e.Lock()
 	defer e.Unlock()
 
-	uri, err := url.Parse("https://site.api.espn.com/apis/site/v2/sports/football/college-football/rankings")
-	if err != nil {
-		return err
-	}
-
-	if season != "" {
-		v := uri.Query()
-		v.Set("season", season)
-		uri.RawQuery = v.Encode()
-	}
-
-	e.log.Info("getting NCAAF rankings",
-		zap.String("season", season),
-		zap.String("url", uri.String()),
-	)
-
-	req, err := http.NewRequest("GET", uri.String(), nil)
-	if err != nil {
-		return err
-	}
-	client := http.DefaultClient
-
-	req = req.WithContext(ctx)
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	data, err := e.getRankings(ctx, season)
 	if err != nil {
 		return err
 	}
 
-	var data *ncaafRankingsData
-
-	if err := json.Unmarshal(body, &data); err != nil {
-		return err
-	}
-
 	ranks := n.getRanks(data)
 
 RANK:
@@ -136,28 +111,17 @@ RANK:
 }
 
 func (n *ncaaf) getRanks(data *ncaafRankingsData) []*ncaafRanks {
-	prefIndex := 0
-	thisYear := n.latestRankingsYear(data)
-
-	for {
-		if prefIndex > len(preferedPolls) {
-			return nil
-		}
-	INNER:
-		for _, ranking := range data.Rankings {
-			if ranking.Type != preferedPolls[prefIndex] || ranking.Season.Year != thisYear {
-				continue INNER
-			}
-			return ranking.Ranks
-		}
-		prefIndex++
+	ranking := findRanking(data, preferedPolls)
+	if ranking == nil {
+		return nil
 	}
+	return ranking.Ranks
 }
 
-func (n *ncaaf) latestRankingsYear(data *ncaafRankingsData) int {
+func latestRankingsYear(data *ncaafRankingsData) int {
 	year := 0
 	for _, r := range data.Rankings {
-		if r.Season.Year > year {
+		if r.Season != nil && r.Season.Year > year {
 			year = r.Season.Year
 		}
 	}
@@ -166,3 +130,71 @@ func (n *ncaaf) latestRankingsYear(data *ncaafRankingsData) int {
 	}
 	return year
 }
+
+// findRanking returns the latest season's ranking of the first poll type found
+func findRanking(data *ncaafRankingsData, pollTypes []string) *ncaafRanking {
+	thisYear := latestRankingsYear(data)
+
+	for _, pollType := range pollTypes {
+		for _, ranking := range data.Rankings {
+			if ranking.Season == nil || ranking.Season.Year != thisYear {
+				continue
+			}
+			if strings.EqualFold(ranking.Type, pollType) {
+				return ranking
+			}
+		}
+	}
+
+	return nil
+}
+
+// getRankings gets all of the league's current polls
+func (e *ESPNBoard) getRankings(ctx context.Context, season string) (*ncaafRankingsData, error) {
+	uri, err := url.Parse(fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/%s/rankings", e.leaguer.APIPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	if season != "" {
+		v := uri.Query()
+		v.Set("season", season)
+		uri.RawQuery = v.Encode()
+	}
+
+	e.log.Info("getting rankings",
+		zap.String("league", e.leaguer.League()),
+		zap.String("season", season),
+		zap.String("url", uri.String()),
+	)
+
+	req, err := http.NewRequest("GET", uri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	client := fetch.Client()
+
+	req = req.WithContext(ctx)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var data *ncaafRankingsData
+
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("no rankings for %s", e.leaguer.League())
+	}
+
+	return data, nil
+}
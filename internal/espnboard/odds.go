@@ -0,0 +1,269 @@
+package espnboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	linesboard "github.com/robbydyer/sports/internal/board/lines"
+)
+
+// teamOdds is a team's side of the odds
+type teamOdds struct {
+	Favorite  bool     `json:"favorite"`
+	MoneyLine flexLine `json:"moneyLine"`
+}
+
+// oddsMarket has the open and current line of each team for a market, such as the point spread
+type oddsMarket struct {
+	Home *oddsLine `json:"home"`
+	Away *oddsLine `json:"away"`
+}
+
+type totalMarket struct {
+	Over *oddsLine `json:"over"`
+}
+
+type oddsLine struct {
+	Open  *oddsValue `json:"open"`
+	Close *oddsValue `json:"close"`
+}
+
+type oddsValue struct {
+	Line flexLine `json:"line"`
+	Odds flexLine `json:"odds"`
+}
+
+// flexLine is a betting line that ESPN sends either as a number or a string, such as "-3.5",
+// "o47.5", "+150" or "EVEN"
+type flexLine struct {
+	value *float64
+}
+
+// UnmarshalJSON ...
+func (f *flexLine) UnmarshalJSON(dat []byte) error {
+	str := strings.Trim(string(dat), `"`)
+	if v, ok := parseLine(str); ok {
+		f.value = &v
+	}
+	return nil
+}
+
+func parseLine(str string) (float64, bool) {
+	str = strings.TrimSpace(strings.ToLower(str))
+	str = strings.TrimLeft(str, "ou")
+	switch str {
+	case "", "null":
+		return 0, false
+	case "even", "ev":
+		return 100, true
+	case "pk", "pick":
+		return 0, true
+	}
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+type winProbabilitySummary struct {
+	WinProbability []*struct {
+		HomeWinPercentage float64 `json:"homeWinPercentage"`
+	} `json:"winprobability"`
+	Predictor *struct {
+		HomeTeam *struct {
+			GameProjection flexLine `json:"gameProjection"`
+		} `json:"homeTeam"`
+	} `json:"predictor"`
+}
+
+// NewLinesAPI returns the API of a built in or registered league for a lines board. The league's
+// existing API is reused when there is one, so its scoreboard isn't polled twice.
+func NewLinesAPI(ctx context.Context, league string, existing *ESPNBoard, logger *zap.Logger) (*ESPNBoard, error) {
+	if _, err := GetLeaguer(league); err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return existing, nil
+	}
+
+	return NewFromLeague(ctx, league, logger)
+}
+
+// GetLines gets the betting lines of the day's games. Live games include the win probability.
+func (e *ESPNBoard) GetLines(ctx context.Context, day time.Time) ([]*linesboard.Line, error) {
+	games, err := e.GetGames(ctx, e.DateStr(day))
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []*linesboard.Line
+	for _, g := range games {
+		line, err := g.GetLines()
+		if err != nil {
+			continue
+		}
+
+		if line.Live {
+			p, err := g.GetWinProbability(ctx)
+			if err != nil {
+				e.log.Debug("no win probability",
+					zap.String("league", e.leaguer.League()),
+					zap.String("game", g.ID),
+					zap.Error(err),
+				)
+			} else {
+				line.HomeWinProbability = p
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// GetLines gets the game's moneyline, spread and total from its primary odds provider
+func (g *Game) GetLines() (*linesboard.Line, error) {
+	odds := g.primaryOdds()
+	if odds == nil || g.Home == nil || g.Away == nil {
+		return nil, fmt.Errorf("no odds for game %s", g.ID)
+	}
+
+	line := linesFromOdds(odds, g.Home, g.Away)
+	line.Start = g.GameTime
+
+	if complete, err := g.IsComplete(); err == nil && complete {
+		line.Complete = true
+	} else if live, err := g.IsLive(); err == nil && live {
+		line.Live = true
+		line.Period = g.status.Type.ShortDetail
+		if line.Period == "" {
+			line.Period, _ = g.GetQuarter()
+			line.Clock, _ = g.GetClock()
+		}
+	}
+
+	return line, nil
+}
+
+func linesFromOdds(odds *Odds, home *Team, away *Team) *linesboard.Line {
+	line := &linesboard.Line{
+		Home:               teamLine(home),
+		Away:               teamLine(away),
+		Total:              odds.OverUnder,
+		HomeWinProbability: -1,
+	}
+	if odds.Provider != nil {
+		line.Provider = odds.Provider.Name
+	}
+
+	// ESPN's spread is the home team's
+	line.Home.Spread = odds.Spread
+	line.Away.Spread = -odds.Spread
+
+	if odds.HomeTeamOdds != nil {
+		line.Home.Favorite = odds.HomeTeamOdds.Favorite
+		if v := odds.HomeTeamOdds.MoneyLine.value; v != nil {
+			line.Home.Moneyline = *v
+		}
+	}
+	if odds.AwayTeamOdds != nil {
+		line.Away.Favorite = odds.AwayTeamOdds.Favorite
+		if v := odds.AwayTeamOdds.MoneyLine.value; v != nil {
+			line.Away.Moneyline = *v
+		}
+	}
+
+	if odds.Moneyline != nil {
+		setLine(odds.Moneyline.Home, &line.Home.Moneyline, &line.Home.OpenMoneyline, true)
+		setLine(odds.Moneyline.Away, &line.Away.Moneyline, &line.Away.OpenMoneyline, true)
+	}
+	if odds.PointSpread != nil {
+		setLine(odds.PointSpread.Home, &line.Home.Spread, &line.Home.OpenSpread, false)
+		setLine(odds.PointSpread.Away, &line.Away.Spread, &line.Away.OpenSpread, false)
+	}
+	if odds.Total != nil {
+		setLine(odds.Total.Over, &line.Total, &line.OpenTotal, false)
+	}
+
+	return line
+}
+
+// setLine sets the current and opening line from a market. Moneylines are the market's odds,
+// spreads and totals are its line.
+func setLine(l *oddsLine, current *float64, open **float64, moneyline bool) {
+	if l == nil {
+		return
+	}
+	value := func(v *oddsValue) *float64 {
+		if v == nil {
+			return nil
+		}
+		if moneyline {
+			return v.Odds.value
+		}
+		return v.Line.value
+	}
+	if v := value(l.Close); v != nil {
+		*current = *v
+	}
+	if v := value(l.Open); v != nil {
+		o := *v
+		*open = &o
+	}
+}
+
+func teamLine(t *Team) *linesboard.TeamLine {
+	return &linesboard.TeamLine{
+		ID:           t.ID,
+		Abbreviation: t.Abbreviation,
+		Color:        t.GetColor(),
+	}
+}
+
+// GetWinProbability implements sportboard.WinProbabilityGame. It uses the scoreboard's live
+// probability when there is one, otherwise the game summary's.
+func (g *Game) GetWinProbability(ctx context.Context) (float64, error) {
+	if g.Situation != nil && g.Situation.LastPlay != nil && g.Situation.LastPlay.Probability != nil {
+		if p := g.Situation.LastPlay.Probability.HomeWinPercentage; p != nil {
+			return *p, nil
+		}
+	}
+
+	dat, err := g.getSummary(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseWinProbability(dat)
+}
+
+// parseWinProbability returns the latest live win probability from a game summary, falling back
+// to the pregame predictor
+func parseWinProbability(dat []byte) (float64, error) {
+	var s *winProbabilitySummary
+	if err := json.Unmarshal(dat, &s); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal game summary: %w", err)
+	}
+	if s == nil {
+		return 0, fmt.Errorf("no win probability available")
+	}
+
+	if len(s.WinProbability) > 0 {
+		return s.WinProbability[len(s.WinProbability)-1].HomeWinPercentage, nil
+	}
+
+	if s.Predictor != nil && s.Predictor.HomeTeam != nil && s.Predictor.HomeTeam.GameProjection.value != nil {
+		return *s.Predictor.HomeTeam.GameProjection.value / 100, nil
+	}
+
+	return 0, fmt.Errorf("no win probability available")
+}
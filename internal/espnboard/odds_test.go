@@ -0,0 +1,136 @@
+package espnboard
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParseLine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		expected float64
+		ok       bool
+	}{
+		{in: "-3.5", expected: -3.5, ok: true},
+		{in: "+150", expected: 150, ok: true},
+		{in: "o47.5", expected: 47.5, ok: true},
+		{in: "u47.5", expected: 47.5, ok: true},
+		{in: "EVEN", expected: 100, ok: true},
+		{in: "PK", expected: 0, ok: true},
+		{in: "", ok: false},
+		{in: "N/A", ok: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.in, func(t *testing.T) {
+			t.Parallel()
+			v, ok := parseLine(test.in)
+			require.Equal(t, test.ok, ok)
+			require.Equal(t, test.expected, v)
+		})
+	}
+}
+
+func TestLinesFromOdds(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`{
+	"provider": {"id": "58", "name": "ESPN BET", "priority": 1},
+	"details": "KC -3.5",
+	"overUnder": 47.5,
+	"spread": -3.5,
+	"homeTeamOdds": {"favorite": true, "moneyLine": -180},
+	"awayTeamOdds": {"favorite": false, "moneyLine": 150},
+	"moneyline": {
+		"home": {"open": {"odds": "-165"}, "close": {"odds": "-185"}},
+		"away": {"open": {"odds": "+140"}, "close": {"odds": "+155"}}
+	},
+	"pointSpread": {
+		"home": {"open": {"line": "-3", "odds": "-110"}, "close": {"line": "-3.5", "odds": "-110"}},
+		"away": {"open": {"line": "+3", "odds": "-110"}, "close": {"line": "+3.5", "odds": "-110"}}
+	},
+	"total": {
+		"over": {"open": {"line": "o48.5", "odds": "-110"}, "close": {"line": "o47.5", "odds": "-110"}}
+	}
+}`)
+
+	var odds *Odds
+	require.NoError(t, json.Unmarshal(dat, &odds))
+
+	line := linesFromOdds(odds, &Team{Abbreviation: "KC", Color: "e31837"}, &Team{Abbreviation: "BUF"})
+	require.Equal(t, "ESPN BET", line.Provider)
+	require.True(t, line.Home.Favorite)
+	require.Equal(t, -185.0, line.Home.Moneyline)
+	require.Equal(t, 155.0, line.Away.Moneyline)
+	require.Equal(t, -20.0, line.Home.MoneylineMovement())
+	require.Equal(t, -3.5, line.Home.Spread)
+	require.Equal(t, 3.5, line.Away.Spread)
+	require.Equal(t, 0.5, line.Away.SpreadMovement())
+	require.Equal(t, 47.5, line.Total)
+	require.Equal(t, -1.0, line.TotalMovement())
+	require.NotNil(t, line.Home.Color)
+	require.Nil(t, line.Away.Color)
+
+	// Older odds only have the current lines
+	var old *Odds
+	require.NoError(t, json.Unmarshal([]byte(`{"details": "BUF -1", "overUnder": 44, "spread": 1, "homeTeamOdds": {"moneyLine": 105}}`), &old))
+	line = linesFromOdds(old, &Team{Abbreviation: "KC"}, &Team{Abbreviation: "BUF"})
+	require.Equal(t, 1.0, line.Home.Spread)
+	require.Equal(t, -1.0, line.Away.Spread)
+	require.Equal(t, 105.0, line.Home.Moneyline)
+	require.Equal(t, 0.0, line.Home.SpreadMovement())
+	require.Equal(t, 0.0, line.TotalMovement())
+}
+
+func TestParseWinProbability(t *testing.T) {
+	t.Parallel()
+
+	p, err := parseWinProbability([]byte(`{"winprobability": [{"homeWinPercentage": 0.5}, {"homeWinPercentage": 0.62}]}`))
+	require.NoError(t, err)
+	require.Equal(t, 0.62, p)
+
+	p, err = parseWinProbability([]byte(`{"predictor": {"homeTeam": {"gameProjection": "41.0"}}}`))
+	require.NoError(t, err)
+	require.InDelta(t, 0.41, p, 0.0001)
+
+	_, err = parseWinProbability([]byte(`{}`))
+	require.Error(t, err)
+}
+
+func TestCachedSummary(t *testing.T) {
+	t.Parallel()
+
+	e := &ESPNBoard{
+		summaries: map[string]*gameSummary{
+			"1": {
+				body:    []byte(`{"winprobability": [{"homeWinPercentage": 0.7}]}`),
+				fetched: time.Now(),
+			},
+		},
+	}
+
+	// A cached summary is used without fetching it again
+	p, err := (&Game{ID: "1", espnBoard: e}).GetWinProbability(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0.7, p)
+}
+
+func TestNewLinesAPI(t *testing.T) {
+	t.Parallel()
+
+	existing := &ESPNBoard{}
+	api, err := NewLinesAPI(context.Background(), "NFL", existing, zap.NewNop())
+	require.NoError(t, err)
+	require.Same(t, existing, api)
+
+	_, err = NewLinesAPI(context.Background(), "nope", existing, zap.NewNop())
+	require.Error(t, err)
+}
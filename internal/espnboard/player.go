@@ -0,0 +1,620 @@
+package espnboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	statboard "github.com/robbydyer/sports/internal/board/stat"
+	"github.com/robbydyer/sports/internal/fetch"
+)
+
+const (
+	passing    = "passing"
+	rushing    = "rushing"
+	receiving  = "receiving"
+	defense    = "defense"
+	kicking    = "kicking"
+	player     = "player"
+	goalkeeper = "goalkeeper"
+	outfield   = "outfield"
+	skater     = "skater"
+	goalie     = "goalie"
+	hitter     = "hitter"
+	pitcher    = "pitcher"
+)
+
+// statCategory is a group of positions that share the same default stat set
+type statCategory struct {
+	name      string
+	positions []string
+	stats     []string
+}
+
+// sportStatCategories are the player categories for each sport, keyed by the
+// sport portion of a Leaguer's APIPath. The last category in each list is the
+// default for positions that don't match any other category.
+var sportStatCategories = map[string][]*statCategory{
+	"football": {
+		{
+			name:      passing,
+			positions: []string{"QB"},
+			stats:     []string{"passingYards", "passingTouchdowns", "interceptions", "completionPct", "QBRating"},
+		},
+		{
+			name:      rushing,
+			positions: []string{"RB", "FB"},
+			stats:     []string{"rushingAttempts", "rushingYards", "yardsPerRushAttempt", "rushingTouchdowns"},
+		},
+		{
+			name:      receiving,
+			positions: []string{"WR", "TE"},
+			stats:     []string{"receptions", "receivingYards", "yardsPerReception", "receivingTouchdowns"},
+		},
+		{
+			name:      kicking,
+			positions: []string{"K", "PK", "P"},
+			stats:     []string{"fieldGoalsMade", "fieldGoalPct", "longFieldGoalMade", "extraPointsMade"},
+		},
+		{
+			name:  defense,
+			stats: []string{"totalTackles", "sacks", "interceptions", "passesDefended"},
+		},
+	},
+	"basketball": {
+		{
+			name:  player,
+			stats: []string{"gamesPlayed", "avgPoints", "avgRebounds", "avgAssists", "fieldGoalPct"},
+		},
+	},
+	"soccer": {
+		{
+			name:      goalkeeper,
+			positions: []string{"G", "GK"},
+			stats:     []string{"appearances", "saves", "goalsConceded", "shotsFaced"},
+		},
+		{
+			name:  outfield,
+			stats: []string{"appearances", "totalGoals", "goalAssists", "shotsOnTarget"},
+		},
+	},
+	"hockey": {
+		{
+			name:      goalie,
+			positions: []string{"G"},
+			stats:     []string{"wins", "losses", "savePct", "avgGoalsAgainst"},
+		},
+		{
+			name:  skater,
+			stats: []string{"goals", "assists", "points", "plusMinus"},
+		},
+	},
+	"baseball": {
+		{
+			name:      pitcher,
+			positions: []string{"P", "SP", "RP"},
+			stats:     []string{"wins", "losses", "ERA", "saves"},
+		},
+		{
+			name:  hitter,
+			stats: []string{"avg", "homeRuns", "RBIs", "OPS"},
+		},
+	},
+}
+
+var statShortNames = map[string]string{
+	"passingyards":        "YDS",
+	"passingtouchdowns":   "TD",
+	"interceptions":       "INT",
+	"completionpct":       "CMP%",
+	"qbrating":            "RTG",
+	"rushingattempts":     "CAR",
+	"rushingyards":        "YDS",
+	"yardsperrushattempt": "AVG",
+	"rushingtouchdowns":   "TD",
+	"receptions":          "REC",
+	"receivingyards":      "YDS",
+	"yardsperreception":   "AVG",
+	"receivingtouchdowns": "TD",
+	"fieldgoalsmade":      "FG",
+	"fieldgoalpct":        "FG%",
+	"longfieldgoalmade":   "LNG",
+	"extrapointsmade":     "XP",
+	"totaltackles":        "TCK",
+	"sacks":               "SCK",
+	"passesdefended":      "PD",
+	"gamesplayed":         "GP",
+	"avgpoints":           "PTS",
+	"avgrebounds":         "REB",
+	"avgassists":          "AST",
+	"appearances":         "APP",
+	"saves":               "SV",
+	"goalsconceded":       "GA",
+	"shotsfaced":          "SHF",
+	"totalgoals":          "G",
+	"goalassists":         "A",
+	"shotsontarget":       "SOT",
+	"wins":                "W",
+	"losses":              "L",
+	"savepct":             "SV%",
+	"avggoalsagainst":     "GAA",
+	"goals":               "G",
+	"assists":             "A",
+	"points":              "P",
+	"plusminus":           "+/-",
+	"era":                 "ERA",
+	"avg":                 "AVG",
+	"homeruns":            "HR",
+	"rbis":                "RBI",
+	"ops":                 "OPS",
+}
+
+// Player implements statboard.Player
+type Player struct {
+	ID             string `json:"id"`
+	First          string `json:"firstName"`
+	Last           string `json:"lastName"`
+	FullName       string `json:"fullName"`
+	Jersey         string `json:"jersey"`
+	PlayerPosition *struct {
+		Abbreviation string `json:"abbreviation"`
+		Name         string `json:"name"`
+	} `json:"position"`
+	TeamAbbreviation string
+	espnBoard        *ESPNBoard
+	category         string
+	stats            map[string]string
+	statLock         sync.RWMutex
+}
+
+type rosterData struct {
+	Athletes []json.RawMessage `json:"athletes"`
+}
+
+// rosterGroup is used by leagues that group their roster by position, such as the NFL
+type rosterGroup struct {
+	Position string    `json:"position"`
+	Items    []*Player `json:"items"`
+}
+
+type athleteStatData struct {
+	Categories []struct {
+		Name       string   `json:"name"`
+		Labels     []string `json:"labels"`
+		Names      []string `json:"names"`
+		Statistics []struct {
+			Stats []string `json:"stats"`
+		} `json:"statistics"`
+	} `json:"categories"`
+}
+
+// LeagueShortName ...
+func (e *ESPNBoard) LeagueShortName() string {
+	return strings.ToUpper(e.leaguer.HTTPPathPrefix())
+}
+
+func (e *ESPNBoard) sport() string {
+	parts := strings.Split(e.leaguer.APIPath(), "/")
+	return parts[0]
+}
+
+// PlayerCategories returns the possible categories a player falls into
+func (e *ESPNBoard) PlayerCategories() []string {
+	cats := []string{}
+	for _, c := range sportStatCategories[e.sport()] {
+		cats = append(cats, c.name)
+	}
+
+	return cats
+}
+
+// AvailableStats ...
+func (e *ESPNBoard) AvailableStats(ctx context.Context, category string) ([]string, error) {
+	for _, c := range sportStatCategories[e.sport()] {
+		if c.name == category {
+			return c.stats, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid player category '%s' for %s", category, e.League())
+}
+
+// StatShortName returns a short name representation of the stat, if any
+func (e *ESPNBoard) StatShortName(stat string) string {
+	if s, ok := statShortNames[strings.ToLower(stat)]; ok {
+		return s
+	}
+
+	e.rosterLock.Lock()
+	defer e.rosterLock.Unlock()
+	if s, ok := e.statLabels[stat]; ok {
+		return s
+	}
+
+	return stat
+}
+
+// ListPlayers ...
+func (e *ESPNBoard) ListPlayers(ctx context.Context, teamAbbreviation string) ([]statboard.Player, error) {
+	if len(e.teams) < 1 {
+		if _, err := e.GetTeams(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, team := range e.teams {
+		if !strings.EqualFold(team.Abbreviation, teamAbbreviation) {
+			continue
+		}
+
+		roster, err := e.getRoster(ctx, team)
+		if err != nil {
+			return nil, err
+		}
+
+		var players []statboard.Player
+
+	INNER:
+		for _, p := range roster {
+			if !p.hasStats() {
+				if err := p.setStats(ctx); err != nil {
+					e.log.Error("could not find stats for player",
+						zap.Error(err),
+						zap.String("league", e.League()),
+					)
+					continue INNER
+				}
+			}
+			players = append(players, p)
+		}
+
+		return players, nil
+	}
+
+	return nil, fmt.Errorf("could not find team '%s'", teamAbbreviation)
+}
+
+// FindPlayer ...
+func (e *ESPNBoard) FindPlayer(ctx context.Context, first string, last string) (statboard.Player, error) {
+	full := strings.ToLower(strings.TrimSpace(fmt.Sprintf("%s %s", first, last)))
+
+	if len(e.teams) < 1 {
+		if _, err := e.GetTeams(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, team := range e.teams {
+		roster, err := e.getRoster(ctx, team)
+		if err != nil {
+			e.log.Error("failed to get team roster",
+				zap.Error(err),
+				zap.String("team", team.Abbreviation),
+				zap.String("league", e.League()),
+			)
+			continue
+		}
+		for _, p := range roster {
+			if full != strings.ToLower(p.fullName()) {
+				continue
+			}
+			if !p.hasStats() {
+				if err := p.setStats(ctx); err != nil {
+					return nil, err
+				}
+			}
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find player '%s %s'", first, last)
+}
+
+// GetPlayer ...
+func (e *ESPNBoard) GetPlayer(ctx context.Context, id string) (statboard.Player, error) {
+	if len(e.teams) < 1 {
+		if _, err := e.GetTeams(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, team := range e.teams {
+		roster, err := e.getRoster(ctx, team)
+		if err != nil {
+			continue
+		}
+		for _, p := range roster {
+			if p.ID != id {
+				continue
+			}
+			if !p.hasStats() {
+				if err := p.setStats(ctx); err != nil {
+					return nil, err
+				}
+			}
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find player")
+}
+
+func (e *ESPNBoard) getRoster(ctx context.Context, team *Team) ([]*Player, error) {
+	e.rosterLock.Lock()
+	roster, ok := e.rosters[team.ID]
+	e.rosterLock.Unlock()
+	if ok {
+		return roster, nil
+	}
+
+	// http://site.api.espn.com/apis/site/v2/sports/football/nfl/teams/1/roster
+	uri, err := url.Parse(fmt.Sprintf("http://site.api.espn.com/apis/site/v2/sports/%s/teams/%s/roster", e.leaguer.APIPath(), team.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	e.log.Debug("fetching team roster",
+		zap.String("league", e.League()),
+		zap.String("team", team.Abbreviation),
+	)
+
+	req, err := http.NewRequest("GET", uri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	client := fetch.Client()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	roster, err = parseRoster(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse roster for %s: %w", team.Abbreviation, err)
+	}
+
+	for _, p := range roster {
+		p.espnBoard = e
+		p.TeamAbbreviation = team.Abbreviation
+		p.category = categoryForPosition(e.sport(), p.Position())
+	}
+
+	e.rosterLock.Lock()
+	defer e.rosterLock.Unlock()
+	e.rosters[team.ID] = roster
+
+	return roster, nil
+}
+
+func parseRoster(dat []byte) ([]*Player, error) {
+	var r *rosterData
+	if err := json.Unmarshal(dat, &r); err != nil {
+		return nil, err
+	}
+
+	players := []*Player{}
+
+	for _, raw := range r.Athletes {
+		var group *rosterGroup
+		if err := json.Unmarshal(raw, &group); err == nil && len(group.Items) > 0 {
+			players = append(players, group.Items...)
+			continue
+		}
+
+		var p *Player
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return nil, err
+		}
+		players = append(players, p)
+	}
+
+	return players, nil
+}
+
+func categoryForPosition(sport string, position string) string {
+	cats := sportStatCategories[sport]
+	if len(cats) < 1 {
+		return player
+	}
+	for _, c := range cats {
+		for _, pos := range c.positions {
+			if strings.EqualFold(pos, position) {
+				return c.name
+			}
+		}
+	}
+
+	return cats[len(cats)-1].name
+}
+
+// parseAthleteStats returns a map of stat name to value for the most recent season,
+// along with a map of stat name to its short label
+func parseAthleteStats(dat []byte) (map[string]string, map[string]string, error) {
+	var d *athleteStatData
+	if err := json.Unmarshal(dat, &d); err != nil {
+		return nil, nil, err
+	}
+
+	stats := make(map[string]string)
+	labels := make(map[string]string)
+
+	for _, cat := range d.Categories {
+		if len(cat.Statistics) < 1 {
+			continue
+		}
+		// Seasons are listed in ascending order
+		season := cat.Statistics[len(cat.Statistics)-1]
+		for i, name := range cat.Names {
+			if i >= len(season.Stats) {
+				break
+			}
+			if _, ok := stats[name]; !ok {
+				stats[name] = season.Stats[i]
+			}
+			if i < len(cat.Labels) {
+				labels[name] = cat.Labels[i]
+			}
+		}
+	}
+
+	return stats, labels, nil
+}
+
+func (p *Player) hasStats() bool {
+	p.statLock.RLock()
+	defer p.statLock.RUnlock()
+	return p.stats != nil
+}
+
+func (p *Player) setStats(ctx context.Context) error {
+	if p.espnBoard == nil {
+		return fmt.Errorf("player %s is missing league info", p.ID)
+	}
+
+	// https://site.web.api.espn.com/apis/common/v3/sports/football/nfl/athletes/3139477/stats
+	uri, err := url.Parse(fmt.Sprintf("https://site.web.api.espn.com/apis/common/v3/sports/%s/athletes/%s/stats", p.espnBoard.leaguer.APIPath(), p.ID))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("GET", uri.String(), nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	client := fetch.Client()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	stats, labels, err := parseAthleteStats(body)
+	if err != nil {
+		return fmt.Errorf("failed to parse stats for player %s: %w", p.fullName(), err)
+	}
+
+	p.statLock.Lock()
+	p.stats = stats
+	p.statLock.Unlock()
+
+	p.espnBoard.rosterLock.Lock()
+	defer p.espnBoard.rosterLock.Unlock()
+	for k, v := range labels {
+		p.espnBoard.statLabels[k] = v
+	}
+
+	return nil
+}
+
+func (p *Player) fullName() string {
+	if p.FullName != "" {
+		return p.FullName
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s", p.First, p.Last))
+}
+
+// UpdateStats ...
+func (p *Player) UpdateStats(ctx context.Context) error {
+	return p.setStats(ctx)
+}
+
+// GetCategory returns the player's stat category, based on their position
+func (p *Player) GetCategory() string {
+	if p.category != "" {
+		return p.category
+	}
+	return player
+}
+
+// Position ...
+func (p *Player) Position() string {
+	if p.PlayerPosition == nil {
+		return ""
+	}
+	return p.PlayerPosition.Abbreviation
+}
+
+// GetStat ...
+func (p *Player) GetStat(stat string) string {
+	p.statLock.RLock()
+	defer p.statLock.RUnlock()
+
+	if p.stats == nil {
+		return ""
+	}
+	if s, ok := p.stats[stat]; ok {
+		return s
+	}
+	for k, v := range p.stats {
+		if strings.EqualFold(k, stat) {
+			return v
+		}
+	}
+
+	return "?"
+}
+
+// StatColor ...
+func (p *Player) StatColor(stat string) color.Color {
+	return color.White
+}
+
+// PrefixCol ...
+func (p *Player) PrefixCol() string {
+	return ""
+}
+
+// FirstName ...
+func (p *Player) FirstName() string {
+	if p.First != "" {
+		return p.First
+	}
+	parts := strings.Fields(p.FullName)
+	if len(parts) > 0 {
+		return parts[0]
+	}
+
+	return p.FullName
+}
+
+// LastName ...
+func (p *Player) LastName() string {
+	if p.Last != "" {
+		return p.Last
+	}
+	parts := strings.Fields(p.FullName)
+	if len(parts) > 0 {
+		return strings.Join(parts[1:], " ")
+	}
+	return p.FullName
+}
+
+// GetTeamAbbreviation ...
+func (p *Player) GetTeamAbbreviation() string {
+	return p.TeamAbbreviation
+}
@@ -0,0 +1,106 @@
+package espnboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRoster(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		in       string
+		expected []string
+	}{
+		{
+			name: "grouped",
+			in: `{"athletes":[
+				{"position":"offense","items":[{"id":"1","firstName":"Josh","lastName":"Allen","position":{"abbreviation":"QB"}}]},
+				{"position":"defense","items":[{"id":"2","firstName":"Von","lastName":"Miller","position":{"abbreviation":"LB"}}]}
+			]}`,
+			expected: []string{"1", "2"},
+		},
+		{
+			name: "flat",
+			in: `{"athletes":[
+				{"id":"3","firstName":"Trae","lastName":"Young","position":{"abbreviation":"G"}},
+				{"id":"4","fullName":"Clint Capela","position":{"abbreviation":"C"}}
+			]}`,
+			expected: []string{"3", "4"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			players, err := parseRoster([]byte(test.in))
+			require.NoError(t, err)
+
+			ids := []string{}
+			for _, p := range players {
+				ids = append(ids, p.ID)
+			}
+			require.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func TestParseAthleteStats(t *testing.T) {
+	t.Parallel()
+
+	dat := `{"categories":[
+		{"name":"passing","labels":["YDS","TD"],"names":["passingYards","passingTouchdowns"],
+			"statistics":[{"stats":["3000","20"]},{"stats":["4306","29"]}]},
+		{"name":"rushing","labels":["YDS","TD"],"names":["rushingYards","rushingTouchdowns"],
+			"statistics":[{"stats":["524","15"]}]}
+	]}`
+
+	stats, labels, err := parseAthleteStats([]byte(dat))
+	require.NoError(t, err)
+	require.Equal(t, "4306", stats["passingYards"])
+	require.Equal(t, "29", stats["passingTouchdowns"])
+	require.Equal(t, "524", stats["rushingYards"])
+	require.Equal(t, "YDS", labels["rushingYards"])
+}
+
+func TestCategoryForPosition(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, passing, categoryForPosition("football", "QB"))
+	require.Equal(t, rushing, categoryForPosition("football", "RB"))
+	require.Equal(t, defense, categoryForPosition("football", "CB"))
+	require.Equal(t, goalkeeper, categoryForPosition("soccer", "G"))
+	require.Equal(t, outfield, categoryForPosition("soccer", "F"))
+	require.Equal(t, player, categoryForPosition("basketball", "C"))
+	require.Equal(t, player, categoryForPosition("unknown", "C"))
+}
+
+func TestParseLeaders(t *testing.T) {
+	t.Parallel()
+
+	dat := `{"leaders":{"categories":[
+		{"name":"passingYards","abbreviation":"PYDS","leaders":[
+			{"displayValue":"4,306","athlete":{"id":"1","firstName":"Josh","lastName":"Allen"},"team":{"abbreviation":"BUF"}},
+			{"displayValue":"4,183","athlete":{"id":"2","firstName":"Jared","lastName":"Goff"},"team":{"abbreviation":"DET"}}
+		]},
+		{"name":"rushingYards","abbreviation":"RYDS","leaders":[
+			{"displayValue":"1,500","athlete":{"id":"3","firstName":"Derrick","lastName":"Henry"},"team":{"abbreviation":"BAL"}}
+		]}
+	]}}`
+
+	players, abbrev, err := parseLeaders([]byte(dat), "PYDS", 1)
+	require.NoError(t, err)
+	require.Equal(t, "PYDS", abbrev)
+	require.Len(t, players, 1)
+	require.Equal(t, "Allen", players[0].LastName())
+	require.Equal(t, "BUF", players[0].GetTeamAbbreviation())
+	require.Equal(t, "4,306", players[0].GetStat("PYDS"))
+
+	_, _, err = parseLeaders([]byte(dat), "sacks", 10)
+	require.Error(t, err)
+}
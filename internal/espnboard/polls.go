@@ -0,0 +1,91 @@
+package espnboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	pollboard "github.com/robbydyer/sports/internal/board/poll"
+)
+
+// pollAliases maps friendlier poll names to ESPN's poll types
+var pollAliases = map[string]string{
+	"coaches": "usa",
+	"playoff": "cfp",
+}
+
+// pollLeagues are the leagues with ranking polls
+var pollLeagues = map[string]func(context.Context, *zap.Logger) (*ESPNBoard, error){
+	"ncaaf": NewNCAAF,
+	"ncaam": NewNCAAMensBasketball,
+	"ncaaw": NewNCAAWomensBasketball,
+}
+
+// NewPollAPI returns the API of a league with ranking polls. Polls are available for ncaaf, ncaam and ncaaw.
+// The league's existing API is reused when there is one, so its teams and rankings are only fetched once.
+func NewPollAPI(ctx context.Context, league string, existing *ESPNBoard, logger *zap.Logger) (*ESPNBoard, error) {
+	newAPI, ok := pollLeagues[strings.ToLower(league)]
+	if !ok {
+		return nil, fmt.Errorf("polls are not available for league '%s'", league)
+	}
+
+	if existing != nil {
+		return existing, nil
+	}
+
+	return newAPI(ctx, logger)
+}
+
+// GetPoll gets the latest edition of a poll, such as "ap", "coaches" or "cfp". An empty poll
+// gets the first available of the playoff rankings, AP poll and coaches poll.
+func (e *ESPNBoard) GetPoll(ctx context.Context, poll string) (*pollboard.Poll, error) {
+	data, err := e.getRankings(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return pollFromRankings(data, poll)
+}
+
+func pollFromRankings(data *ncaafRankingsData, poll string) (*pollboard.Poll, error) {
+	pollTypes := preferedPolls
+	if poll != "" {
+		poll = strings.ToLower(poll)
+		if alias, ok := pollAliases[poll]; ok {
+			poll = alias
+		}
+		pollTypes = []string{poll}
+	}
+
+	ranking := findRanking(data, pollTypes)
+	if ranking == nil {
+		return nil, fmt.Errorf("no rankings found for poll '%s'", poll)
+	}
+
+	p := &pollboard.Poll{
+		Name: ranking.ShortName,
+	}
+	if p.Name == "" {
+		p.Name = ranking.Name
+	}
+	if ranking.Occurrence != nil {
+		p.Week = ranking.Occurrence.DisplayValue
+	}
+
+	for _, r := range ranking.Ranks {
+		if r.Team == nil || r.Current < 1 {
+			continue
+		}
+		p.Teams = append(p.Teams, &pollboard.RankedTeam{
+			ID:           r.Team.ID,
+			Abbreviation: r.Team.Abbreviation,
+			Record:       r.Record,
+			Rank:         r.Current,
+			Previous:     r.Previous,
+		})
+	}
+
+	return p, nil
+}
@@ -0,0 +1,76 @@
+package espnboard
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPollFromRankings(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`{
+	"rankings": [
+		{
+			"type": "ap",
+			"name": "AP Top 25",
+			"shortName": "AP Poll",
+			"season": {"year": 2022},
+			"ranks": [{"current": 1, "previous": 1, "team": {"id": "9", "abbreviation": "OLD"}}]
+		},
+		{
+			"type": "ap",
+			"name": "AP Top 25",
+			"shortName": "AP Poll",
+			"season": {"year": 2023},
+			"occurrence": {"displayValue": "Week 7"},
+			"ranks": [
+				{"current": 1, "previous": 2, "recordSummary": "6-0", "team": {"id": "1", "abbreviation": "UGA"}},
+				{"current": 2, "previous": 0, "recordSummary": "6-0", "team": {"id": "2", "abbreviation": "MICH"}}
+			]
+		},
+		{
+			"type": "usa",
+			"name": "AFCA Coaches Poll",
+			"season": {"year": 2023},
+			"ranks": [{"current": 1, "previous": 1, "recordSummary": "6-0", "team": {"id": "2", "abbreviation": "MICH"}}]
+		}
+	]
+}`)
+
+	var data *ncaafRankingsData
+	require.NoError(t, json.Unmarshal(dat, &data))
+
+	p, err := pollFromRankings(data, "")
+	require.NoError(t, err)
+	require.Equal(t, "AP Poll", p.Name)
+	require.Equal(t, "Week 7", p.Week)
+	require.Len(t, p.Teams, 2)
+	require.Equal(t, "UGA", p.Teams[0].Abbreviation)
+	require.Equal(t, 1, p.Teams[0].Movement())
+	require.Equal(t, 0, p.Teams[1].Movement())
+	require.Equal(t, "NR", p.Teams[1].PreviousStr())
+
+	p, err = pollFromRankings(data, "Coaches")
+	require.NoError(t, err)
+	require.Equal(t, "AFCA Coaches Poll", p.Name)
+	require.Equal(t, "MICH", p.Teams[0].Abbreviation)
+
+	_, err = pollFromRankings(data, "cfp")
+	require.Error(t, err)
+}
+
+func TestNewPollAPI(t *testing.T) {
+	t.Parallel()
+
+	existing := &ESPNBoard{}
+	api, err := NewPollAPI(context.Background(), "NCAAF", existing, zap.NewNop())
+	require.NoError(t, err)
+	require.Same(t, existing, api)
+
+	_, err = NewPollAPI(context.Background(), "nfl", existing, zap.NewNop())
+	require.Error(t, err)
+}
@@ -0,0 +1,136 @@
+package espnboard
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+var (
+	registry     = map[string]*LeagueDefinition{}
+	registryLock sync.RWMutex
+)
+
+// LeagueDefinition defines an ESPN league at runtime, such as from the config, rather than as a built in Leaguer.
+// It implements Leaguer.
+type LeagueDefinition struct {
+	// Name is the league's display name, such as "Championship"
+	Name string `json:"league"`
+	// Path is the ESPN sport/league API path, such as "soccer/eng.2"
+	Path string `json:"apiPath"`
+	// PathPrefix is the league's HTTP path prefix, such as "championship"
+	PathPrefix string `json:"httpPathPrefix"`
+	// Teams are the API endpoints the teams are pulled from. Defaults to "<apiPath>/teams"
+	Teams []string `json:"teamEndpoints"`
+	// Headlines is the API path of the league's news. Defaults to "<apiPath>/news"
+	Headlines string `json:"headlinePath"`
+	// SwapHomeSide shows the home team on the left, as is common in soccer
+	SwapHomeSide bool `json:"homeSideSwap"`
+	// ScoreboardQuery are extra query parameters for the scoreboard API, such as "groups: 50"
+	ScoreboardQuery map[string]string `json:"scoreboardQuery"`
+	// LogoFile is an optional path to a league logo image, used for headlines and the league logo
+	LogoFile string `json:"logoFile"`
+}
+
+// Validate checks the definition is usable and doesn't clash with a built in league
+func (d *LeagueDefinition) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("ESPN league definition is missing a league name")
+	}
+	if d.Path == "" {
+		return fmt.Errorf("ESPN league '%s' is missing an apiPath", d.Name)
+	}
+	if d.PathPrefix == "" {
+		return fmt.Errorf("ESPN league '%s' is missing an httpPathPrefix", d.Name)
+	}
+	if strings.ContainsAny(d.PathPrefix, "/ ") {
+		return fmt.Errorf("ESPN league '%s' httpPathPrefix '%s' must not contain slashes or spaces", d.Name, d.PathPrefix)
+	}
+	if _, err := builtinLeaguer(d.PathPrefix); err == nil {
+		return fmt.Errorf("ESPN league '%s' httpPathPrefix '%s' is already used by a built in league", d.Name, d.PathPrefix)
+	}
+
+	return nil
+}
+
+// League ...
+func (d *LeagueDefinition) League() string {
+	return d.Name
+}
+
+// APIPath ...
+func (d *LeagueDefinition) APIPath() string {
+	return strings.Trim(d.Path, "/")
+}
+
+// TeamEndpoints ...
+func (d *LeagueDefinition) TeamEndpoints() []string {
+	if len(d.Teams) > 0 {
+		return d.Teams
+	}
+	return []string{filepath.Join(d.APIPath(), "teams")}
+}
+
+// HTTPPathPrefix ...
+func (d *LeagueDefinition) HTTPPathPrefix() string {
+	return strings.ToLower(d.PathPrefix)
+}
+
+// HeadlinePath ...
+func (d *LeagueDefinition) HeadlinePath() string {
+	if d.Headlines != "" {
+		return d.Headlines
+	}
+	return fmt.Sprintf("%s/news", d.APIPath())
+}
+
+// HomeSideSwap ...
+func (d *LeagueDefinition) HomeSideSwap() bool {
+	return d.SwapHomeSide
+}
+
+// SetScoreboardQuery ...
+func (d *LeagueDefinition) SetScoreboardQuery(v url.Values) {
+	for k, val := range d.ScoreboardQuery {
+		v.Set(k, val)
+	}
+}
+
+// RegisterLeague validates a league definition and makes it available from GetLeaguer
+func RegisterLeague(d *LeagueDefinition) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if existing, ok := registry[d.HTTPPathPrefix()]; ok && existing != d {
+		return fmt.Errorf("ESPN league httpPathPrefix '%s' is defined more than once", d.HTTPPathPrefix())
+	}
+	registry[d.HTTPPathPrefix()] = d
+
+	return nil
+}
+
+func registeredLeaguer(league string) (Leaguer, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	d, ok := registry[league]
+	return d, ok
+}
+
+// NewFromDefinition registers a league definition and returns its API
+func NewFromDefinition(ctx context.Context, d *LeagueDefinition, logger *zap.Logger) (*ESPNBoard, error) {
+	if err := RegisterLeague(d); err != nil {
+		return nil, err
+	}
+
+	return New(ctx, d, logger, defaultRankSetter, defaultRankSetter)
+}
@@ -0,0 +1,107 @@
+package espnboard
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLeagueDefinitionValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		def         *LeagueDefinition
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			def:  &LeagueDefinition{Name: "Liga MX", Path: "soccer/mex.1", PathPrefix: "ligamx"},
+		},
+		{
+			name:        "missing path",
+			def:         &LeagueDefinition{Name: "Liga MX", PathPrefix: "ligamx"},
+			expectedErr: "missing an apiPath",
+		},
+		{
+			name:        "missing prefix",
+			def:         &LeagueDefinition{Name: "Liga MX", Path: "soccer/mex.1"},
+			expectedErr: "missing an httpPathPrefix",
+		},
+		{
+			name:        "bad prefix",
+			def:         &LeagueDefinition{Name: "Liga MX", Path: "soccer/mex.1", PathPrefix: "liga/mx"},
+			expectedErr: "must not contain",
+		},
+		{
+			name:        "built in",
+			def:         &LeagueDefinition{Name: "EPL", Path: "soccer/eng.1", PathPrefix: "EPL"},
+			expectedErr: "already used by a built in league",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			err := test.def.Validate()
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRegisterLeague(t *testing.T) {
+	t.Parallel()
+
+	def := &LeagueDefinition{
+		Name:            "NWSL",
+		Path:            "/soccer/usa.nwsl/",
+		PathPrefix:      "NWSL",
+		SwapHomeSide:    true,
+		ScoreboardQuery: map[string]string{"groups": "50"},
+	}
+	require.NoError(t, RegisterLeague(def))
+	require.NoError(t, RegisterLeague(def))
+	require.Error(t, RegisterLeague(&LeagueDefinition{Name: "Other", Path: "soccer/usa.nwsl", PathPrefix: "nwsl"}))
+
+	l, err := GetLeaguer("nwsl")
+	require.NoError(t, err)
+	require.Equal(t, "NWSL", l.League())
+	require.Equal(t, "soccer/usa.nwsl", l.APIPath())
+	require.Equal(t, "nwsl", l.HTTPPathPrefix())
+	require.Equal(t, []string{"soccer/usa.nwsl/teams"}, l.TeamEndpoints())
+	require.Equal(t, "soccer/usa.nwsl/news", l.HeadlinePath())
+	require.True(t, l.HomeSideSwap())
+
+	v := url.Values{}
+	l.SetScoreboardQuery(v)
+	require.Equal(t, "50", v.Get("groups"))
+
+	_, err = GetLeaguer("notaleague")
+	require.Error(t, err)
+}
+
+func TestNewFromLeague(t *testing.T) {
+	t.Parallel()
+
+	def := &LeagueDefinition{Name: "Liga MX", Path: "soccer/mex.1", PathPrefix: "ligamxfromleague"}
+	require.NoError(t, RegisterLeague(def))
+
+	api, err := NewFromLeague(context.Background(), "LigaMXFromLeague", zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, def, api.leaguer)
+
+	api, err = NewFromLeague(context.Background(), "nfl", zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, "nfl", api.HTTPPathPrefix())
+
+	_, err = NewFromLeague(context.Background(), "nope", zap.NewNop())
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package espnboard
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	cricketScoreRegex = regexp.MustCompile(`^(.*?)\s*\(([^)]*)\)\s*$`)
+	cricketRunsRegex  = regexp.MustCompile(`([0-9]+)(/[0-9]+)?d?$`)
+	parentheticRegex  = regexp.MustCompile(`\s*\([^)]*\)`)
+)
+
+// richScoreLeaguer is implemented by Leaguers of sports whose scores don't fit in a single number.
+// Teams of these leagues implement sportboard.RichScoreTeam.
+type richScoreLeaguer interface {
+	// setRichScore sets the competitor's score detail from the scoreboard
+	setRichScore(c *competitor)
+	periodName(period int) string
+}
+
+// ScoreDetail implements sportboard.RichScoreTeam
+func (t *Team) ScoreDetail() string {
+	return t.scoreDetail
+}
+
+// ScoreSubDetail implements sportboard.RichScoreTeam
+func (t *Team) ScoreSubDetail() string {
+	return t.scoreSubDetail
+}
+
+// sessionLeaguer is implemented by Leaguers of sports without a game clock. Their games show
+// the session instead, such as "Lunch" or "Stumps".
+type sessionLeaguer interface {
+	session(s *status) string
+}
+
+// cricket is embedded in cricket Leaguers
+type cricket struct{}
+
+// setRichScore sets a cricket score, such as "187/4 (18.2 ov)", as the detail "187/4" with
+// the overs as sub-detail. The team's points are its runs in the match, so they only go up
+// when a new innings starts.
+func (c *cricket) setRichScore(comp *competitor) {
+	if comp.Team == nil {
+		return
+	}
+	detail, overs, runs := parseCricketScore(comp.Score)
+	comp.Team.scoreDetail = detail
+	comp.Team.scoreSubDetail = overs
+	comp.Team.Points = strconv.Itoa(runs)
+}
+
+func (c *cricket) periodName(period int) string {
+	return fmt.Sprintf("INN %d", period)
+}
+
+// session is the game's status, such as "Lunch", "Stumps" or "Innings break". The overs are
+// already shown with the score.
+func (c *cricket) session(s *status) string {
+	if s == nil {
+		return ""
+	}
+	if s.Type.ShortDetail != "" {
+		return s.Type.ShortDetail
+	}
+	return s.Type.Description
+}
+
+// parseCricketScore splits a score such as "250 & 180/3 (45 ov, T:320)" into the score
+// "250 & 180/3", the overs "45 ov" and the runs of every innings
+func parseCricketScore(score string) (string, string, int) {
+	score = strings.TrimSpace(score)
+	detail := score
+	overs := ""
+
+	if m := cricketScoreRegex.FindStringSubmatch(score); len(m) == 3 {
+		detail = m[1]
+		overs = strings.TrimSpace(strings.SplitN(m[2], ",", 2)[0])
+	}
+
+	runs := 0
+	for _, innings := range strings.Split(detail, "&") {
+		if m := cricketRunsRegex.FindStringSubmatch(strings.TrimSpace(innings)); len(m) > 1 {
+			r, _ := strconv.Atoi(m[1])
+			runs += r
+		}
+	}
+
+	return detail, overs, runs
+}
+
+// rugby is embedded in rugby union Leaguers
+type rugby struct{}
+
+// setRichScore sets the number of tries, such as "3T", as the sub-detail. The score
+// itself is the usual points total.
+func (r *rugby) setRichScore(comp *competitor) {
+	if comp.Team == nil {
+		return
+	}
+	for _, stat := range comp.Statistics {
+		if strings.EqualFold(stat.Name, "tries") && stat.DisplayValue != "" {
+			comp.Team.scoreSubDetail = fmt.Sprintf("%sT", stat.DisplayValue)
+			return
+		}
+	}
+}
+
+func (r *rugby) periodName(period int) string {
+	switch period {
+	case 1:
+		return "1H"
+	case 2:
+		return "2H"
+	}
+	return "ET"
+}
+
+// shortSummary shortens a result summary to fit the matrix, replacing team names with
+// their abbreviations. For example, "Mumbai Indians won by 5 wickets (with 7 balls remaining)"
+// becomes "MI won by 5 wkts".
+func shortSummary(summary string, teams ...*Team) string {
+	summary = parentheticRegex.ReplaceAllString(summary, "")
+	for _, t := range teams {
+		if t == nil || t.Abbreviation == "" {
+			continue
+		}
+		for _, name := range []string{t.DisplayName, t.Name} {
+			if name != "" {
+				summary = strings.ReplaceAll(summary, name, t.Abbreviation)
+			}
+		}
+	}
+	summary = strings.ReplaceAll(summary, "wickets", "wkts")
+	summary = strings.ReplaceAll(summary, "wicket", "wkt")
+
+	return strings.TrimSpace(summary)
+}
@@ -0,0 +1,116 @@
+package espnboard
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	sportboard "github.com/robbydyer/sports/internal/board/sport"
+)
+
+func TestParseCricketScore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		in     string
+		detail string
+		overs  string
+		runs   int
+	}{
+		{
+			name:   "all out",
+			in:     "187 (19.3 ov)",
+			detail: "187",
+			overs:  "19.3 ov",
+			runs:   187,
+		},
+		{
+			name:   "chasing",
+			in:     "145/6 (16.2 ov, T:188)",
+			detail: "145/6",
+			overs:  "16.2 ov",
+			runs:   145,
+		},
+		{
+			name:   "test match",
+			in:     "250 & 180/3d",
+			detail: "250 & 180/3d",
+			overs:  "",
+			runs:   430,
+		},
+		{
+			name: "yet to bat",
+			in:   "",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			detail, overs, runs := parseCricketScore(test.in)
+			require.Equal(t, test.detail, detail)
+			require.Equal(t, test.overs, overs)
+			require.Equal(t, test.runs, runs)
+		})
+	}
+}
+
+func TestShortSummary(t *testing.T) {
+	t.Parallel()
+
+	teams := []*Team{
+		{DisplayName: "Mumbai Indians", Abbreviation: "MI"},
+		{DisplayName: "Chennai Super Kings", Abbreviation: "CSK"},
+	}
+
+	require.Equal(t, "MI won by 5 wkts", shortSummary("Mumbai Indians won by 5 wickets (with 7 balls remaining)", teams...))
+	require.Equal(t, "CSK need 24 runs", shortSummary("Chennai Super Kings need 24 runs", teams...))
+}
+
+func TestRichScoreGame(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`{
+	"id": "1",
+	"date": "2023-04-08T14:00Z",
+	"status": {"period": 2, "summary": "Chennai Super Kings need 43 runs from 20 balls", "type": {"state": "in", "shortDetail": "Live"}},
+	"competitions": [{
+		"competitors": [
+			{"homeAway": "home", "score": "187/4 (20 ov)", "team": {"id": "1", "abbreviation": "MI", "displayName": "Mumbai Indians"}},
+			{"homeAway": "away", "score": "145/6 (16.4 ov, T:188)", "team": {"id": "2", "abbreviation": "CSK", "displayName": "Chennai Super Kings"}}
+		]
+	}]
+}`)
+
+	var e *event
+	require.NoError(t, json.Unmarshal(dat, &e))
+
+	b := &ESPNBoard{leaguer: &ipl{}, log: zap.NewNop()}
+	g, err := gameFromEvent(e, b)
+	require.NoError(t, err)
+	g.leaguer = b.leaguer
+
+	home, err := g.HomeTeam()
+	require.NoError(t, err)
+	rich, ok := home.(sportboard.RichScoreTeam)
+	require.True(t, ok)
+	require.Equal(t, "187/4", rich.ScoreDetail())
+	require.Equal(t, "20 ov", rich.ScoreSubDetail())
+	require.Equal(t, 187, home.Score())
+
+	quarter, err := g.GetQuarter()
+	require.NoError(t, err)
+	require.Equal(t, "INN 2", quarter)
+
+	clock, err := g.GetClock()
+	require.NoError(t, err)
+	require.Equal(t, "Live", clock)
+
+	summary, err := g.GetSummary()
+	require.NoError(t, err)
+	require.Equal(t, "CSK need 43 runs from 20 balls", summary)
+}
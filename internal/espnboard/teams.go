@@ -5,6 +5,7 @@ import (
 	"embed"
 	"encoding/json"
 	"fmt"
+	"image/color"
 	"io"
 	"net/http"
 	"net/url"
@@ -17,6 +18,9 @@ import (
 
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/fetch"
+	"github.com/robbydyer/sports/internal/rgbrender"
 )
 
 //go:embed assets
@@ -44,6 +48,8 @@ type Team struct {
 	IsHome         bool
 	rank           int
 	record         string
+	scoreDetail    string
+	scoreSubDetail string
 	sync.Mutex
 }
 
@@ -218,6 +224,30 @@ func (t *Team) GetDisplayName() string {
 	return t.DisplayName
 }
 
+// GetColor implements sportboard.ColorTeam. It's nil if the team has no color.
+func (t *Team) GetColor() color.Color {
+	if t.Color == "" {
+		return nil
+	}
+	r, g, b, err := rgbrender.HexToRGB(t.Color)
+	if err != nil {
+		return nil
+	}
+	return color.RGBA{r, g, b, 255}
+}
+
+// GetAlternateColor implements sportboard.AlternateColorTeam. It's nil if the team has no alternate color.
+func (t *Team) GetAlternateColor() color.Color {
+	if t.AlternateColor == "" {
+		return nil
+	}
+	r, g, b, err := rgbrender.HexToRGB(t.AlternateColor)
+	if err != nil {
+		return nil
+	}
+	return color.RGBA{r, g, b, 255}
+}
+
 // GetAbbreviation ...
 func (t *Team) GetAbbreviation() string {
 	return t.Abbreviation
@@ -256,7 +286,7 @@ func pullTeams(ctx context.Context, endpoint string) ([]byte, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -0,0 +1,171 @@
+package espnfight
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"image"
+	"path/filepath"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/fetch"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+//go:embed assets
+var assets embed.FS
+
+const cacheDir = "/tmp/sportsmatrix/fight"
+
+// API ...
+type API struct {
+	leaguer Leaguer
+	log     *zap.Logger
+}
+
+// Leaguer ...
+type Leaguer interface {
+	ShortName() string
+	HTTPPathPrefix() string
+	APIPath() string
+	LogoAsset() string
+}
+
+// New ...
+func New(leaguer Leaguer, log *zap.Logger) (*API, error) {
+	return &API{
+		leaguer: leaguer,
+		log:     log,
+	}, nil
+}
+
+// GetLeaguer returns the Leaguer for a promotion by its HTTP path prefix, such as "ufc"
+func GetLeaguer(promotion string) (Leaguer, error) {
+	switch strings.Trim(strings.ToLower(promotion), " ") {
+	case "ufc":
+		return &UFC{}, nil
+	case "pfl":
+		return &PFL{}, nil
+	case "bellator":
+		return &Bellator{}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported fight promotion '%s'", promotion)
+}
+
+// GetLogo ...
+func (a *API) GetLogo(ctx context.Context, matrixBounds image.Rectangle) (*logo.Logo, error) {
+	return logo.New(
+		a.leaguer.ShortName(),
+		a.logoSourceGetter,
+		cacheDir,
+		matrixBounds,
+		&logo.Config{
+			FitImage: true,
+			Abbrev:   a.leaguer.ShortName(),
+			XSize:    matrixBounds.Dx(),
+			YSize:    matrixBounds.Dy(),
+			Pt: &logo.Pt{
+				Zoom: 1,
+			},
+		},
+	), nil
+}
+
+// LeagueShortName ...
+func (a *API) LeagueShortName() string {
+	return a.leaguer.ShortName()
+}
+
+// HTTPPathPrefix ...
+func (a *API) HTTPPathPrefix() string {
+	return a.leaguer.HTTPPathPrefix()
+}
+
+// Stale implements board.StaleAPI
+func (a *API) Stale() bool {
+	return fetch.Stale(fmt.Sprintf("%s/%s/scoreboard", baseURL, a.leaguer.APIPath()))
+}
+
+func (a *API) logoSourceGetter(ctx context.Context) (image.Image, error) {
+	b, err := assets.ReadFile(filepath.Join("assets", a.leaguer.LogoAsset()))
+	if err != nil {
+		return nil, err
+	}
+
+	reader := bytes.NewReader(b)
+	return imaging.Decode(reader)
+}
+
+// UFC ...
+type UFC struct{}
+
+// ShortName ...
+func (a *UFC) ShortName() string {
+	return "UFC"
+}
+
+// HTTPPathPrefix ...
+func (a *UFC) HTTPPathPrefix() string {
+	return "ufc"
+}
+
+// APIPath ...
+func (a *UFC) APIPath() string {
+	return "mma/ufc"
+}
+
+// LogoAsset ...
+func (a *UFC) LogoAsset() string {
+	return "ufc.png"
+}
+
+// PFL is the Professional Fighters League
+type PFL struct{}
+
+// ShortName ...
+func (a *PFL) ShortName() string {
+	return "PFL"
+}
+
+// HTTPPathPrefix ...
+func (a *PFL) HTTPPathPrefix() string {
+	return "pfl"
+}
+
+// APIPath ...
+func (a *PFL) APIPath() string {
+	return "mma/pfl"
+}
+
+// LogoAsset ...
+func (a *PFL) LogoAsset() string {
+	return "pfl.png"
+}
+
+// Bellator ...
+type Bellator struct{}
+
+// ShortName ...
+func (a *Bellator) ShortName() string {
+	return "Bellator"
+}
+
+// HTTPPathPrefix ...
+func (a *Bellator) HTTPPathPrefix() string {
+	return "bellator"
+}
+
+// APIPath ...
+func (a *Bellator) APIPath() string {
+	return "mma/bellator"
+}
+
+// LogoAsset ...
+func (a *Bellator) LogoAsset() string {
+	return "bellator.png"
+}
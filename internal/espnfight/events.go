@@ -0,0 +1,307 @@
+package espnfight
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	fightboard "github.com/robbydyer/sports/internal/board/fight"
+	"github.com/robbydyer/sports/internal/fetch"
+	"github.com/robbydyer/sports/internal/util"
+)
+
+const (
+	baseURL = "http://site.api.espn.com/apis/site/v2/sports"
+	// maxEvents is the most upcoming events shown from the season calendar
+	maxEvents = 4
+)
+
+type scoreboard struct {
+	Leagues []*struct {
+		Calendar []*struct {
+			Label     string `json:"label"`
+			StartDate string `json:"startDate"`
+			EndDate   string `json:"endDate"`
+			Event     *struct {
+				Ref string `json:"$ref"`
+			} `json:"event"`
+		} `json:"calendar"`
+	} `json:"leagues"`
+	Events []*struct {
+		ID           string         `json:"id"`
+		Date         string         `json:"date"`
+		Name         string         `json:"name"`
+		ShortName    string         `json:"shortName"`
+		Status       *status        `json:"status"`
+		Competitions []*competition `json:"competitions"`
+	} `json:"events"`
+}
+
+type status struct {
+	Period       int    `json:"period"`
+	DisplayClock string `json:"displayClock"`
+	Type         *struct {
+		State     string `json:"state"`
+		Completed bool   `json:"completed"`
+	} `json:"type"`
+	Result *struct {
+		Name             string `json:"name"`
+		DisplayName      string `json:"displayName"`
+		ShortDisplayName string `json:"shortDisplayName"`
+	} `json:"result"`
+}
+
+type competition struct {
+	ID   string `json:"id"`
+	Type *struct {
+		Abbreviation string `json:"abbreviation"`
+		Text         string `json:"text"`
+	} `json:"type"`
+	CardSegment *struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"cardSegment"`
+	Notes []*struct {
+		Headline string `json:"headline"`
+	} `json:"notes"`
+	Status      *status `json:"status"`
+	Competitors []*struct {
+		Order   int  `json:"order"`
+		Winner  bool `json:"winner"`
+		Athlete *struct {
+			DisplayName string `json:"displayName"`
+		} `json:"athlete"`
+		Records []*struct {
+			Summary string `json:"summary"`
+		} `json:"records"`
+	} `json:"competitors"`
+}
+
+// GetScheduledEvents gets the current event and the next upcoming events on the season calendar
+func (a *API) GetScheduledEvents(ctx context.Context) ([]*fightboard.Event, error) {
+	sb, err := a.scoreboardFromAPI(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsFromScoreboard(sb, time.Now()), nil
+}
+
+// GetEventCard gets the bouts of an event. The event's live status is updated.
+func (a *API) GetEventCard(ctx context.Context, event *fightboard.Event) ([]*fightboard.Bout, error) {
+	sb, err := a.scoreboardFromAPI(ctx, scoreboardDate(event.Date))
+	if err != nil {
+		return nil, err
+	}
+
+	bouts := cardForEvent(sb, event)
+
+	a.log.Debug("fight card",
+		zap.String("league", a.leaguer.ShortName()),
+		zap.String("event", event.Name),
+		zap.Bool("live", event.Live),
+		zap.Int("bouts", len(bouts)),
+	)
+
+	return bouts, nil
+}
+
+// scoreboardDate is the scoreboard date of an event. Event times are UTC, but the scoreboard's
+// dates are local, and a card that starts after midnight belongs to the day before.
+func scoreboardDate(t time.Time) string {
+	return util.Today(t).Format("20060102")
+}
+
+func eventsFromScoreboard(sb *scoreboard, now time.Time) []*fightboard.Event {
+	events := []*fightboard.Event{}
+	seen := map[string]struct{}{}
+
+	for _, e := range sb.Events {
+		d, err := time.Parse("2006-01-02T15:04Z", e.Date)
+		if err != nil {
+			continue
+		}
+		name := e.ShortName
+		if name == "" {
+			name = e.Name
+		}
+		events = append(events, &fightboard.Event{
+			ID:        e.ID,
+			Date:      d,
+			Name:      name,
+			Live:      e.Status.isLive(),
+			Completed: e.Status.isComplete(),
+		})
+		seen[e.ID] = struct{}{}
+	}
+
+	for _, l := range sb.Leagues {
+		for _, c := range l.Calendar {
+			if len(events) >= maxEvents {
+				return events
+			}
+			if c.Event == nil {
+				continue
+			}
+			id := eventID(c.Event.Ref)
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			d, err := time.Parse("2006-01-02T15:04Z", c.StartDate)
+			if err != nil || d.Before(now) {
+				continue
+			}
+			events = append(events, &fightboard.Event{
+				ID:   id,
+				Date: d,
+				Name: c.Label,
+			})
+			seen[id] = struct{}{}
+		}
+	}
+
+	return events
+}
+
+// cardForEvent finds an event's bouts in a scoreboard. Bouts are listed from the first
+// prelim to the main event, so order within a segment counts from the end.
+func cardForEvent(sb *scoreboard, event *fightboard.Event) []*fightboard.Bout {
+	for _, e := range sb.Events {
+		if e.ID != event.ID {
+			continue
+		}
+
+		event.Live = e.Status.isLive()
+		event.Completed = e.Status.isComplete()
+
+		bouts := []*fightboard.Bout{}
+		segmentCount := map[string]int{}
+
+		for i := len(e.Competitions) - 1; i >= 0; i-- {
+			c := e.Competitions[i]
+			segment := c.segment()
+			segmentCount[segment]++
+
+			b := &fightboard.Bout{
+				ID:        c.ID,
+				Segment:   segment,
+				Order:     segmentCount[segment],
+				Live:      c.Status.isLive(),
+				Completed: c.Status.isComplete(),
+				Title:     c.isTitle(),
+			}
+			if c.Type != nil {
+				b.WeightClass = c.Type.Abbreviation
+			}
+			if c.Status != nil {
+				b.Round = c.Status.Period
+				b.Clock = c.Status.DisplayClock
+				if c.Status.Result != nil {
+					b.Result = c.Status.Result.ShortDisplayName
+					if b.Result == "" {
+						b.Result = c.Status.Result.DisplayName
+					}
+				}
+			}
+
+			for _, comp := range c.Competitors {
+				f := &fightboard.Fighter{
+					Winner: comp.Winner,
+				}
+				if comp.Athlete != nil {
+					f.Name = comp.Athlete.DisplayName
+				}
+				if len(comp.Records) > 0 {
+					f.Record = comp.Records[0].Summary
+				}
+				b.Fighters = append(b.Fighters, f)
+			}
+
+			bouts = append(bouts, b)
+		}
+
+		return bouts
+	}
+
+	return nil
+}
+
+func (c *competition) segment() string {
+	if c.CardSegment == nil {
+		return fightboard.MainCard
+	}
+	seg := strings.ToLower(c.CardSegment.Name + " " + c.CardSegment.Description)
+	switch {
+	case strings.Contains(seg, "early"):
+		return fightboard.EarlyPrelims
+	case strings.Contains(seg, "prelim"):
+		return fightboard.Prelims
+	}
+	return fightboard.MainCard
+}
+
+func (c *competition) isTitle() bool {
+	if c.Type != nil && strings.Contains(strings.ToLower(c.Type.Text), "title") {
+		return true
+	}
+	for _, n := range c.Notes {
+		if strings.Contains(strings.ToLower(n.Headline), "title") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *status) isLive() bool {
+	return s != nil && s.Type != nil && s.Type.State == "in"
+}
+
+func (s *status) isComplete() bool {
+	return s != nil && s.Type != nil && (s.Type.Completed || s.Type.State == "post")
+}
+
+// eventID gets the event ID from an API reference, such as ".../events/600041234?lang=en"
+func eventID(ref string) string {
+	ref = strings.SplitN(ref, "?", 2)[0]
+	return path.Base(ref)
+}
+
+func (a *API) scoreboardFromAPI(ctx context.Context, date string) (*scoreboard, error) {
+	uri := fmt.Sprintf("%s/%s/scoreboard", baseURL, a.leaguer.APIPath())
+	if date != "" {
+		uri = fmt.Sprintf("%s?dates=%s", uri, date)
+	}
+
+	req, err := http.NewRequest("GET", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	client := fetch.Client()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	dat, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb *scoreboard
+	if err := json.Unmarshal(dat, &sb); err != nil {
+		return nil, err
+	}
+
+	return sb, nil
+}
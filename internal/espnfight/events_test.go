@@ -0,0 +1,136 @@
+package espnfight
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	fightboard "github.com/robbydyer/sports/internal/board/fight"
+	"github.com/robbydyer/sports/internal/locale"
+)
+
+const scoreboardJSON = `{
+  "leagues": [{
+    "calendar": [
+      {"label": "UFC 300", "startDate": "2025-04-13T22:00Z", "event": {"$ref": "http://sports.core.api.espn.com/v2/sports/mma/leagues/ufc/events/100?lang=en"}},
+      {"label": "UFC Fight Night", "startDate": "2025-04-20T22:00Z", "event": {"$ref": "http://sports.core.api.espn.com/v2/sports/mma/leagues/ufc/events/101?lang=en"}},
+      {"label": "UFC Old", "startDate": "2025-01-01T22:00Z", "event": {"$ref": "http://sports.core.api.espn.com/v2/sports/mma/leagues/ufc/events/99?lang=en"}}
+    ]
+  }],
+  "events": [{
+    "id": "100",
+    "date": "2025-04-13T22:00Z",
+    "name": "UFC 300: Pereira vs. Hill",
+    "shortName": "UFC 300",
+    "status": {"type": {"state": "in", "completed": false}},
+    "competitions": [
+      {
+        "id": "1",
+        "type": {"abbreviation": "FW"},
+        "cardSegment": {"name": "prelims2", "description": "Early Prelims"},
+        "status": {"period": 3, "displayClock": "5:00", "type": {"state": "post", "completed": true}, "result": {"shortDisplayName": "U-DEC"}},
+        "competitors": [
+          {"winner": true, "athlete": {"displayName": "Fighter One"}, "records": [{"summary": "10-1-0"}]},
+          {"athlete": {"displayName": "Fighter Two"}, "records": [{"summary": "8-3-0"}]}
+        ]
+      },
+      {
+        "id": "2",
+        "type": {"abbreviation": "LW"},
+        "cardSegment": {"name": "main", "description": "Main Card"},
+        "status": {"period": 2, "displayClock": "3:12", "type": {"state": "in"}},
+        "competitors": [
+          {"athlete": {"displayName": "Fighter Three"}},
+          {"athlete": {"displayName": "Fighter Four"}}
+        ]
+      },
+      {
+        "id": "3",
+        "type": {"abbreviation": "LHW", "text": "Light Heavyweight - Title Fight"},
+        "cardSegment": {"name": "main", "description": "Main Card"},
+        "status": {"type": {"state": "pre"}},
+        "competitors": [
+          {"athlete": {"displayName": "Alex Pereira"}, "records": [{"summary": "11-2-0"}]},
+          {"athlete": {"displayName": "Jamahal Hill"}, "records": [{"summary": "12-1-0"}]}
+        ]
+      }
+    ]
+  }]
+}`
+
+func TestEventsFromScoreboard(t *testing.T) {
+	t.Parallel()
+
+	var sb *scoreboard
+	require.NoError(t, json.Unmarshal([]byte(scoreboardJSON), &sb))
+
+	now, err := time.Parse("2006-01-02", "2025-04-10")
+	require.NoError(t, err)
+
+	events := eventsFromScoreboard(sb, now)
+	require.Len(t, events, 2)
+	require.Equal(t, "UFC 300", events[0].Name)
+	require.True(t, events[0].Live)
+	require.Equal(t, "101", events[1].ID)
+	require.Equal(t, "UFC Fight Night", events[1].Name)
+}
+
+func TestCardForEvent(t *testing.T) {
+	t.Parallel()
+
+	var sb *scoreboard
+	require.NoError(t, json.Unmarshal([]byte(scoreboardJSON), &sb))
+
+	event := &fightboard.Event{ID: "100"}
+	bouts := cardForEvent(sb, event)
+	require.True(t, event.Live)
+	require.Len(t, bouts, 3)
+
+	mainEvent := bouts[0]
+	require.Equal(t, "3", mainEvent.ID)
+	require.Equal(t, fightboard.MainCard, mainEvent.Segment)
+	require.Equal(t, 1, mainEvent.Order)
+	require.True(t, mainEvent.Title)
+	require.Equal(t, "11-2-0", mainEvent.Fighters[0].Record)
+
+	live := bouts[1]
+	require.True(t, live.Live)
+	require.Equal(t, 2, live.Order)
+	require.Equal(t, 2, live.Round)
+	require.Equal(t, "3:12", live.Clock)
+
+	done := bouts[2]
+	require.Equal(t, fightboard.EarlyPrelims, done.Segment)
+	require.True(t, done.Completed)
+	require.Equal(t, "U-DEC", done.Result)
+	require.True(t, done.Fighters[0].Winner)
+
+	require.Nil(t, cardForEvent(sb, &fightboard.Event{ID: "nope"}))
+}
+
+func TestGetLeaguer(t *testing.T) {
+	t.Parallel()
+
+	for _, promotion := range []string{"ufc", "PFL", "bellator"} {
+		l, err := GetLeaguer(promotion)
+		require.NoError(t, err)
+		_, err = assets.ReadFile("assets/" + l.LogoAsset())
+		require.NoError(t, err, "missing logo asset for %s", promotion)
+	}
+
+	_, err := GetLeaguer("nope")
+	require.Error(t, err)
+}
+
+func TestScoreboardDate(t *testing.T) {
+	t.Parallel()
+
+	evening := time.Date(2024, 3, 9, 20, 0, 0, 0, locale.Location())
+	require.Equal(t, "20240309", scoreboardDate(evening.UTC()))
+
+	// A main card after midnight is still the night before's card
+	late := time.Date(2024, 3, 10, 1, 30, 0, 0, locale.Location())
+	require.Equal(t, "20240309", scoreboardDate(late.UTC()))
+}
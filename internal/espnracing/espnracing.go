@@ -8,10 +8,13 @@ import (
 	"image"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/disintegration/imaging"
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/logo"
 )
 
@@ -22,10 +25,12 @@ const cacheDir = "/tmp/sportsmatrix/racing"
 
 // API ...
 type API struct {
-	myLogo   *image.Image
-	leaguer  Leaguer
-	schedule *Scoreboard
-	log      *zap.Logger
+	myLogo          *image.Image
+	leaguer         Leaguer
+	schedule        *Scoreboard
+	scheduleUpdated time.Time
+	log             *zap.Logger
+	sync.Mutex
 }
 
 // Leaguer ...
@@ -74,6 +79,12 @@ func (a *API) HTTPPathPrefix() string {
 	return a.leaguer.HTTPPathPrefix()
 }
 
+// Stale implements board.StaleAPI
+func (a *API) Stale() bool {
+	return fetch.Stale(fmt.Sprintf("%s/%s/scoreboard", baseURL, a.leaguer.APIPath())) ||
+		fetch.Stale(fmt.Sprintf("%s/%s/standings", standingsURL, a.leaguer.APIPath()))
+}
+
 func (a *API) logoSourceGetter(ctx context.Context) (image.Image, error) {
 	if a.myLogo != nil {
 		return *a.myLogo, nil
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	racingboard "github.com/robbydyer/sports/internal/board/racing"
+	"github.com/robbydyer/sports/internal/fetch"
 )
 
 const baseURL = "http://site.api.espn.com/apis/site/v2/sports"
@@ -32,45 +33,71 @@ type Scoreboard struct {
 		} `json:"calendar"`
 	} `json:"leagues"`
 	Events []*struct {
-		ID        string `json:"id"`
-		Date      string `json:"date"`
-		Name      string `json:"name"`
-		ShortName string `json:"shortName"`
-		Status    *struct {
-			Name         string `json:"name"`
-			State        string `json:"state"`
-			Completed    bool   `json:"completed"`
-			DisplayClock string `json:"displayClock"`
-		} `json:"status"`
-		Competitions []*struct {
-			ID   string `json:"id"`
-			Type *struct {
-				ID           string `json:"id"`
-				Abbreviation string `json:"abbreviation"`
-			} `json:"type"`
-			Status *struct {
-				Name         string `json:"name"`
-				State        string `json:"state"`
-				Completed    bool   `json:"completed"`
-				DisplayClock string `json:"displayClock"`
-			} `json:"status"`
-		}
+		ID           string         `json:"id"`
+		Date         string         `json:"date"`
+		Name         string         `json:"name"`
+		ShortName    string         `json:"shortName"`
+		Status       *eventStatus   `json:"status"`
+		Competitions []*competition `json:"competitions"`
 	} `json:"events"`
 }
 
+type eventStatus struct {
+	Name         string `json:"name"`
+	State        string `json:"state"`
+	Completed    bool   `json:"completed"`
+	DisplayClock string `json:"displayClock"`
+	Period       int    `json:"period"`
+	Type         *struct {
+		State       string `json:"state"`
+		Completed   bool   `json:"completed"`
+		Description string `json:"description"`
+	} `json:"type"`
+}
+
+type competition struct {
+	ID   string `json:"id"`
+	Type *struct {
+		ID           string `json:"id"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"type"`
+	Date        string        `json:"date"`
+	Status      *eventStatus  `json:"status"`
+	Competitors []*competitor `json:"competitors"`
+}
+
 // GetScheduledEvents ...
 func (a *API) GetScheduledEvents(ctx context.Context) ([]*racingboard.Event, error) {
-	if a.schedule != nil {
-		return a.eventsFromSchedule(a.schedule)
+	sched, err := a.getSchedule(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.eventsFromSchedule(sched)
+}
+
+// getSchedule returns the cached schedule, fetching it when there isn't one or it's older than
+// maxAge. A maxAge of 0 never refetches a cached schedule.
+func (a *API) getSchedule(ctx context.Context, maxAge time.Duration) (*Scoreboard, error) {
+	a.Lock()
+	sched, updated := a.schedule, a.scheduleUpdated
+	a.Unlock()
+
+	if sched != nil && (maxAge == 0 || time.Since(updated) < maxAge) {
+		return sched, nil
 	}
 
-	var err error
-	a.schedule, err = a.scheduledEventsFromAPI(ctx)
+	sched, err := a.scheduledEventsFromAPI(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return a.eventsFromSchedule(a.schedule)
+	a.Lock()
+	defer a.Unlock()
+	a.schedule = sched
+	a.scheduleUpdated = time.Now()
+
+	return sched, nil
 }
 
 func (a *API) eventsFromSchedule(sched *Scoreboard) ([]*racingboard.Event, error) {
@@ -87,8 +114,11 @@ func (a *API) eventsFromSchedule(sched *Scoreboard) ([]*racingboard.Event, error
 
 		events = append(events,
 			&racingboard.Event{
-				Name: e.ShortName,
-				Date: eventDate,
+				ID:        e.ID,
+				Name:      e.ShortName,
+				Date:      eventDate,
+				Live:      e.Status.isLive(),
+				Completed: e.Status.isComplete(),
 			},
 		)
 	}
@@ -108,7 +138,7 @@ func (a *API) scheduledEventsFromAPI(ctx context.Context) (*Scoreboard, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -129,3 +159,23 @@ func (a *API) scheduledEventsFromAPI(ctx context.Context) (*Scoreboard, error) {
 
 	return sched, nil
 }
+
+func (s *eventStatus) isLive() bool {
+	if s == nil {
+		return false
+	}
+	if s.Type != nil {
+		return s.Type.State == "in"
+	}
+	return s.State == "in"
+}
+
+func (s *eventStatus) isComplete() bool {
+	if s == nil {
+		return false
+	}
+	if s.Type != nil {
+		return s.Type.Completed
+	}
+	return s.Completed
+}
@@ -1,5 +1,30 @@
 package espnracing
 
+import (
+	"fmt"
+	"strings"
+)
+
+// GetLeaguer returns the Leaguer for a racing series by its HTTP path prefix, such as "f1" or "nascar"
+func GetLeaguer(series string) (Leaguer, error) {
+	switch strings.Trim(strings.ToLower(series), " ") {
+	case "f1":
+		return &F1{}, nil
+	case "irl":
+		return &IRL{}, nil
+	case "nascar":
+		return &NASCAR{}, nil
+	case "xfinity":
+		return &Xfinity{}, nil
+	case "truck":
+		return &Truck{}, nil
+	case "motogp":
+		return &MotoGP{}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported racing series '%s'", series)
+}
+
 // F1 ...
 type F1 struct{}
 
@@ -55,3 +80,115 @@ func (a *IRL) APIPath() string {
 func (a *IRL) LogoAsset() string {
 	return "irl.png"
 }
+
+// NASCAR is the NASCAR Cup Series
+type NASCAR struct{}
+
+// ShortName ...
+func (a *NASCAR) ShortName() string {
+	return "NASCAR"
+}
+
+// LogoSourceURL ...
+func (a *NASCAR) LogoSourceURL() string {
+	return ""
+}
+
+// HTTPPathPrefix ...
+func (a *NASCAR) HTTPPathPrefix() string {
+	return "nascar"
+}
+
+// APIPath ...
+func (a *NASCAR) APIPath() string {
+	return "racing/nascar-premier"
+}
+
+// LogoAsset ...
+func (a *NASCAR) LogoAsset() string {
+	return "nascar.png"
+}
+
+// Xfinity is the NASCAR Xfinity Series
+type Xfinity struct{}
+
+// ShortName ...
+func (a *Xfinity) ShortName() string {
+	return "Xfinity"
+}
+
+// LogoSourceURL ...
+func (a *Xfinity) LogoSourceURL() string {
+	return ""
+}
+
+// HTTPPathPrefix ...
+func (a *Xfinity) HTTPPathPrefix() string {
+	return "xfinity"
+}
+
+// APIPath ...
+func (a *Xfinity) APIPath() string {
+	return "racing/nascar-secondary"
+}
+
+// LogoAsset ...
+func (a *Xfinity) LogoAsset() string {
+	return "xfinity.png"
+}
+
+// Truck is the NASCAR Craftsman Truck Series
+type Truck struct{}
+
+// ShortName ...
+func (a *Truck) ShortName() string {
+	return "Truck"
+}
+
+// LogoSourceURL ...
+func (a *Truck) LogoSourceURL() string {
+	return ""
+}
+
+// HTTPPathPrefix ...
+func (a *Truck) HTTPPathPrefix() string {
+	return "truck"
+}
+
+// APIPath ...
+func (a *Truck) APIPath() string {
+	return "racing/nascar-truck"
+}
+
+// LogoAsset ...
+func (a *Truck) LogoAsset() string {
+	return "truck.png"
+}
+
+// MotoGP ...
+type MotoGP struct{}
+
+// ShortName ...
+func (a *MotoGP) ShortName() string {
+	return "MotoGP"
+}
+
+// LogoSourceURL ...
+func (a *MotoGP) LogoSourceURL() string {
+	return ""
+}
+
+// HTTPPathPrefix ...
+func (a *MotoGP) HTTPPathPrefix() string {
+	return "motogp"
+}
+
+// APIPath ...
+func (a *MotoGP) APIPath() string {
+	return "racing/motogp"
+}
+
+// LogoAsset ...
+func (a *MotoGP) LogoAsset() string {
+	return "motogp.png"
+}
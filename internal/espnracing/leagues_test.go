@@ -0,0 +1,21 @@
+package espnracing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLeaguer(t *testing.T) {
+	t.Parallel()
+
+	for _, series := range []string{"f1", "irl", "nascar", "xfinity", "truck", "MotoGP"} {
+		l, err := GetLeaguer(series)
+		require.NoError(t, err)
+		_, err = assets.ReadFile("assets/" + l.LogoAsset())
+		require.NoError(t, err, "missing logo asset for %s", series)
+	}
+
+	_, err := GetLeaguer("nope")
+	require.Error(t, err)
+}
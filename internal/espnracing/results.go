@@ -0,0 +1,296 @@
+package espnracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	racingboard "github.com/robbydyer/sports/internal/board/racing"
+	"github.com/robbydyer/sports/internal/fetch"
+)
+
+const standingsURL = "http://site.api.espn.com/apis/v2/sports"
+
+// resultsRefresh is how old the schedule can be before it's fetched again for results. The
+// results of every event on the board come from one fetch of the schedule.
+const resultsRefresh = time.Minute
+
+type competitor struct {
+	ID      string `json:"id"`
+	Order   int    `json:"order"`
+	Winner  bool   `json:"winner"`
+	Athlete *struct {
+		DisplayName  string `json:"displayName"`
+		ShortName    string `json:"shortName"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"athlete"`
+	Vehicle *struct {
+		Manufacturer string `json:"manufacturer"`
+	} `json:"vehicle"`
+	Status *struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"status"`
+	Statistics []*stat `json:"statistics"`
+}
+
+type stat struct {
+	Name         string  `json:"name"`
+	Value        float64 `json:"value"`
+	DisplayValue string  `json:"displayValue"`
+}
+
+type standingsData struct {
+	Children []*struct {
+		Name         string `json:"name"`
+		Abbreviation string `json:"abbreviation"`
+		Standings    struct {
+			Entries []*struct {
+				Athlete *struct {
+					DisplayName  string `json:"displayName"`
+					Abbreviation string `json:"abbreviation"`
+				} `json:"athlete"`
+				Team *struct {
+					DisplayName      string `json:"displayName"`
+					ShortDisplayName string `json:"shortDisplayName"`
+					Abbreviation     string `json:"abbreviation"`
+				} `json:"team"`
+				Stats []*stat `json:"stats"`
+			} `json:"entries"`
+		} `json:"standings"`
+	} `json:"children"`
+}
+
+// GetEventResults gets the running order of a live session, or the results of the most
+// recently completed session of an event. The event's live status is updated.
+func (a *API) GetEventResults(ctx context.Context, event *racingboard.Event) ([]*racingboard.Result, error) {
+	sched, err := a.getSchedule(ctx, resultsRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	results := resultsForEvent(sched, event)
+
+	a.log.Debug("racing results",
+		zap.String("league", a.leaguer.ShortName()),
+		zap.String("event", event.Name),
+		zap.Bool("live", event.Live),
+		zap.Int("results", len(results)),
+	)
+
+	return results, nil
+}
+
+// resultsForEvent finds the event's live session or latest completed session in the scoreboard
+func resultsForEvent(sched *Scoreboard, event *racingboard.Event) []*racingboard.Result {
+	if sched == nil {
+		return nil
+	}
+
+	for _, e := range sched.Events {
+		if e.ID != event.ID {
+			continue
+		}
+
+		event.Live = e.Status.isLive()
+		event.Completed = e.Status.isComplete()
+		event.Status = ""
+
+		var session *competition
+		for _, c := range e.Competitions {
+			if c.Status.isLive() {
+				session = c
+				break
+			}
+			if c.Status.isComplete() {
+				session = c
+			}
+		}
+
+		if session == nil {
+			return nil
+		}
+
+		sessionName := ""
+		if session.Type != nil {
+			sessionName = session.Type.Abbreviation
+		}
+
+		switch {
+		case session.Status.isLive():
+			event.Live = true
+			event.Status = strings.TrimSpace(fmt.Sprintf("%s LIVE", sessionName))
+			if session.Status.Period > 0 {
+				event.Status = strings.TrimSpace(fmt.Sprintf("%s L%d", sessionName, session.Status.Period))
+			}
+		default:
+			event.Status = strings.TrimSpace(fmt.Sprintf("%s FINAL", sessionName))
+		}
+
+		results := []*racingboard.Result{}
+		for i, comp := range session.Competitors {
+			pos := comp.Order
+			if pos == 0 {
+				pos = i + 1
+			}
+			r := &racingboard.Result{
+				Position: pos,
+				Driver:   comp.abbreviation(),
+				Gap:      comp.gap(),
+				InPit:    comp.inPit(),
+			}
+			if comp.Vehicle != nil {
+				r.Team = comp.Vehicle.Manufacturer
+			}
+			results = append(results, r)
+		}
+
+		return results
+	}
+
+	return nil
+}
+
+func (c *competitor) abbreviation() string {
+	if c.Athlete == nil {
+		return c.ID
+	}
+	return driverAbbreviation(c.Athlete.Abbreviation, c.Athlete.DisplayName)
+}
+
+// gap returns the interval to the leader, or the leader's total time
+func (c *competitor) gap() string {
+	for _, name := range []string{"behindTime", "behindLaps", "totalTime"} {
+		for _, st := range c.Statistics {
+			if strings.EqualFold(st.Name, name) && st.DisplayValue != "" {
+				return st.DisplayValue
+			}
+		}
+	}
+
+	return ""
+}
+
+func (c *competitor) inPit() bool {
+	if c.Status == nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(c.Status.Name), "pit") ||
+		strings.Contains(strings.ToLower(c.Status.Description), "pit")
+}
+
+// driverAbbreviation uses the given abbreviation, or the first three letters of a driver's last name
+func driverAbbreviation(abbrev string, name string) string {
+	if abbrev != "" {
+		return abbrev
+	}
+	parts := strings.Fields(name)
+	if len(parts) < 1 {
+		return ""
+	}
+	last := []rune(strings.ToUpper(parts[len(parts)-1]))
+	if len(last) > 3 {
+		last = last[0:3]
+	}
+	return string(last)
+}
+
+// GetDriverStandings ...
+func (a *API) GetDriverStandings(ctx context.Context) ([]*racingboard.Standing, error) {
+	dat, err := a.standingsFromAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseStandings(dat, "driver")
+}
+
+// GetConstructorStandings ...
+func (a *API) GetConstructorStandings(ctx context.Context) ([]*racingboard.Standing, error) {
+	dat, err := a.standingsFromAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseStandings(dat, "constructor")
+}
+
+// parseStandings parses the standings group whose name contains the given kind, such as "driver"
+func parseStandings(dat []byte, kind string) ([]*racingboard.Standing, error) {
+	var d *standingsData
+	if err := json.Unmarshal(dat, &d); err != nil {
+		return nil, err
+	}
+
+	standings := []*racingboard.Standing{}
+
+	for _, child := range d.Children {
+		if !strings.Contains(strings.ToLower(child.Name), kind) {
+			continue
+		}
+
+		for i, entry := range child.Standings.Entries {
+			st := &racingboard.Standing{
+				Position: i + 1,
+			}
+
+			switch {
+			case entry.Athlete != nil:
+				st.Name = driverAbbreviation(entry.Athlete.Abbreviation, entry.Athlete.DisplayName)
+			case entry.Team != nil:
+				st.Name = entry.Team.Abbreviation
+				if st.Name == "" {
+					st.Name = entry.Team.ShortDisplayName
+				}
+				if st.Name == "" {
+					st.Name = entry.Team.DisplayName
+				}
+			}
+
+			for _, entryStat := range entry.Stats {
+				switch entryStat.Name {
+				case "rank":
+					if entryStat.Value > 0 {
+						st.Position = int(entryStat.Value)
+					}
+				case "championshipPts", "points":
+					st.Points = entryStat.DisplayValue
+				}
+			}
+
+			standings = append(standings, st)
+		}
+	}
+
+	return standings, nil
+}
+
+func (a *API) standingsFromAPI(ctx context.Context) ([]byte, error) {
+	uri, err := url.Parse(fmt.Sprintf("%s/%s/standings", standingsURL, a.leaguer.APIPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", uri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	client := fetch.Client()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return io.ReadAll(resp.Body)
+}
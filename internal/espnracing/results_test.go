@@ -0,0 +1,120 @@
+package espnracing
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	racingboard "github.com/robbydyer/sports/internal/board/racing"
+)
+
+func TestResultsForEvent(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`{"events": [{"id": "1", "status": {"type": {"state": "in", "completed": false}},
+		"competitions": [
+			{"id": "10", "type": {"abbreviation": "Qual"}, "status": {"type": {"state": "post", "completed": true}},
+				"competitors": [{"id": "a", "order": 1}]},
+			{"id": "11", "type": {"abbreviation": "Race"}, "status": {"period": 23, "type": {"state": "in", "completed": false}},
+				"competitors": [
+					{"id": "a", "order": 1, "athlete": {"displayName": "Max Verstappen", "abbreviation": "VER"},
+						"statistics": [{"name": "totalTime", "displayValue": "35:12.345"}]},
+					{"id": "b", "order": 2, "athlete": {"displayName": "Lando Norris"},
+						"statistics": [{"name": "behindTime", "displayValue": "+1.234"}], "status": {"name": "IN_PIT"}}
+				]}
+		]}]}`)
+
+	var sched *Scoreboard
+	require.NoError(t, json.Unmarshal(dat, &sched))
+
+	event := &racingboard.Event{ID: "1"}
+	results := resultsForEvent(sched, event)
+
+	require.True(t, event.Live)
+	require.Equal(t, "Race L23", event.Status)
+	require.Len(t, results, 2)
+	require.Equal(t, "VER", results[0].Driver)
+	require.Equal(t, "35:12.345", results[0].Gap)
+	require.False(t, results[0].InPit)
+	require.Equal(t, "NOR", results[1].Driver)
+	require.Equal(t, 2, results[1].Position)
+	require.Equal(t, "+1.234", results[1].Gap)
+	require.True(t, results[1].InPit)
+}
+
+func TestParseStandings(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`{"children": [
+		{"name": "Driver Standings", "standings": {"entries": [
+			{"athlete": {"displayName": "Max Verstappen", "abbreviation": "VER"},
+				"stats": [{"name": "rank", "value": 1}, {"name": "championshipPts", "value": 575, "displayValue": "575"}]}
+		]}},
+		{"name": "Constructor Standings", "standings": {"entries": [
+			{"team": {"displayName": "Red Bull"}, "stats": [{"name": "rank", "value": 1}, {"name": "points", "displayValue": "860"}]}
+		]}}
+	]}`)
+
+	tests := []struct {
+		kind   string
+		name   string
+		points string
+	}{
+		{
+			kind:   "driver",
+			name:   "VER",
+			points: "575",
+		},
+		{
+			kind:   "constructor",
+			name:   "Red Bull",
+			points: "860",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.kind, func(t *testing.T) {
+			t.Parallel()
+			standings, err := parseStandings(dat, test.kind)
+			require.NoError(t, err)
+			require.Len(t, standings, 1)
+			require.Equal(t, 1, standings[0].Position)
+			require.Equal(t, test.name, standings[0].Name)
+			require.Equal(t, test.points, standings[0].Points)
+		})
+	}
+}
+
+func TestGetEventResultsCached(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`{"events": [{"id": "1", "status": {"type": {"state": "post", "completed": true}},
+		"competitions": [
+			{"id": "10", "type": {"abbreviation": "Race"}, "status": {"type": {"state": "post", "completed": true}},
+				"competitors": [{"id": "a", "order": 1, "athlete": {"displayName": "Max Verstappen", "abbreviation": "VER"}}]}
+		]}]}`)
+
+	var sched *Scoreboard
+	require.NoError(t, json.Unmarshal(dat, &sched))
+
+	l, err := GetLeaguer("f1")
+	require.NoError(t, err)
+
+	a, err := New(l, zap.NewNop())
+	require.NoError(t, err)
+	a.schedule = sched
+	a.scheduleUpdated = time.Now()
+
+	// A recently fetched schedule is reused rather than fetched again
+	event := &racingboard.Event{ID: "1"}
+	results, err := a.GetEventResults(context.Background(), event)
+	require.NoError(t, err)
+	require.True(t, event.Completed)
+	require.Len(t, results, 1)
+	require.Equal(t, "VER", results[0].Driver)
+}
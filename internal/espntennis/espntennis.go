@@ -0,0 +1,142 @@
+package espntennis
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/assetlogo"
+	tennisboard "github.com/robbydyer/sports/internal/board/tennis"
+	"github.com/robbydyer/sports/internal/fetch"
+	"github.com/robbydyer/sports/internal/logo"
+)
+
+const baseURL = "http://site.api.espn.com/apis/site/v2/sports"
+
+// API ...
+type API struct {
+	leaguer Leaguer
+	log     *zap.Logger
+}
+
+// Leaguer ...
+type Leaguer interface {
+	ShortName() string
+	HTTPPathPrefix() string
+	APIPath() string
+}
+
+// New ...
+func New(leaguer Leaguer, log *zap.Logger) (*API, error) {
+	return &API{
+		leaguer: leaguer,
+		log:     log,
+	}, nil
+}
+
+// GetLeaguer returns the Leaguer for a tour by its HTTP path prefix, such as "atp"
+func GetLeaguer(tour string) (Leaguer, error) {
+	switch strings.Trim(strings.ToLower(tour), " ") {
+	case "atp":
+		return &ATP{}, nil
+	case "wta":
+		return &WTA{}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported tennis tour '%s'", tour)
+}
+
+// LeagueShortName ...
+func (a *API) LeagueShortName() string {
+	return a.leaguer.ShortName()
+}
+
+// HTTPPathPrefix ...
+func (a *API) HTTPPathPrefix() string {
+	return a.leaguer.HTTPPathPrefix()
+}
+
+// Stale implements board.StaleAPI
+func (a *API) Stale() bool {
+	return fetch.Stale(fmt.Sprintf("%s/%s/scoreboard", baseURL, a.leaguer.APIPath()))
+}
+
+// GetFlag gets a bundled country flag
+func (a *API) GetFlag(ctx context.Context, country string, bounds image.Rectangle) (*logo.Logo, error) {
+	return assetlogo.GetFlag(country, bounds)
+}
+
+// GetMatches gets the matches of all tournaments on today's scoreboard
+func (a *API) GetMatches(ctx context.Context) ([]*tennisboard.Match, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s/scoreboard", baseURL, a.leaguer.APIPath()), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	client := fetch.Client()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := parseScoreboard(body)
+	if err != nil {
+		return nil, err
+	}
+
+	a.log.Debug("tennis scoreboard",
+		zap.String("league", a.leaguer.ShortName()),
+		zap.Int("matches", len(matches)),
+	)
+
+	return matches, nil
+}
+
+// ATP ...
+type ATP struct{}
+
+// ShortName ...
+func (a *ATP) ShortName() string {
+	return "ATP"
+}
+
+// HTTPPathPrefix ...
+func (a *ATP) HTTPPathPrefix() string {
+	return "atp"
+}
+
+// APIPath ...
+func (a *ATP) APIPath() string {
+	return "tennis/atp"
+}
+
+// WTA ...
+type WTA struct{}
+
+// ShortName ...
+func (a *WTA) ShortName() string {
+	return "WTA"
+}
+
+// HTTPPathPrefix ...
+func (a *WTA) HTTPPathPrefix() string {
+	return "wta"
+}
+
+// APIPath ...
+func (a *WTA) APIPath() string {
+	return "tennis/wta"
+}
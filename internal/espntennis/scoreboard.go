@@ -0,0 +1,207 @@
+package espntennis
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	tennisboard "github.com/robbydyer/sports/internal/board/tennis"
+)
+
+var roundNumberReg = regexp.MustCompile(`([0-9]+)`)
+
+type scoreboard struct {
+	Events []*struct {
+		ID           string         `json:"id"`
+		Name         string         `json:"name"`
+		ShortName    string         `json:"shortName"`
+		Competitions []*competition `json:"competitions"`
+		Groupings    []*struct {
+			Grouping struct {
+				Slug        string `json:"slug"`
+				DisplayName string `json:"displayName"`
+			} `json:"grouping"`
+			Competitions []*competition `json:"competitions"`
+		} `json:"groupings"`
+	} `json:"events"`
+}
+
+type competition struct {
+	ID    string `json:"id"`
+	Date  string `json:"date"`
+	Round struct {
+		DisplayName string `json:"displayName"`
+	} `json:"round"`
+	Venue struct {
+		Court string `json:"court"`
+	} `json:"venue"`
+	Status struct {
+		Period int `json:"period"`
+		Type   struct {
+			State       string `json:"state"`
+			Completed   bool   `json:"completed"`
+			Description string `json:"description"`
+			ShortDetail string `json:"shortDetail"`
+		} `json:"type"`
+	} `json:"status"`
+	Competitors []*competitor `json:"competitors"`
+}
+
+type competitor struct {
+	ID         string   `json:"id"`
+	Order      int      `json:"order"`
+	Winner     bool     `json:"winner"`
+	Possession bool     `json:"possession"`
+	Points     string   `json:"points"`
+	Athlete    *athlete `json:"athlete"`
+	Roster     *struct {
+		ShortDisplayName string     `json:"shortDisplayName"`
+		DisplayName      string     `json:"displayName"`
+		Athletes         []*athlete `json:"athletes"`
+	} `json:"roster"`
+	CuratedRank *struct {
+		Current int `json:"current"`
+	} `json:"curatedRank"`
+	Linescores []*struct {
+		Value    float64 `json:"value"`
+		Tiebreak int     `json:"tiebreak"`
+	} `json:"linescores"`
+}
+
+type athlete struct {
+	DisplayName string `json:"displayName"`
+	Flag        *struct {
+		Href string `json:"href"`
+		Alt  string `json:"alt"`
+	} `json:"flag"`
+}
+
+func parseScoreboard(body []byte) ([]*tennisboard.Match, error) {
+	var sb *scoreboard
+	if err := json.Unmarshal(body, &sb); err != nil {
+		return nil, err
+	}
+
+	matches := []*tennisboard.Match{}
+
+	for _, event := range sb.Events {
+		tournament := event.ShortName
+		if tournament == "" {
+			tournament = event.Name
+		}
+
+		for _, c := range event.Competitions {
+			matches = append(matches, c.toMatch(tournament, false))
+		}
+
+		for _, g := range event.Groupings {
+			doubles := strings.Contains(strings.ToLower(g.Grouping.DisplayName), "doubles") ||
+				strings.Contains(strings.ToLower(g.Grouping.Slug), "doubles")
+			for _, c := range g.Competitions {
+				matches = append(matches, c.toMatch(tournament, doubles))
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func (c *competition) toMatch(tournament string, doubles bool) *tennisboard.Match {
+	m := &tennisboard.Match{
+		ID:         c.ID,
+		Tournament: tournament,
+		Round:      roundAbbreviation(c.Round.DisplayName),
+		Court:      c.Venue.Court,
+		Doubles:    doubles,
+		Live:       c.Status.Type.State == "in",
+		Completed:  c.Status.Type.Completed || c.Status.Type.State == "post",
+	}
+
+	if t, err := time.Parse("2006-01-02T15:04Z", c.Date); err == nil {
+		m.Start = t
+	}
+
+	desc := strings.ToLower(c.Status.Type.Description)
+	switch {
+	case m.Live && c.Status.Period > 0:
+		m.Status = fmt.Sprintf("Set %d", c.Status.Period)
+	case m.Live:
+		m.Status = c.Status.Type.ShortDetail
+	case strings.Contains(desc, "retire"):
+		m.Status = "Ret."
+	case strings.Contains(desc, "walkover"):
+		m.Status = "W/O"
+	case m.Completed:
+		m.Status = "Final"
+	}
+
+	for _, comp := range c.Competitors {
+		p := &tennisboard.Player{
+			ID:      comp.ID,
+			Winner:  comp.Winner,
+			Serving: comp.Possession,
+			Points:  comp.Points,
+		}
+
+		switch {
+		case comp.Athlete != nil:
+			p.Name = comp.Athlete.DisplayName
+			p.Country = comp.Athlete.country()
+		case comp.Roster != nil:
+			p.Name = comp.Roster.ShortDisplayName
+			if p.Name == "" {
+				p.Name = comp.Roster.DisplayName
+			}
+			if len(comp.Roster.Athletes) > 0 {
+				p.Country = comp.Roster.Athletes[0].country()
+			}
+		}
+
+		if comp.CuratedRank != nil && comp.CuratedRank.Current > 0 && comp.CuratedRank.Current < 99 {
+			p.Seed = fmt.Sprint(comp.CuratedRank.Current)
+		}
+
+		for _, ls := range comp.Linescores {
+			p.Sets = append(p.Sets, &tennisboard.Set{
+				Games:    int(ls.Value),
+				Tiebreak: ls.Tiebreak,
+			})
+		}
+
+		m.Players = append(m.Players, p)
+	}
+
+	return m
+}
+
+// country gets the flag country code from the flag image URL, such as ".../countries/500/esp.png"
+func (a *athlete) country() string {
+	if a.Flag == nil || a.Flag.Href == "" {
+		return ""
+	}
+	return strings.TrimSuffix(path.Base(a.Flag.Href), path.Ext(a.Flag.Href))
+}
+
+// roundAbbreviation shortens round names, such as "Quarterfinal" to "QF" and "Round 2" to "R2"
+func roundAbbreviation(round string) string {
+	r := strings.ToLower(round)
+	switch {
+	case strings.Contains(r, "qualif"):
+		return "Q"
+	case strings.Contains(r, "quarter"):
+		return "QF"
+	case strings.Contains(r, "semi"):
+		return "SF"
+	case strings.Contains(r, "final"):
+		return "F"
+	case strings.Contains(r, "round"):
+		if n := roundNumberReg.FindString(r); n != "" {
+			return "R" + n
+		}
+	}
+
+	return round
+}
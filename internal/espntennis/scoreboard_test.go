@@ -0,0 +1,90 @@
+package espntennis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const scoreboardJSON = `{
+  "events": [{
+    "id": "1",
+    "name": "Wimbledon",
+    "groupings": [
+      {
+        "grouping": {"slug": "mens-singles", "displayName": "Men's Singles"},
+        "competitions": [
+          {
+            "id": "10",
+            "date": "2025-07-09T12:30Z",
+            "round": {"displayName": "Quarterfinal"},
+            "status": {"period": 3, "type": {"state": "in", "completed": false}},
+            "competitors": [
+              {"id": "a", "order": 1, "possession": true, "points": "40", "athlete": {"displayName": "Novak Djokovic", "flag": {"href": "https://a.espncdn.com/i/teamlogos/countries/500/srb.png"}}, "linescores": [{"value": 6}, {"value": 6, "tiebreak": 7}, {"value": 2}]},
+              {"id": "b", "order": 2, "points": "15", "athlete": {"displayName": "Carlos Alcaraz", "flag": {"href": "https://a.espncdn.com/i/teamlogos/countries/500/esp.png"}}, "linescores": [{"value": 4}, {"value": 7, "tiebreak": 5}, {"value": 1}]}
+            ]
+          },
+          {
+            "id": "11",
+            "date": "2025-07-09T15:00Z",
+            "round": {"displayName": "Round 2"},
+            "status": {"type": {"state": "post", "completed": true, "description": "Retired"}},
+            "competitors": [
+              {"id": "c", "winner": true, "athlete": {"displayName": "Jannik Sinner"}},
+              {"id": "d", "athlete": {"displayName": "Taylor Fritz"}}
+            ]
+          }
+        ]
+      },
+      {
+        "grouping": {"slug": "mens-doubles", "displayName": "Men's Doubles"},
+        "competitions": [
+          {
+            "id": "12",
+            "round": {"displayName": "Final"},
+            "status": {"type": {"state": "pre"}},
+            "competitors": [
+              {"id": "e", "roster": {"shortDisplayName": "Ram / Salisbury", "athletes": [{"flag": {"href": "x/usa.png"}}]}},
+              {"id": "f", "roster": {"shortDisplayName": "Granollers / Zeballos"}}
+            ]
+          }
+        ]
+      }
+    ]
+  }]
+}`
+
+func TestParseScoreboard(t *testing.T) {
+	t.Parallel()
+
+	matches, err := parseScoreboard([]byte(scoreboardJSON))
+	require.NoError(t, err)
+	require.Len(t, matches, 3)
+
+	live := matches[0]
+	require.True(t, live.Live)
+	require.False(t, live.Completed)
+	require.Equal(t, "QF", live.Round)
+	require.Equal(t, "Set 3", live.Status)
+	require.Equal(t, "Wimbledon", live.Tournament)
+	require.Len(t, live.Players, 2)
+	require.Equal(t, "srb", live.Players[0].Country)
+	require.True(t, live.Players[0].Serving)
+	require.Equal(t, "40", live.Players[0].Points)
+	require.Len(t, live.Players[1].Sets, 3)
+	require.Equal(t, 7, live.Players[1].Sets[1].Games)
+	require.Equal(t, 5, live.Players[1].Sets[1].Tiebreak)
+
+	retired := matches[1]
+	require.True(t, retired.Completed)
+	require.Equal(t, "R2", retired.Round)
+	require.Equal(t, "Ret.", retired.Status)
+	require.True(t, retired.Players[0].Winner)
+
+	doubles := matches[2]
+	require.True(t, doubles.Doubles)
+	require.Equal(t, "F", doubles.Round)
+	require.Equal(t, "Ram / Salisbury", doubles.Players[0].Name)
+	require.Equal(t, "usa", doubles.Players[0].Country)
+	require.True(t, doubles.Start.IsZero())
+}
@@ -0,0 +1,210 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	// Register decoders for feed images
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	"go.uber.org/zap"
+
+	textboard "github.com/robbydyer/sports/internal/board/text"
+	"github.com/robbydyer/sports/internal/fetch"
+)
+
+const defaultUpdateInterval = 30 * time.Minute
+
+// Config for an RSS or Atom feed
+type Config struct {
+	// URL of the RSS or Atom feed
+	URL string `json:"url"`
+	// LogoURL is shown before each story. Defaults to the feed's own image, if it has one.
+	LogoURL string            `json:"logoURL"`
+	Board   *textboard.Config `json:"board"`
+	Timeout string            `json:"timeout"`
+	timeout time.Duration
+}
+
+// Feed is an RSS or Atom feed. It implements textboard.StoryAPI
+type Feed struct {
+	name       string
+	config     *Config
+	log        *zap.Logger
+	client     *http.Client
+	lastUpdate time.Time
+	stories    []*textboard.Story
+	feedLogo   string
+	logo       image.Image
+	sync.Mutex
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	c.timeout = 10 * time.Second
+	if c.Timeout != "" {
+		if d, err := time.ParseDuration(c.Timeout); err == nil {
+			c.timeout = d
+		}
+	}
+	if c.Board != nil {
+		c.Board.SetDefaults()
+	}
+}
+
+// New ...
+func New(name string, config *Config, logger *zap.Logger) (*Feed, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("feed '%s' is missing a url", name)
+	}
+
+	return &Feed{
+		name:   name,
+		config: config,
+		log:    logger,
+		client: &http.Client{
+			Timeout:   config.timeout,
+			Transport: fetch.Client().Transport,
+		},
+	}, nil
+}
+
+// HTTPPathPrefix ...
+func (f *Feed) HTTPPathPrefix() string {
+	return fmt.Sprintf("feed/%s", strings.ToLower(strings.ReplaceAll(f.name, " ", "")))
+}
+
+// Stale implements board.StaleAPI
+func (f *Feed) Stale() bool {
+	return fetch.Stale(f.config.URL)
+}
+
+// GetLogo ...
+func (f *Feed) GetLogo(ctx context.Context) (image.Image, error) {
+	f.Lock()
+	if f.logo != nil {
+		defer f.Unlock()
+		return f.logo, nil
+	}
+	f.Unlock()
+
+	logoURL := f.config.LogoURL
+	if logoURL == "" {
+		if _, err := f.GetStories(ctx); err != nil {
+			return nil, err
+		}
+		f.Lock()
+		logoURL = f.feedLogo
+		f.Unlock()
+	}
+	if logoURL == "" {
+		return nil, fmt.Errorf("feed '%s' has no logo", f.name)
+	}
+
+	img, err := f.getImage(ctx, logoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.logo = img
+
+	return f.logo, nil
+}
+
+// GetText ...
+func (f *Feed) GetText(ctx context.Context) ([]string, error) {
+	stories, err := f.GetStories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	texts := make([]string, 0, len(stories))
+	for _, s := range stories {
+		texts = append(texts, s.Headline)
+	}
+
+	return texts, nil
+}
+
+// GetStories gets the feed's items, newest first
+func (f *Feed) GetStories(ctx context.Context) ([]*textboard.Story, error) {
+	f.Lock()
+	if len(f.stories) > 0 && time.Since(f.lastUpdate) < defaultUpdateInterval {
+		defer f.Unlock()
+		return f.stories, nil
+	}
+	f.Unlock()
+
+	dat, err := f.get(ctx, f.config.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	stories, logo, err := parseFeed(dat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse feed '%s': %w", f.name, err)
+	}
+
+	f.log.Debug("updated feed",
+		zap.String("feed", f.name),
+		zap.Int("stories", len(stories)),
+	)
+
+	f.Lock()
+	defer f.Unlock()
+	f.stories = stories
+	f.feedLogo = logo
+	f.lastUpdate = time.Now()
+
+	return f.stories, nil
+}
+
+// GetStoryImage ...
+func (f *Feed) GetStoryImage(ctx context.Context, story *textboard.Story) (image.Image, error) {
+	return f.getImage(ctx, story.ImageURL)
+}
+
+func (f *Feed) getImage(ctx context.Context, url string) (image.Image, error) {
+	dat, err := f.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(dat))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image %s: %w", url, err)
+	}
+
+	return img, nil
+}
+
+func (f *Feed) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s: %s", url, resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,197 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	textboard "github.com/robbydyer/sports/internal/board/text"
+)
+
+var (
+	tagRegex   = regexp.MustCompile(`<[^>]*>`)
+	spaceRegex = regexp.MustCompile(`\s+`)
+	imgRegex   = regexp.MustCompile(`<img[^>]+src="([^"]+)"`)
+)
+
+// timeFormats are the date formats seen in RSS and Atom feeds
+var timeFormats = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+}
+
+type media struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rss struct {
+	Channel *struct {
+		Image *struct {
+			URL string `xml:"url"`
+		} `xml:"image"`
+		Items []*struct {
+			Title       string   `xml:"title"`
+			Description string   `xml:"description"`
+			PubDate     string   `xml:"pubDate"`
+			Categories  []string `xml:"category"`
+			Enclosure   *media   `xml:"enclosure"`
+			Thumbnail   *media   `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+			Content     []*media `xml:"http://search.yahoo.com/mrss/ content"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+type atom struct {
+	Logo    string `xml:"logo"`
+	Icon    string `xml:"icon"`
+	Entries []*struct {
+		Title     string `xml:"title"`
+		Summary   string `xml:"summary"`
+		Content   string `xml:"content"`
+		Published string `xml:"published"`
+		Updated   string `xml:"updated"`
+		Links     []*struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+			Type string `xml:"type,attr"`
+		} `xml:"link"`
+		Categories []*struct {
+			Term string `xml:"term,attr"`
+		} `xml:"category"`
+		Thumbnail *media `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+	} `xml:"entry"`
+}
+
+// parseFeed parses an RSS or Atom feed into stories, newest first, along with the feed's logo URL
+func parseFeed(dat []byte) ([]*textboard.Story, string, error) {
+	var root struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(dat, &root); err != nil {
+		return nil, "", err
+	}
+
+	var stories []*textboard.Story
+	var logo string
+
+	switch strings.ToLower(root.XMLName.Local) {
+	case "rss":
+		var r rss
+		if err := xml.Unmarshal(dat, &r); err != nil {
+			return nil, "", err
+		}
+		if r.Channel == nil {
+			return nil, "", fmt.Errorf("rss feed has no channel")
+		}
+		if r.Channel.Image != nil {
+			logo = r.Channel.Image.URL
+		}
+		for _, item := range r.Channel.Items {
+			story := &textboard.Story{
+				Headline:    cleanText(item.Title),
+				Description: cleanText(item.Description),
+				Published:   parseTime(item.PubDate),
+				Teams:       item.Categories,
+			}
+			switch {
+			case item.Thumbnail != nil:
+				story.ImageURL = item.Thumbnail.URL
+			case item.Enclosure != nil && strings.HasPrefix(item.Enclosure.Type, "image"):
+				story.ImageURL = item.Enclosure.URL
+			default:
+				for _, c := range item.Content {
+					if c.Type == "" || strings.HasPrefix(c.Type, "image") {
+						story.ImageURL = c.URL
+						break
+					}
+				}
+			}
+			if story.ImageURL == "" {
+				story.ImageURL = htmlImage(item.Description)
+			}
+			stories = append(stories, story)
+		}
+	case "feed":
+		var a atom
+		if err := xml.Unmarshal(dat, &a); err != nil {
+			return nil, "", err
+		}
+		logo = a.Logo
+		if logo == "" {
+			logo = a.Icon
+		}
+		for _, entry := range a.Entries {
+			summary := entry.Summary
+			if summary == "" {
+				summary = entry.Content
+			}
+			published := entry.Published
+			if published == "" {
+				published = entry.Updated
+			}
+			story := &textboard.Story{
+				Headline:    cleanText(entry.Title),
+				Description: cleanText(summary),
+				Published:   parseTime(published),
+			}
+			for _, c := range entry.Categories {
+				story.Teams = append(story.Teams, c.Term)
+			}
+			if entry.Thumbnail != nil {
+				story.ImageURL = entry.Thumbnail.URL
+			}
+			for _, l := range entry.Links {
+				if story.ImageURL == "" && l.Rel == "enclosure" && strings.HasPrefix(l.Type, "image") {
+					story.ImageURL = l.Href
+				}
+			}
+			if story.ImageURL == "" {
+				story.ImageURL = htmlImage(summary)
+			}
+			stories = append(stories, story)
+		}
+	default:
+		return nil, "", fmt.Errorf("unsupported feed type '%s'", root.XMLName.Local)
+	}
+
+	sort.SliceStable(stories, func(i, j int) bool {
+		return stories[i].Published.After(stories[j].Published)
+	})
+
+	return stories, logo, nil
+}
+
+// cleanText strips HTML tags and entities, and collapses whitespace
+func cleanText(s string) string {
+	s = tagRegex.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+	s = spaceRegex.ReplaceAllString(s, " ")
+	return strings.TrimSpace(s)
+}
+
+// htmlImage returns the source of the first image in an HTML description
+func htmlImage(s string) string {
+	if m := imgRegex.FindStringSubmatch(html.UnescapeString(s)); len(m) > 1 {
+		return m[1]
+	}
+	return ""
+}
+
+func parseTime(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, f := range timeFormats {
+		if t, err := time.Parse(f, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
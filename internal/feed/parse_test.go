@@ -0,0 +1,89 @@
+package feed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRSS(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+<channel>
+  <title>Team Blog</title>
+  <image><url>https://example.com/logo.png</url></image>
+  <item>
+    <title>Older &amp; wiser</title>
+    <description><![CDATA[<p>The <b>veteran</b> signs on.</p>]]></description>
+    <pubDate>Mon, 03 Apr 2023 10:00:00 +0000</pubDate>
+    <category>ATL</category>
+    <enclosure url="https://example.com/old.jpg" type="image/jpeg" length="1"/>
+  </item>
+  <item>
+    <title>Newest story</title>
+    <description><![CDATA[<img src="https://example.com/inline.jpg"/> Big win]]></description>
+    <pubDate>Tue, 04 Apr 2023 10:00:00 GMT</pubDate>
+  </item>
+  <item>
+    <title>Thumbnail story</title>
+    <pubDate>Sun, 02 Apr 2023 10:00:00 +0000</pubDate>
+    <media:thumbnail url="https://example.com/thumb.jpg"/>
+  </item>
+</channel>
+</rss>`)
+
+	stories, logo, err := parseFeed(dat)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/logo.png", logo)
+	require.Len(t, stories, 3)
+
+	require.Equal(t, "Newest story", stories[0].Headline)
+	require.Equal(t, "Big win", stories[0].Description)
+	require.Equal(t, "https://example.com/inline.jpg", stories[0].ImageURL)
+
+	require.Equal(t, "Older & wiser", stories[1].Headline)
+	require.Equal(t, "The veteran signs on.", stories[1].Description)
+	require.Equal(t, "https://example.com/old.jpg", stories[1].ImageURL)
+	require.Equal(t, []string{"ATL"}, stories[1].Teams)
+	require.Equal(t, time.Date(2023, 4, 3, 10, 0, 0, 0, time.UTC), stories[1].Published.UTC())
+
+	require.Equal(t, "https://example.com/thumb.jpg", stories[2].ImageURL)
+}
+
+func TestParseAtom(t *testing.T) {
+	t.Parallel()
+
+	dat := []byte(`<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Local News</title>
+  <icon>https://example.com/icon.png</icon>
+  <entry>
+    <title>Stadium vote passes</title>
+    <summary>The council approved it.</summary>
+    <updated>2023-04-05T12:00:00Z</updated>
+    <category term="Sports"/>
+    <link rel="alternate" href="https://example.com/story"/>
+    <link rel="enclosure" type="image/png" href="https://example.com/stadium.png"/>
+  </entry>
+</feed>`)
+
+	stories, logo, err := parseFeed(dat)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/icon.png", logo)
+	require.Len(t, stories, 1)
+	require.Equal(t, "Stadium vote passes", stories[0].Headline)
+	require.Equal(t, "The council approved it.", stories[0].Description)
+	require.Equal(t, "https://example.com/stadium.png", stories[0].ImageURL)
+	require.Equal(t, []string{"Sports"}, stories[0].Teams)
+	require.False(t, stories[0].Published.IsZero())
+}
+
+func TestParseUnsupported(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := parseFeed([]byte(`<html></html>`))
+	require.Error(t, err)
+}
@@ -0,0 +1,261 @@
+package fetch
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// pruneInterval is how often expired entries are removed from the cache
+	pruneInterval = time.Hour
+	// persistInterval is how often changed entries are written to disk
+	persistInterval = 5 * time.Minute
+)
+
+// entry is a cached response
+type entry struct {
+	URL          string      `json:"url"`
+	ETag         string      `json:"etag"`
+	LastModified string      `json:"lastModified"`
+	Header       http.Header `json:"header"`
+	Stored       time.Time   `json:"stored"`
+	Body         []byte      `json:"body"`
+}
+
+// cacheable determines if a response is API data worth serving when upstream is down. Images
+// and other media aren't cached, the logo caches already keep those.
+func cacheable(resp *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	switch mediaType {
+	case "application/json", "application/xml", "text/xml":
+		return true
+	}
+
+	// Such as application/rss+xml for feeds
+	return strings.HasSuffix(mediaType, "+json") || strings.HasSuffix(mediaType, "+xml")
+}
+
+func (e *entry) expired(maxStale time.Duration) bool {
+	return time.Since(e.Stored) > maxStale
+}
+
+// response builds a response to a request from the cached entry
+func (e *entry) response(req *http.Request, stale bool) *http.Response {
+	header := e.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	if stale {
+		header.Set(StaleHeader, e.Stored.Format(time.RFC3339))
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// cache keeps responses in memory. They're written to disk, one file per URL, every
+// persistInterval and on Flush, so they're still there after a restart.
+type cache struct {
+	dir       string
+	maxStale  time.Duration
+	entries   map[string]*entry
+	checked   map[string]struct{}
+	dirty     map[string]struct{}
+	lastFlush time.Time
+	lastPrune time.Time
+	sync.Mutex
+	// writeLock keeps flushes from writing at the same time
+	writeLock sync.Mutex
+}
+
+func newCache(dir string, maxStale time.Duration) *cache {
+	return &cache{
+		dir:       dir,
+		maxStale:  maxStale,
+		entries:   make(map[string]*entry),
+		checked:   make(map[string]struct{}),
+		dirty:     make(map[string]struct{}),
+		lastFlush: time.Now(),
+	}
+}
+
+func (c *cache) file(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// get returns the cached entry for a URL. A URL that isn't in memory is looked for on disk once,
+// in case it was cached before a restart.
+func (c *cache) get(url string) *entry {
+	c.Lock()
+	e, ok := c.entries[url]
+	_, checked := c.checked[url]
+	c.Unlock()
+	if ok || checked {
+		return e
+	}
+
+	e = c.read(url)
+
+	c.Lock()
+	defer c.Unlock()
+	c.checked[url] = struct{}{}
+	if existing, ok := c.entries[url]; ok {
+		return existing
+	}
+	if e != nil {
+		c.entries[url] = e
+	}
+
+	return e
+}
+
+func (c *cache) read(url string) *entry {
+	dat, err := os.ReadFile(c.file(url))
+	if err != nil {
+		return nil
+	}
+
+	e := &entry{}
+	if err := json.Unmarshal(dat, e); err != nil || e.URL != url {
+		return nil
+	}
+
+	return e
+}
+
+func (c *cache) put(url string, resp *http.Response, body []byte) {
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+	header.Del(StaleHeader)
+
+	c.set(&entry{
+		URL:          url,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Header:       header,
+		Stored:       time.Now(),
+		Body:         body,
+	})
+}
+
+// touch marks a cached entry as fresh after upstream revalidated it. Entries may be in use by
+// other requests, so a copy is stored.
+func (c *cache) touch(e *entry) {
+	fresh := *e
+	fresh.Stored = time.Now()
+	c.set(&fresh)
+}
+
+func (c *cache) set(e *entry) {
+	c.Lock()
+	defer c.Unlock()
+	c.entries[e.URL] = e
+	c.dirty[e.URL] = struct{}{}
+
+	if time.Since(c.lastFlush) >= persistInterval {
+		c.lastFlush = time.Now()
+		go c.flush()
+	}
+}
+
+// flush writes the entries that changed since the last flush to disk, and prunes expired entries
+func (c *cache) flush() {
+	c.Lock()
+	pending := make([]*entry, 0, len(c.dirty))
+	for url := range c.dirty {
+		if e, ok := c.entries[url]; ok {
+			pending = append(pending, e)
+		}
+	}
+	c.dirty = make(map[string]struct{})
+	c.lastFlush = time.Now()
+
+	prune := time.Since(c.lastPrune) >= pruneInterval
+	if prune {
+		c.lastPrune = time.Now()
+		for url, e := range c.entries {
+			if e.expired(c.maxStale) {
+				delete(c.entries, url)
+			}
+		}
+	}
+	c.Unlock()
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	if len(pending) > 0 {
+		if err := os.MkdirAll(c.dir, 0o755); err != nil {
+			return
+		}
+	}
+	for _, e := range pending {
+		c.write(e)
+	}
+
+	if prune {
+		c.prune()
+	}
+}
+
+func (c *cache) write(e *entry) {
+	dat, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+
+	// Write to a temp file first so a reader never sees a partial entry
+	f := c.file(e.URL)
+	tmp := f + ".tmp"
+	if err := os.WriteFile(tmp, dat, 0o600); err != nil {
+		return
+	}
+	if err := os.Rename(tmp, f); err != nil {
+		_ = os.Remove(tmp)
+	}
+}
+
+// prune removes files too old to be served
+func (c *cache) prune() {
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			continue
+		}
+		if time.Since(info.ModTime()) > c.maxStale {
+			_ = os.Remove(filepath.Join(c.dir, f.Name()))
+		}
+	}
+}
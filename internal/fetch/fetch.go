@@ -0,0 +1,189 @@
+package fetch
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/robbydyer/sports/internal/metrics"
+)
+
+// StaleHeader is set on responses served from the cache because upstream couldn't be reached
+const StaleHeader = "X-Sportsmatrix-Stale"
+
+// ErrOffline is returned for requests that aren't cached while in offline mode
+var ErrOffline = errors.New("offline mode: no cached response")
+
+// Defaults
+const (
+	DefaultTimeout    = 20 * time.Second
+	DefaultRetries    = 2
+	DefaultRetryDelay = 500 * time.Millisecond
+	DefaultRateLimit  = 5
+	DefaultCacheDir   = "/tmp/sportsmatrix/http"
+	DefaultMaxStale   = 24 * time.Hour
+)
+
+// Config configures the fetch layer shared by every data source
+type Config struct {
+	// Timeout of each attempt of a request. Defaults to 20s
+	Timeout string `json:"timeout"`
+	// Retries of a request that failed with a network error, a 5xx or a 429. Defaults to 2
+	Retries *int `json:"retries"`
+	// RetryDelay is the initial delay between retries. It doubles with each retry, with jitter.
+	// Defaults to 500ms
+	RetryDelay string `json:"retryDelay"`
+	// RateLimit is the maximum requests per second to a single host. Defaults to 5
+	RateLimit float64 `json:"rateLimit"`
+	// CacheDir is where API responses are persisted so they survive a restart. They're kept in
+	// memory and written every few minutes. Defaults to /tmp/sportsmatrix/http
+	CacheDir string `json:"cacheDir"`
+	// MaxStale is how old a cached response can be and still be shown when upstream is down.
+	// Defaults to 24h
+	MaxStale string `json:"maxStale"`
+	// Offline serves every request from the cache, without contacting upstream
+	Offline *atomic.Bool `json:"offline"`
+
+	timeout    time.Duration
+	retryDelay time.Duration
+	maxStale   time.Duration
+}
+
+// SetDefaults sets config defaults
+func (c *Config) SetDefaults() error {
+	var err error
+	c.timeout, err = parseDuration(c.Timeout, DefaultTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout: %w", err)
+	}
+	c.retryDelay, err = parseDuration(c.RetryDelay, DefaultRetryDelay)
+	if err != nil {
+		return fmt.Errorf("invalid retryDelay: %w", err)
+	}
+	c.maxStale, err = parseDuration(c.MaxStale, DefaultMaxStale)
+	if err != nil {
+		return fmt.Errorf("invalid maxStale: %w", err)
+	}
+
+	if c.Retries == nil {
+		r := DefaultRetries
+		c.Retries = &r
+	}
+	if c.RateLimit <= 0 {
+		c.RateLimit = DefaultRateLimit
+	}
+	if c.CacheDir == "" {
+		c.CacheDir = DefaultCacheDir
+	}
+	if c.Offline == nil {
+		c.Offline = atomic.NewBool(false)
+	}
+
+	return nil
+}
+
+func parseDuration(s string, def time.Duration) (time.Duration, error) {
+	if s == "" {
+		return def, nil
+	}
+	return time.ParseDuration(s)
+}
+
+var (
+	client     *http.Client
+	transport  *Transport
+	clientLock sync.RWMutex
+)
+
+func init() {
+	conf := &Config{}
+	_ = conf.SetDefaults()
+	transport = NewTransport(conf, metrics.NewTransport(nil))
+	client = &http.Client{
+		Transport: transport,
+	}
+}
+
+// Configure sets up the shared client used by every data source
+func Configure(conf *Config) error {
+	if conf == nil {
+		conf = &Config{}
+	}
+	if err := conf.SetDefaults(); err != nil {
+		return err
+	}
+
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	transport = NewTransport(conf, metrics.NewTransport(nil))
+	client = &http.Client{
+		Transport: transport,
+	}
+
+	return nil
+}
+
+// Client returns the shared client used by every data source
+func Client() *http.Client {
+	clientLock.RLock()
+	defer clientLock.RUnlock()
+	return client
+}
+
+// Flush writes cached responses to disk, so they can be served after a restart. Responses are
+// also written periodically, but call it at shutdown so the latest are kept.
+func Flush() {
+	clientLock.RLock()
+	t := transport
+	clientLock.RUnlock()
+	t.cache.flush()
+}
+
+// SetOffline turns offline mode on or off. In offline mode every request is served from the cache.
+func SetOffline(on bool) {
+	clientLock.RLock()
+	defer clientLock.RUnlock()
+	transport.config.Offline.Store(on)
+}
+
+// Offline determines if offline mode is on
+func Offline() bool {
+	clientLock.RLock()
+	defer clientLock.RUnlock()
+	return transport.config.Offline.Load()
+}
+
+var (
+	stale     = make(map[string]time.Time)
+	staleLock sync.RWMutex
+)
+
+func setStale(url string, stored time.Time) {
+	staleLock.Lock()
+	defer staleLock.Unlock()
+	stale[url] = stored
+}
+
+func clearStale(url string) {
+	staleLock.Lock()
+	defer staleLock.Unlock()
+	delete(stale, url)
+}
+
+// Stale determines if any response for URLs starting with a prefix was last served from the cache
+// because upstream couldn't be reached. Data sources use it to mark their boards as stale.
+func Stale(prefix string) bool {
+	staleLock.RLock()
+	defer staleLock.RUnlock()
+	for url := range stale {
+		if strings.HasPrefix(url, prefix) {
+			return true
+		}
+	}
+	return false
+}
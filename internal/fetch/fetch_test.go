@@ -0,0 +1,246 @@
+package fetch
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func testClient(t *testing.T, retries int) (*http.Client, *Config) {
+	t.Helper()
+	conf := &Config{
+		Retries:    &retries,
+		RetryDelay: "1ms",
+		RateLimit:  1000,
+		CacheDir:   t.TempDir(),
+	}
+	require.NoError(t, conf.SetDefaults())
+
+	return &http.Client{Transport: NewTransport(conf, nil)}, conf
+}
+
+func get(t *testing.T, client *http.Client, url string) (*http.Response, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func TestRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		failures int
+		retries  int
+		status   int
+		calls    int
+	}{
+		{name: "success", failures: 0, retries: 2, status: http.StatusOK, calls: 1},
+		{name: "recovers", failures: 2, retries: 2, status: http.StatusOK, calls: 3},
+		{name: "gives up", failures: 5, retries: 2, status: http.StatusBadGateway, calls: 3},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			calls := atomic.NewInt32(0)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if int(calls.Inc()) <= test.failures {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				_, _ = w.Write([]byte("ok"))
+			}))
+			defer server.Close()
+
+			client, _ := testClient(t, test.retries)
+			resp, _ := get(t, client, server.URL)
+			require.Equal(t, test.status, resp.StatusCode)
+			require.Equal(t, test.calls, int(calls.Load()))
+		})
+	}
+}
+
+func TestConditional(t *testing.T) {
+	t.Parallel()
+
+	calls := atomic.NewInt32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Inc()
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("scores"))
+	}))
+	defer server.Close()
+
+	client, _ := testClient(t, 0)
+
+	for i := 0; i < 2; i++ {
+		resp, body := get(t, client, server.URL)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "scores", body)
+		require.Empty(t, resp.Header.Get(StaleHeader))
+	}
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestStale(t *testing.T) {
+	t.Parallel()
+
+	down := atomic.NewBool(false)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("scores"))
+	}))
+	defer server.Close()
+
+	client, conf := testClient(t, 1)
+	url := server.URL + "/scoreboard"
+
+	_, body := get(t, client, url)
+	require.Equal(t, "scores", body)
+	require.False(t, Stale(server.URL))
+
+	down.Store(true)
+	resp, body := get(t, client, url)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "scores", body)
+	require.NotEmpty(t, resp.Header.Get(StaleHeader))
+	require.True(t, Stale(server.URL))
+
+	down.Store(false)
+	_, _ = get(t, client, url)
+	require.False(t, Stale(server.URL))
+
+	// Too old to serve
+	conf.maxStale = time.Nanosecond
+	down.Store(true)
+	resp, _ = get(t, client, url)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
+
+func TestOffline(t *testing.T) {
+	t.Parallel()
+
+	calls := atomic.NewInt32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Inc()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("scores"))
+	}))
+	defer server.Close()
+
+	client, conf := testClient(t, 0)
+	_, _ = get(t, client, server.URL+"/cached")
+
+	conf.Offline.Store(true)
+
+	resp, body := get(t, client, server.URL+"/cached")
+	require.Equal(t, "scores", body)
+	require.NotEmpty(t, resp.Header.Get(StaleHeader))
+	require.True(t, Stale(server.URL))
+
+	_, err := client.Get(server.URL + "/uncached")
+	require.True(t, errors.Is(err, ErrOffline))
+
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestCacheable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		contentType string
+		expected    bool
+	}{
+		{contentType: "application/json", expected: true},
+		{contentType: "application/json; charset=utf-8", expected: true},
+		{contentType: "application/vnd.api+json", expected: true},
+		{contentType: "application/rss+xml", expected: true},
+		{contentType: "text/xml", expected: true},
+		{contentType: "image/png", expected: false},
+		{contentType: "text/html", expected: false},
+		{contentType: "", expected: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.contentType, func(t *testing.T) {
+			t.Parallel()
+			resp := &http.Response{Header: http.Header{}}
+			resp.Header.Set("Content-Type", test.contentType)
+			require.Equal(t, test.expected, cacheable(resp))
+		})
+	}
+}
+
+func TestImagesNotCached(t *testing.T) {
+	t.Parallel()
+
+	down := atomic.NewBool(false)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("logo"))
+	}))
+	defer server.Close()
+
+	client, _ := testClient(t, 0)
+	_, body := get(t, client, server.URL+"/logo.png")
+	require.Equal(t, "logo", body)
+
+	down.Store(true)
+	resp, _ := get(t, client, server.URL+"/logo.png")
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
+
+func TestPersist(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("scores"))
+	}))
+	defer server.Close()
+
+	client, conf := testClient(t, 0)
+	transport, ok := client.Transport.(*Transport)
+	require.True(t, ok)
+	_, _ = get(t, client, server.URL+"/persist")
+
+	// Responses stay in memory until they're flushed
+	files, err := os.ReadDir(conf.CacheDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	transport.cache.flush()
+
+	restarted := &http.Client{Transport: NewTransport(conf, nil)}
+	conf.Offline.Store(true)
+	resp, body := get(t, restarted, server.URL+"/persist")
+	require.Equal(t, "scores", body)
+	require.NotEmpty(t, resp.Header.Get(StaleHeader))
+}
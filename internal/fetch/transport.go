@@ -0,0 +1,207 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxRetryDelay caps the backoff between retries
+const maxRetryDelay = 30 * time.Second
+
+// Transport is an http.RoundTripper that retries failed requests with jittered backoff, limits
+// the rate of requests to each host, revalidates cached API responses with ETag/If-Modified-Since
+// and serves stale cached API responses when upstream can't be reached
+type Transport struct {
+	config   *Config
+	base     http.RoundTripper
+	cache    *cache
+	limiters map[string]*limiter
+	sync.Mutex
+}
+
+// NewTransport wraps an http.RoundTripper. The Config must have its defaults set.
+func NewTransport(conf *Config, base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{
+		config:   conf,
+		base:     base,
+		cache:    newCache(conf.CacheDir, conf.maxStale),
+		limiters: make(map[string]*limiter),
+	}
+}
+
+// RoundTrip ...
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.base.RoundTrip(req)
+	}
+
+	url := req.URL.String()
+	cached := t.cache.get(url)
+
+	if t.config.Offline.Load() {
+		if cached == nil {
+			return nil, fmt.Errorf("%s: %w", url, ErrOffline)
+		}
+		setStale(url, cached.Stored)
+		return cached.response(req, true), nil
+	}
+
+	var lastErr error
+	var lastResp *http.Response
+
+	for attempt := 0; attempt <= *t.config.Retries; attempt++ {
+		if attempt > 0 {
+			if err := sleep(req.Context(), t.backoff(attempt, lastResp)); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := t.limiter(req.URL.Host).wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		resp, body, err := t.do(req, cached)
+		if err != nil {
+			if errors.Is(req.Context().Err(), context.Canceled) {
+				return nil, err
+			}
+			lastErr, lastResp = err, nil
+			continue
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusNotModified && cached != nil:
+			t.cache.touch(cached)
+			clearStale(url)
+			return cached.response(req, false), nil
+		case retryable(resp.StatusCode):
+			lastErr, lastResp = fmt.Errorf("%s: %s", url, resp.Status), resp
+			continue
+		case resp.StatusCode == http.StatusOK:
+			if cacheable(resp) {
+				t.cache.put(url, resp, body)
+			}
+			clearStale(url)
+		}
+
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return resp, nil
+	}
+
+	if cached != nil && !cached.expired(t.config.maxStale) {
+		setStale(url, cached.Stored)
+		return cached.response(req, true), nil
+	}
+
+	if lastResp != nil {
+		lastResp.Body = io.NopCloser(bytes.NewReader(nil))
+		return lastResp, nil
+	}
+
+	return nil, lastErr
+}
+
+// do makes one attempt of a request, reading the whole body within the attempt's timeout
+func (t *Transport) do(req *http.Request, cached *entry) (*http.Response, []byte, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.config.timeout)
+	defer cancel()
+
+	r := req.Clone(ctx)
+	if cached != nil && r.Header.Get("If-None-Match") == "" && r.Header.Get("If-Modified-Since") == "" {
+		if cached.ETag != "" {
+			r.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			r.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, body, nil
+}
+
+func retryable(code int) bool {
+	return code >= http.StatusInternalServerError || code == http.StatusTooManyRequests
+}
+
+// backoff returns the delay before a retry: the retry delay doubled for each attempt, with
+// jitter, or the upstream's Retry-After
+func (t *Transport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs > 0 {
+			return minDuration(time.Duration(secs)*time.Second, maxRetryDelay)
+		}
+	}
+
+	d := t.config.retryDelay << (attempt - 1)
+	d = d/2 + time.Duration(rand.Int63n(int64(d)+1)) //nolint:gosec
+	return minDuration(d, maxRetryDelay)
+}
+
+func minDuration(a time.Duration, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+func (t *Transport) limiter(host string) *limiter {
+	t.Lock()
+	defer t.Unlock()
+	l, ok := t.limiters[host]
+	if !ok {
+		l = &limiter{interval: time.Duration(float64(time.Second) / t.config.RateLimit)}
+		t.limiters[host] = l
+	}
+	return l
+}
+
+// limiter spaces out requests to a host
+type limiter struct {
+	interval time.Duration
+	next     time.Time
+	sync.Mutex
+}
+
+func (l *limiter) wait(ctx context.Context) error {
+	l.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.Unlock()
+
+	return sleep(ctx, time.Until(at))
+}
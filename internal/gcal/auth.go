@@ -7,6 +7,8 @@ import (
 	"os"
 
 	"golang.org/x/oauth2"
+
+	"github.com/robbydyer/sports/internal/metrics"
 )
 
 const (
@@ -22,5 +24,10 @@ func getClient(config *oauth2.Config) (*http.Client, error) {
 	defer f.Close()
 	tok := &oauth2.Token{}
 	err = json.NewDecoder(f).Decode(tok)
-	return config.Client(context.Background(), tok), err
+
+	// Calendar and token requests are recorded in the upstream metrics
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{
+		Transport: metrics.NewTransport(nil),
+	})
+	return config.Client(ctx, tok), err
 }
@@ -0,0 +1,252 @@
+package locale
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	// Zone data is embedded so that time zones work on images without a zoneinfo database
+	_ "time/tzdata"
+
+	"go.uber.org/atomic"
+)
+
+// Date formats
+const (
+	MonthDayYear = "mdy"
+	DayMonthYear = "dmy"
+	YearMonthDay = "ymd"
+)
+
+// Config is the locale used by all boards to display times, dates and labels
+type Config struct {
+	// TimeZone is an IANA time zone name, ie. "America/New_York". Defaults to the host's time zone
+	TimeZone string `json:"timeZone"`
+	// SecondaryTimeZone is shown alongside the time on boards that have room for it
+	SecondaryTimeZone string `json:"secondaryTimeZone"`
+	// Enable24Hour shows times in 24 hour format. Board's enable24Hour settings override this
+	Enable24Hour *atomic.Bool `json:"enable24Hour"`
+	// DateFormat is one of mdy, dmy or ymd. Defaults to mdy
+	DateFormat string `json:"dateFormat"`
+	// Language of labels such as FINAL and PPD, ie. "es". Defaults to English
+	Language string `json:"language"`
+	// Labels overrides the text of individual labels, keyed by their English text
+	Labels map[string]string `json:"labels"`
+}
+
+// Locale formats times, dates and labels
+type Locale struct {
+	location   *time.Location
+	secondary  *time.Location
+	hour24     bool
+	dateFormat string
+	language   string
+	labels     map[string]string
+}
+
+var (
+	current = &Locale{
+		location:   time.Local,
+		dateFormat: MonthDayYear,
+		language:   English,
+	}
+	currentLock sync.RWMutex
+)
+
+// New creates a Locale from a Config
+func New(conf *Config) (*Locale, error) {
+	l := &Locale{
+		location:   time.Local,
+		dateFormat: MonthDayYear,
+		language:   English,
+		labels:     make(map[string]string),
+	}
+	if conf == nil {
+		return l, nil
+	}
+
+	if conf.TimeZone != "" {
+		loc, err := time.LoadLocation(conf.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone '%s': %w", conf.TimeZone, err)
+		}
+		l.location = loc
+	}
+	if conf.SecondaryTimeZone != "" {
+		loc, err := time.LoadLocation(conf.SecondaryTimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secondary time zone '%s': %w", conf.SecondaryTimeZone, err)
+		}
+		l.secondary = loc
+	}
+	if conf.Enable24Hour != nil {
+		l.hour24 = conf.Enable24Hour.Load()
+	}
+
+	switch strings.ToLower(conf.DateFormat) {
+	case "", MonthDayYear:
+	case DayMonthYear, YearMonthDay:
+		l.dateFormat = strings.ToLower(conf.DateFormat)
+	default:
+		return nil, fmt.Errorf("invalid date format '%s', must be one of %s, %s or %s", conf.DateFormat, MonthDayYear, DayMonthYear, YearMonthDay)
+	}
+
+	if conf.Language != "" {
+		lang := strings.ToLower(conf.Language)
+		if _, ok := translations[lang]; !ok && lang != English {
+			return nil, fmt.Errorf("unsupported language '%s'", conf.Language)
+		}
+		l.language = lang
+	}
+	for k, v := range conf.Labels {
+		l.labels[k] = v
+	}
+
+	return l, nil
+}
+
+// Set sets the locale used by all boards
+func Set(l *Locale) {
+	currentLock.Lock()
+	defer currentLock.Unlock()
+	current = l
+}
+
+// Current returns the locale used by all boards
+func Current() *Locale {
+	currentLock.RLock()
+	defer currentLock.RUnlock()
+	return current
+}
+
+// Location is the time zone of the current locale
+func Location() *time.Location {
+	return Current().Location()
+}
+
+// With24Hour returns a copy of the locale with a board's 24 hour setting, or the locale itself if
+// the board doesn't have one
+func (l *Locale) With24Hour(enable24Hour *atomic.Bool) *Locale {
+	if enable24Hour == nil {
+		return l
+	}
+	c := *l
+	c.hour24 = enable24Hour.Load()
+	return &c
+}
+
+// Location is the locale's time zone
+func (l *Locale) Location() *time.Location {
+	return l.location
+}
+
+// Now is the current time in the locale's time zone
+func (l *Locale) Now() time.Time {
+	return time.Now().In(l.location)
+}
+
+// In converts a time to the locale's time zone
+func (l *Locale) In(t time.Time) time.Time {
+	return t.In(l.location)
+}
+
+// Is24Hour ...
+func (l *Locale) Is24Hour() bool {
+	return l.hour24
+}
+
+// Time formats the time of day, ie. 3:04PM or 15:04
+func (l *Locale) Time(t time.Time) string {
+	return formatTime(t.In(l.location), l.hour24)
+}
+
+// TimeZone formats the time of day followed by the zone, ie. 3:04PM EST
+func (l *Locale) TimeZone(t time.Time) string {
+	t = t.In(l.location)
+	zone, _ := t.Zone()
+	return fmt.Sprintf("%s %s", formatTime(t, l.hour24), zone)
+}
+
+// SecondaryTime formats the time of day and zone in the secondary time zone. It returns false if there
+// is no secondary time zone.
+func (l *Locale) SecondaryTime(t time.Time) (string, bool) {
+	if l.secondary == nil {
+		return "", false
+	}
+	t = t.In(l.secondary)
+	zone, _ := t.Zone()
+	return fmt.Sprintf("%s %s", formatTime(t, l.hour24), zone), true
+}
+
+func formatTime(t time.Time, hour24 bool) string {
+	if hour24 {
+		return t.Format("15:04")
+	}
+	return t.Format("3:04PM")
+}
+
+// ShortDate formats the month and day, ie. 01/02, 02/01 or 01-02
+func (l *Locale) ShortDate(t time.Time) string {
+	t = t.In(l.location)
+	switch l.dateFormat {
+	case DayMonthYear:
+		return t.Format("02/01")
+	case YearMonthDay:
+		return t.Format("01-02")
+	}
+	return t.Format("01/02")
+}
+
+// Date formats the full date, ie. 01/02/2006, 02/01/2006 or 2006-01-02
+func (l *Locale) Date(t time.Time) string {
+	t = t.In(l.location)
+	switch l.dateFormat {
+	case DayMonthYear:
+		return t.Format("02/01/2006")
+	case YearMonthDay:
+		return t.Format("2006-01-02")
+	}
+	return t.Format("01/02/2006")
+}
+
+// DayDate formats the weekday and date, ie. Mon Jan 2 or Mon 2 Jan
+func (l *Locale) DayDate(t time.Time) string {
+	t = t.In(l.location)
+	day := l.Label(t.Weekday().String()[:3])
+	month := l.Label(t.Month().String()[:3])
+	switch l.dateFormat {
+	case DayMonthYear:
+		return fmt.Sprintf("%s %d %s", day, t.Day(), month)
+	case YearMonthDay:
+		return fmt.Sprintf("%s %s", day, t.Format("01-02"))
+	}
+	return fmt.Sprintf("%s %s %d", day, month, t.Day())
+}
+
+// IsToday determines if a time is on the current day in the locale's time zone
+func (l *Locale) IsToday(t time.Time) bool {
+	y1, m1, d1 := t.In(l.location).Date()
+	y2, m2, d2 := l.Now().Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// Label translates a label, such as "FINAL" or "PPD". Labels without a translation are returned as is.
+func (l *Locale) Label(text string) string {
+	if v, ok := l.labels[text]; ok {
+		return v
+	}
+	if v, ok := translations[l.language][text]; ok {
+		return v
+	}
+	return text
+}
+
+// Labels translates a list of labels
+func (l *Locale) Labels(text ...string) []string {
+	out := make([]string, len(text))
+	for i, t := range text {
+		out[i] = l.Label(t)
+	}
+	return out
+}
@@ -0,0 +1,127 @@
+package locale
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	tm := time.Date(2023, time.March, 7, 19, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		conf      *Config
+		time      string
+		timeZone  string
+		shortDate string
+		date      string
+		dayDate   string
+	}{
+		{
+			name:      "default",
+			conf:      &Config{TimeZone: "UTC"},
+			time:      "7:05PM",
+			timeZone:  "7:05PM UTC",
+			shortDate: "03/07",
+			date:      "03/07/2023",
+			dayDate:   "Tue Mar 7",
+		},
+		{
+			name:      "european",
+			conf:      &Config{TimeZone: "Europe/Berlin", Enable24Hour: atomic.NewBool(true), DateFormat: "dmy", Language: "de"},
+			time:      "20:05",
+			timeZone:  "20:05 CET",
+			shortDate: "07/03",
+			date:      "07/03/2023",
+			dayDate:   "Di 7 Mär",
+		},
+		{
+			name:      "iso",
+			conf:      &Config{TimeZone: "America/New_York", DateFormat: "YMD"},
+			time:      "2:05PM",
+			timeZone:  "2:05PM EST",
+			shortDate: "03-07",
+			date:      "2023-03-07",
+			dayDate:   "Tue 03-07",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			l, err := New(test.conf)
+			require.NoError(t, err)
+			require.Equal(t, test.time, l.Time(tm))
+			require.Equal(t, test.timeZone, l.TimeZone(tm))
+			require.Equal(t, test.shortDate, l.ShortDate(tm))
+			require.Equal(t, test.date, l.Date(tm))
+			require.Equal(t, test.dayDate, l.DayDate(tm))
+		})
+	}
+}
+
+func TestSecondaryTime(t *testing.T) {
+	t.Parallel()
+
+	tm := time.Date(2023, time.July, 7, 19, 5, 0, 0, time.UTC)
+
+	l, err := New(&Config{TimeZone: "America/Chicago"})
+	require.NoError(t, err)
+	_, ok := l.SecondaryTime(tm)
+	require.False(t, ok)
+
+	l, err = New(&Config{TimeZone: "America/Chicago", SecondaryTimeZone: "Europe/London"})
+	require.NoError(t, err)
+	secondary, ok := l.SecondaryTime(tm)
+	require.True(t, ok)
+	require.Equal(t, "8:05PM BST", secondary)
+
+	secondary, _ = l.With24Hour(atomic.NewBool(true)).SecondaryTime(tm)
+	require.Equal(t, "20:05 BST", secondary)
+	require.Equal(t, "2:05PM", l.With24Hour(nil).Time(tm))
+}
+
+func TestLabel(t *testing.T) {
+	t.Parallel()
+
+	l, err := New(nil)
+	require.NoError(t, err)
+	require.Equal(t, "FINAL", l.Label("FINAL"))
+
+	l, err = New(&Config{Language: "es", Labels: map[string]string{"PPD": "POSP"}})
+	require.NoError(t, err)
+	require.Equal(t, "POSP", l.Label("PPD"))
+	require.Equal(t, []string{"Sin", "Juegos", "Hoy"}, l.Labels("No", "Games", "Today"))
+	require.Equal(t, "2nd", l.Label("2nd"))
+}
+
+func TestInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, conf := range []*Config{
+		{TimeZone: "Mars/Olympus_Mons"},
+		{SecondaryTimeZone: "nope"},
+		{DateFormat: "yyyy"},
+		{Language: "xx"},
+	} {
+		_, err := New(conf)
+		require.Error(t, err)
+	}
+}
+
+func TestIsToday(t *testing.T) {
+	t.Parallel()
+
+	l, err := New(&Config{TimeZone: "Pacific/Auckland"})
+	require.NoError(t, err)
+
+	now := time.Now()
+	require.True(t, l.IsToday(now))
+	require.False(t, l.IsToday(now.AddDate(0, 0, 1)))
+}
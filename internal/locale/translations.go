@@ -0,0 +1,179 @@
+package locale
+
+// English is the language labels are written in
+const English = "en"
+
+// translations of labels, keyed by language and the English label. Labels are kept short to fit on
+// small matrices.
+var translations = map[string]map[string]string{
+	"es": {
+		"FINAL":     "FINAL",
+		"Final":     "Final",
+		"PPD":       "APLZ",
+		"Postponed": "Aplazado",
+		"OT":        "TE",
+		"SO":        "PEN",
+		"HALF":      "DESC",
+		"Half":      "Desc",
+		"END":       "FIN",
+		"No":        "Sin",
+		"Games":     "Juegos",
+		"Today":     "Hoy",
+		"Live":      "Vivo",
+		"Mon":       "Lun",
+		"Tue":       "Mar",
+		"Wed":       "Mié",
+		"Thu":       "Jue",
+		"Fri":       "Vie",
+		"Sat":       "Sáb",
+		"Sun":       "Dom",
+		"Jan":       "Ene",
+		"Feb":       "Feb",
+		"Mar":       "Mar",
+		"Apr":       "Abr",
+		"May":       "May",
+		"Jun":       "Jun",
+		"Jul":       "Jul",
+		"Aug":       "Ago",
+		"Sep":       "Sep",
+		"Oct":       "Oct",
+		"Nov":       "Nov",
+		"Dec":       "Dic",
+	},
+	"fr": {
+		"FINAL":     "FIN",
+		"Final":     "Fin",
+		"PPD":       "REP",
+		"Postponed": "Reporté",
+		"OT":        "PROL",
+		"SO":        "TAB",
+		"HALF":      "MI-T",
+		"Half":      "Mi-t",
+		"END":       "FIN",
+		"No":        "Aucun",
+		"Games":     "Match",
+		"Today":     "Auj.",
+		"Live":      "Direct",
+		"Mon":       "Lun",
+		"Tue":       "Mar",
+		"Wed":       "Mer",
+		"Thu":       "Jeu",
+		"Fri":       "Ven",
+		"Sat":       "Sam",
+		"Sun":       "Dim",
+		"Jan":       "Jan",
+		"Feb":       "Fév",
+		"Mar":       "Mar",
+		"Apr":       "Avr",
+		"May":       "Mai",
+		"Jun":       "Juin",
+		"Jul":       "Juil",
+		"Aug":       "Août",
+		"Sep":       "Sep",
+		"Oct":       "Oct",
+		"Nov":       "Nov",
+		"Dec":       "Déc",
+	},
+	"de": {
+		"FINAL":     "ENDE",
+		"Final":     "Ende",
+		"PPD":       "VERS",
+		"Postponed": "Verschoben",
+		"OT":        "VERL",
+		"SO":        "PS",
+		"HALF":      "HZ",
+		"Half":      "HZ",
+		"END":       "ENDE",
+		"No":        "Keine",
+		"Games":     "Spiele",
+		"Today":     "Heute",
+		"Live":      "Live",
+		"Mon":       "Mo",
+		"Tue":       "Di",
+		"Wed":       "Mi",
+		"Thu":       "Do",
+		"Fri":       "Fr",
+		"Sat":       "Sa",
+		"Sun":       "So",
+		"Jan":       "Jan",
+		"Feb":       "Feb",
+		"Mar":       "Mär",
+		"Apr":       "Apr",
+		"May":       "Mai",
+		"Jun":       "Jun",
+		"Jul":       "Jul",
+		"Aug":       "Aug",
+		"Sep":       "Sep",
+		"Oct":       "Okt",
+		"Nov":       "Nov",
+		"Dec":       "Dez",
+	},
+	"pt": {
+		"FINAL":     "FIM",
+		"Final":     "Fim",
+		"PPD":       "ADI",
+		"Postponed": "Adiado",
+		"OT":        "PRO",
+		"SO":        "PEN",
+		"HALF":      "INT",
+		"Half":      "Int",
+		"END":       "FIM",
+		"No":        "Sem",
+		"Games":     "Jogos",
+		"Today":     "Hoje",
+		"Live":      "Ao Vivo",
+		"Mon":       "Seg",
+		"Tue":       "Ter",
+		"Wed":       "Qua",
+		"Thu":       "Qui",
+		"Fri":       "Sex",
+		"Sat":       "Sáb",
+		"Sun":       "Dom",
+		"Jan":       "Jan",
+		"Feb":       "Fev",
+		"Mar":       "Mar",
+		"Apr":       "Abr",
+		"May":       "Mai",
+		"Jun":       "Jun",
+		"Jul":       "Jul",
+		"Aug":       "Ago",
+		"Sep":       "Set",
+		"Oct":       "Out",
+		"Nov":       "Nov",
+		"Dec":       "Dez",
+	},
+	"it": {
+		"FINAL":     "FINE",
+		"Final":     "Fine",
+		"PPD":       "RINV",
+		"Postponed": "Rinviata",
+		"OT":        "SUPP",
+		"SO":        "RIG",
+		"HALF":      "INT",
+		"Half":      "Int",
+		"END":       "FINE",
+		"No":        "Nessuna",
+		"Games":     "Partita",
+		"Today":     "Oggi",
+		"Live":      "Diretta",
+		"Mon":       "Lun",
+		"Tue":       "Mar",
+		"Wed":       "Mer",
+		"Thu":       "Gio",
+		"Fri":       "Ven",
+		"Sat":       "Sab",
+		"Sun":       "Dom",
+		"Jan":       "Gen",
+		"Feb":       "Feb",
+		"Mar":       "Mar",
+		"Apr":       "Apr",
+		"May":       "Mag",
+		"Jun":       "Giu",
+		"Jul":       "Lug",
+		"Aug":       "Ago",
+		"Sep":       "Set",
+		"Oct":       "Ott",
+		"Nov":       "Nov",
+		"Dec":       "Dic",
+	},
+}
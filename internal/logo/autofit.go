@@ -0,0 +1,170 @@
+package logo
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/disintegration/imaging"
+)
+
+const (
+	// visibleAlpha is the alpha above which a pixel counts as part of the logo
+	visibleAlpha = 0x1000
+	// wordmarkAspect is the width to height ratio at which a logo is treated as a wordmark
+	wordmarkAspect = 2.0
+	// wordmarkHeight is the share of the panel height a wordmark may use
+	wordmarkHeight = 0.6
+	// centerOfMassWeight is how far a crest is moved from its bounding box centre towards its
+	// visual centre of mass
+	centerOfMassWeight = 0.5
+)
+
+var (
+	fitCache = make(map[string]*Config)
+	fitLock  sync.RWMutex
+)
+
+// ContentBounds returns the bounding box of an image's visible pixels. It's empty if the image
+// is fully transparent.
+func ContentBounds(img image.Image) image.Rectangle {
+	b := img.Bounds()
+	content := image.Rectangle{}
+	found := false
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a <= visibleAlpha {
+				continue
+			}
+			if !found {
+				content = image.Rect(x, y, x+1, y+1)
+				found = true
+				continue
+			}
+			content = content.Union(image.Rect(x, y, x+1, y+1))
+		}
+	}
+
+	return content
+}
+
+// Trim crops the transparent border from an image. A fully transparent image is returned as is.
+func Trim(img image.Image) image.Image {
+	content := ContentBounds(img)
+	if content.Empty() || content == img.Bounds() {
+		return img
+	}
+
+	return imaging.Crop(img, content)
+}
+
+// CenterOfMass returns the alpha weighted centre of an image's visible pixels, relative to the
+// image's bounds
+func CenterOfMass(img image.Image) (float64, float64) {
+	b := img.Bounds()
+	var sumX, sumY, total float64
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			_, _, _, a := img.At(x, y).RGBA()
+			if a <= visibleAlpha {
+				continue
+			}
+			w := float64(a)
+			sumX += w * (float64(x-b.Min.X) + 0.5)
+			sumY += w * (float64(y-b.Min.Y) + 0.5)
+			total += w
+		}
+	}
+
+	if total == 0 {
+		return float64(b.Dx()) / 2, float64(b.Dy()) / 2
+	}
+
+	return sumX / total, sumY / total
+}
+
+// IsWordmark determines if a logo's visible content is wide enough to be laid out as a wordmark
+// rather than a crest
+func IsWordmark(content image.Rectangle) bool {
+	if content.Dy() < 1 {
+		return false
+	}
+	return float64(content.Dx())/float64(content.Dy()) >= wordmarkAspect
+}
+
+// AutoConfig works out a Config that makes a logo's visible content fill half of the matrix. The
+// transparent border is trimmed. Crests are as tall as will fit and are centred on their centre
+// of mass, while wordmarks use the full width and are centred on their bounding box.
+func AutoConfig(key string, src image.Image, matrixBounds image.Rectangle) *Config {
+	conf := &Config{
+		Abbrev: key,
+		XSize:  matrixBounds.Dx(),
+		YSize:  matrixBounds.Dy(),
+		Trim:   true,
+		Pt: &Pt{
+			Zoom: 1,
+		},
+	}
+
+	content := ContentBounds(src)
+	if content.Empty() {
+		return conf
+	}
+	trimmed := Trim(src)
+
+	boxW := float64(matrixBounds.Dx()) / 2
+	boxH := float64(matrixBounds.Dy())
+	wordmark := IsWordmark(content)
+	if wordmark {
+		boxH *= wordmarkHeight
+	}
+
+	aspect := float64(content.Dx()) / float64(content.Dy())
+	w, h := boxW, boxW/aspect
+	if h > boxH {
+		w, h = boxH*aspect, boxH
+	}
+
+	// A thumbnail is resized to fit within the zoomed matrix size, so pick the zoom at which the
+	// content comes out at w x h
+	conf.Pt.Zoom = math.Max(w/float64(matrixBounds.Dx()+1), h/float64(matrixBounds.Dy()+1))
+
+	if !wordmark {
+		// The rendered logo is centred on its bounding box. Move it part of the way towards its
+		// centre of mass, so that crests with a heavy top or bottom look centred.
+		_, comY := CenterOfMass(trimmed)
+		scale := h / float64(content.Dy())
+		offset := (float64(content.Dy())/2 - comY) * scale * centerOfMassWeight
+		conf.Pt.Y = int(math.Round(offset))
+	}
+
+	return conf
+}
+
+// ScoreboardKey determines if a logo key is for a team's HOME or AWAY logo on a scoreboard, which is
+// laid out in half of the matrix. Only these logos are fitted automatically; others, such as ticker
+// and poll logos, are drawn into bounds their caller picks.
+func ScoreboardKey(key string) bool {
+	return strings.Contains(key, "_HOME_") || strings.Contains(key, "_AWAY_")
+}
+
+func fitKey(key string, bounds image.Rectangle) string {
+	return fmt.Sprintf("%s_%dx%d", key, bounds.Dx(), bounds.Dy())
+}
+
+func cachedFit(key string, bounds image.Rectangle) (*Config, bool) {
+	fitLock.RLock()
+	defer fitLock.RUnlock()
+	c, ok := fitCache[fitKey(key, bounds)]
+	return c, ok
+}
+
+func setCachedFit(key string, bounds image.Rectangle, conf *Config) {
+	fitLock.Lock()
+	defer fitLock.Unlock()
+	fitCache[fitKey(key, bounds)] = conf
+}
@@ -0,0 +1,128 @@
+package logo
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// paddedLogo is a transparent image with an opaque block of content in it
+func paddedLogo(size image.Rectangle, content image.Rectangle) image.Image {
+	img := image.NewNRGBA(size)
+	draw.Draw(img, content, image.NewUniform(color.NRGBA{255, 0, 0, 255}), image.Point{}, draw.Src)
+	return img
+}
+
+func TestContentBounds(t *testing.T) {
+	t.Parallel()
+
+	img := paddedLogo(image.Rect(0, 0, 100, 100), image.Rect(20, 10, 70, 90))
+	require.Equal(t, image.Rect(20, 10, 70, 90), ContentBounds(img))
+
+	trimmed := Trim(img)
+	require.Equal(t, 50, trimmed.Bounds().Dx())
+	require.Equal(t, 80, trimmed.Bounds().Dy())
+
+	require.True(t, ContentBounds(image.NewNRGBA(image.Rect(0, 0, 10, 10))).Empty())
+}
+
+func TestCenterOfMass(t *testing.T) {
+	t.Parallel()
+
+	img := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	draw.Draw(img, image.Rect(0, 0, 10, 10), image.NewUniform(color.NRGBA{255, 255, 255, 255}), image.Point{}, draw.Src)
+	x, y := CenterOfMass(img)
+	require.InDelta(t, 5, x, 0.001)
+	require.InDelta(t, 5, y, 0.001)
+
+	// Bottom heavy
+	_, y = CenterOfMass(paddedLogo(image.Rect(0, 0, 10, 10), image.Rect(0, 5, 10, 10)))
+	require.InDelta(t, 7.5, y, 0.001)
+}
+
+func TestIsWordmark(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, IsWordmark(image.Rect(0, 0, 50, 50)))
+	require.False(t, IsWordmark(image.Rect(0, 0, 60, 40)))
+	require.True(t, IsWordmark(image.Rect(0, 0, 120, 30)))
+}
+
+func TestScoreboardKey(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, ScoreboardKey("ncaam_1234_HOME_64x32"))
+	require.True(t, ScoreboardKey("1234_AWAY_128x64"))
+	require.False(t, ScoreboardKey("1234_TICKER_16x16"))
+	require.False(t, ScoreboardKey("333_POLL_16x16"))
+}
+
+func TestAutoConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		src     image.Image
+		matrix  image.Rectangle
+		expectW int
+		expectH int
+	}{
+		{
+			name:    "crest fills the panel height",
+			src:     paddedLogo(image.Rect(0, 0, 500, 500), image.Rect(100, 100, 400, 400)),
+			matrix:  image.Rect(0, 0, 64, 32),
+			expectW: 32,
+			expectH: 32,
+		},
+		{
+			name:    "crest fills half the width of a square panel",
+			src:     paddedLogo(image.Rect(0, 0, 500, 500), image.Rect(0, 0, 500, 500)),
+			matrix:  image.Rect(0, 0, 64, 64),
+			expectW: 32,
+			expectH: 32,
+		},
+		{
+			name:    "wordmark fills half the width",
+			src:     paddedLogo(image.Rect(0, 0, 500, 500), image.Rect(0, 200, 400, 300)),
+			matrix:  image.Rect(0, 0, 64, 32),
+			expectW: 32,
+			expectH: 8,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			conf := AutoConfig("test", test.src, test.matrix)
+			require.True(t, conf.Trim)
+
+			l := New("test", func(ctx context.Context) (image.Image, error) {
+				return test.src, nil
+			}, t.TempDir(), test.matrix, conf)
+			l.memoryOnly = true
+
+			thumb, err := l.GetThumbnail(context.Background(), test.matrix)
+			require.NoError(t, err)
+			require.InDelta(t, test.expectW, thumb.Bounds().Dx(), 1)
+			require.InDelta(t, test.expectH, thumb.Bounds().Dy(), 1)
+		})
+	}
+}
+
+func TestAutoConfigCenterOfMass(t *testing.T) {
+	t.Parallel()
+
+	// A crest with most of its weight at the bottom is moved up
+	img := image.NewNRGBA(image.Rect(0, 0, 100, 100))
+	draw.Draw(img, image.Rect(45, 0, 55, 100), image.NewUniform(color.NRGBA{255, 255, 255, 255}), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 60, 100, 100), image.NewUniform(color.NRGBA{255, 255, 255, 255}), image.Point{}, draw.Src)
+
+	conf := AutoConfig("test", img, image.Rect(0, 0, 64, 32))
+	require.Less(t, conf.Pt.Y, 0)
+	require.Equal(t, 0, conf.Pt.X)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/disintegration/imaging"
 
+	"github.com/robbydyer/sports/internal/metrics"
 	"github.com/robbydyer/sports/internal/rgbrender"
 )
 
@@ -27,6 +28,12 @@ type Logo struct {
 	config           *Config
 	thumbnail        image.Image
 	log              *zap.Logger
+	// memoryOnly skips reading and writing the thumbnail file
+	memoryOnly bool
+	// fitted is the automatic config worked out for an Auto config
+	fitted *Config
+	// pixelArt is set when the source logo is PixelArt
+	pixelArt bool
 }
 
 // Config ...
@@ -38,6 +45,10 @@ type Config struct {
 	// FitImage determines if image scaling is based on bounds given
 	// to render functions. Default of false uses the matrix bounds for scaling
 	FitImage bool `json:"fit"`
+	// Auto works out the zoom and shift from the logo's content. See AutoConfig.
+	Auto bool `json:"auto,omitempty"`
+	// Trim crops the transparent border from the source logo before it's resized
+	Trim bool `json:"trim,omitempty"`
 }
 
 // Pt defines the x, y shift and zoom values for a logo
@@ -63,6 +74,89 @@ func (l *Logo) Key() string {
 	return l.key
 }
 
+// Config returns the logo's position and zoom
+func (l *Logo) Config() *Config {
+	return l.config
+}
+
+// Fitted returns the Config the logo is rendered with. An Auto config is worked out from the
+// source logo the first time, and cached for the logo and matrix size.
+func (l *Logo) Fitted(ctx context.Context) (*Config, error) {
+	if !l.config.Auto {
+		return l.config, nil
+	}
+	if l.fitted != nil {
+		return l.fitted, nil
+	}
+	if c, ok := cachedFit(l.key, l.bounds); ok {
+		l.fitted = c
+		return c, nil
+	}
+
+	if l.sourceLogoGetter == nil {
+		return nil, fmt.Errorf("sourceLogoGetter was nil")
+	}
+	src, err := l.sourceLogoGetter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logo source for %s: %w", l.config.Abbrev, err)
+	}
+	if src == nil {
+		return nil, fmt.Errorf("failed to get logo source for %s", l.config.Abbrev)
+	}
+
+	if _, ok := src.(*PixelArt); ok {
+		l.fitted = l.pixelArtConfig()
+	} else {
+		l.fitted = AutoConfig(l.config.Abbrev, src, l.bounds)
+		l.fitted.FitImage = l.config.FitImage
+	}
+	setCachedFit(l.key, l.bounds, l.fitted)
+
+	return l.fitted, nil
+}
+
+// pixelArtConfig is the Config PixelArt is rendered with. It's already drawn to fit, so it isn't
+// zoomed or shifted.
+func (l *Logo) pixelArtConfig() *Config {
+	return &Config{
+		Abbrev: l.config.Abbrev,
+		XSize:  l.bounds.Dx(),
+		YSize:  l.bounds.Dy(),
+		Pt:     &Pt{Zoom: 1},
+	}
+}
+
+// conf is the Config the logo is rendered with, once an Auto config has been fitted
+func (l *Logo) conf() *Config {
+	if l.fitted != nil {
+		return l.fitted
+	}
+	return l.config
+}
+
+// WithConfig returns a copy of the logo that renders with the given Config. The copy's thumbnail
+// is kept in memory only, so it can be used to preview a position without touching the cache.
+func (l *Logo) WithConfig(conf *Config) *Logo {
+	return &Logo{
+		key:              l.key,
+		targetDirectory:  l.targetDirectory,
+		sourceLogoGetter: l.sourceLogoGetter,
+		config:           conf,
+		bounds:           l.bounds,
+		log:              l.log,
+		memoryOnly:       true,
+	}
+}
+
+// ClearThumbnail removes the resized thumbnail from memory and from the cache directory
+func (l *Logo) ClearThumbnail() error {
+	l.thumbnail = nil
+	if err := os.Remove(l.ThumbnailFilename(l.bounds)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 // SetLogger ...
 func (l *Logo) SetLogger(logger *zap.Logger) {
 	l.log = logger
@@ -76,17 +170,30 @@ func (l *Logo) ensureLogger() {
 
 // ThumbnailFilename returns the filname for the resized thumbnail to use
 func (l *Logo) ThumbnailFilename(size image.Rectangle) string {
+	if l.config.Auto {
+		return filepath.Join(l.targetDirectory, fmt.Sprintf("%s_auto.tiff", l.key))
+	}
 	return filepath.Join(l.targetDirectory, fmt.Sprintf("%s.tiff", l.key))
 }
 
 // GetThumbnail returns the resized image
 func (l *Logo) GetThumbnail(ctx context.Context, size image.Rectangle) (image.Image, error) {
 	if l.thumbnail != nil {
+		metrics.CacheHit("logo")
 		return l.thumbnail, nil
 	}
 
+	if _, err := l.Fitted(ctx); err != nil {
+		return nil, err
+	}
+
 	thumbFile := l.ThumbnailFilename(size)
 
+	if l.memoryOnly {
+		metrics.CacheMiss("logo")
+		return l.createThumbnail(ctx, size)
+	}
+
 	if _, err := os.Stat(thumbFile); err != nil {
 		if os.IsNotExist(err) {
 			if _, err := os.Stat(l.targetDirectory); err != nil {
@@ -97,30 +204,14 @@ func (l *Logo) GetThumbnail(ctx context.Context, size image.Rectangle) (image.Im
 				}
 			}
 
-			if l.sourceLogoGetter == nil {
-				return nil, fmt.Errorf("sourceLogoGetter was nil")
-			}
-
-			src, err := l.sourceLogoGetter(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get logo source for %s: %w", l.config.Abbrev, err)
-			}
-
-			if src == nil {
-				return nil, fmt.Errorf("failed to get logo source for %s", l.config.Abbrev)
+			metrics.CacheMiss("logo")
+			if _, err := l.createThumbnail(ctx, size); err != nil {
+				return nil, err
 			}
 
-			// Create the thumbnail
-			if l.config.FitImage {
-				if l.log != nil {
-					l.log.Debug("fit image thumbnail",
-						zap.Int("width", size.Dx()),
-						zap.Int("height", size.Dy()),
-					)
-				}
-				l.thumbnail = rgbrender.FitImage(src, size, l.config.Pt.Zoom)
-			} else {
-				l.thumbnail = rgbrender.ResizeImage(src, size, l.config.Pt.Zoom)
+			// Pixel art is read from its own file each time, so it's known to be drawn as is
+			if l.pixelArt {
+				return l.thumbnail, nil
 			}
 
 			go func() {
@@ -142,6 +233,50 @@ func (l *Logo) GetThumbnail(ctx context.Context, size image.Rectangle) (image.Im
 	if err != nil {
 		return nil, fmt.Errorf("failed to open logo %s: %w", thumbFile, err)
 	}
+	metrics.CacheHit("logo")
+
+	return l.thumbnail, nil
+}
+
+// createThumbnail resizes the source logo to the given size and zoom
+func (l *Logo) createThumbnail(ctx context.Context, size image.Rectangle) (image.Image, error) {
+	if l.sourceLogoGetter == nil {
+		return nil, fmt.Errorf("sourceLogoGetter was nil")
+	}
+
+	src, err := l.sourceLogoGetter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logo source for %s: %w", l.config.Abbrev, err)
+	}
+
+	if src == nil {
+		return nil, fmt.Errorf("failed to get logo source for %s", l.config.Abbrev)
+	}
+
+	if pa, ok := src.(*PixelArt); ok {
+		l.pixelArt = true
+		l.fitted = l.pixelArtConfig()
+		l.thumbnail = pa.Image
+		return l.thumbnail, nil
+	}
+
+	conf := l.conf()
+	if conf.Trim {
+		src = Trim(src)
+	}
+
+	// Create the thumbnail
+	if conf.FitImage {
+		if l.log != nil {
+			l.log.Debug("fit image thumbnail",
+				zap.Int("width", size.Dx()),
+				zap.Int("height", size.Dy()),
+			)
+		}
+		l.thumbnail = rgbrender.FitImage(src, size, conf.Pt.Zoom)
+	} else {
+		l.thumbnail = rgbrender.ResizeImage(src, size, conf.Pt.Zoom)
+	}
 
 	return l.thumbnail, nil
 }
@@ -168,11 +303,11 @@ func (l *Logo) RenderLeftAligned(ctx context.Context, bounds image.Rectangle, en
 		startX = endX - thumb.Bounds().Dx()
 	}
 
-	startX += l.config.Pt.X
+	startX += l.conf().Pt.X
 
-	startY := 0 + l.config.Pt.Y
+	startY := 0 + l.conf().Pt.Y
 	newBounds := image.Rect(startX, startY, bounds.Dx()-1, bounds.Dy()-1)
-	align, err := rgbrender.AlignPosition(rgbrender.LeftCenter, newBounds, thumb.Bounds().Dx(), thumb.Bounds().Dy())
+	align, err := alignThumb(rgbrender.LeftCenter, newBounds, thumb)
 	if err != nil {
 		return nil, err
 	}
@@ -219,12 +354,12 @@ func (l *Logo) RenderRightAligned(ctx context.Context, bounds image.Rectangle, s
 		}
 	}
 
-	startX = startX + l.config.Pt.X
-	startY := 0 + l.config.Pt.Y
+	startX = startX + l.conf().Pt.X
+	startY := 0 + l.conf().Pt.Y
 
 	newBounds := image.Rect(startX, startY, thumb.Bounds().Dx()+startX, thumb.Bounds().Dy()+startY)
 
-	align, err := rgbrender.AlignPosition(rgbrender.RightCenter, newBounds, thumb.Bounds().Dx(), thumb.Bounds().Dy())
+	align, err := alignThumb(rgbrender.RightCenter, newBounds, thumb)
 	if err != nil {
 		return nil, err
 	}
@@ -257,9 +392,9 @@ func (l *Logo) RenderRightAlignedWithEnd(ctx context.Context, bounds image.Recta
 		startX = endX - thumb.Bounds().Dx()
 	}
 
-	startX += l.config.Pt.X
+	startX += l.conf().Pt.X
 
-	startY := 0 + l.config.Pt.Y
+	startY := 0 + l.conf().Pt.Y
 	newBounds := image.Rect(startX, startY, endX, bounds.Dy()-1)
 	l.ensureLogger()
 	l.log.Debug("render aligned logo",
@@ -269,7 +404,7 @@ func (l *Logo) RenderRightAlignedWithEnd(ctx context.Context, bounds image.Recta
 		zap.Int("end X", newBounds.Max.X),
 		zap.Int("end Y", newBounds.Max.Y),
 	)
-	align, err := rgbrender.AlignPosition(rgbrender.RightCenter, newBounds, thumb.Bounds().Dx(), thumb.Bounds().Dy())
+	align, err := alignThumb(rgbrender.RightCenter, newBounds, thumb)
 	if err != nil {
 		return nil, err
 	}
@@ -296,8 +431,8 @@ func (l *Logo) RenderLeftAlignedWithStart(ctx context.Context, bounds image.Rect
 		}
 	}
 
-	startX = startX + l.config.Pt.X
-	startY := 0 + l.config.Pt.Y
+	startX = startX + l.conf().Pt.X
+	startY := 0 + l.conf().Pt.Y
 
 	newBounds := image.Rect(startX, startY, bounds.Dx()+startX, bounds.Dy()+startY)
 
@@ -310,7 +445,7 @@ func (l *Logo) RenderLeftAlignedWithStart(ctx context.Context, bounds image.Rect
 		zap.Int("end Y", newBounds.Max.Y),
 	)
 
-	align, err := rgbrender.AlignPosition(rgbrender.LeftCenter, newBounds, thumb.Bounds().Dx(), thumb.Bounds().Dy())
+	align, err := alignThumb(rgbrender.LeftCenter, newBounds, thumb)
 	if err != nil {
 		return nil, err
 	}
@@ -320,3 +455,9 @@ func (l *Logo) RenderLeftAlignedWithStart(ctx context.Context, bounds image.Rect
 
 	return i, nil
 }
+
+// alignThumb aligns a thumbnail within a logo's bounds. Logo configs were positioned against the thumbnail
+// being centered on the full height, regardless of the Pt.Y offset in the bounds, so keep doing that.
+func alignThumb(align rgbrender.Align, bounds image.Rectangle, thumb image.Image) (image.Rectangle, error) {
+	return rgbrender.AlignPosition(align, image.Rect(bounds.Min.X, 0, bounds.Max.X, bounds.Max.Y), thumb.Bounds().Dx(), thumb.Bounds().Dy())
+}
@@ -0,0 +1,162 @@
+package logo
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/disintegration/imaging"
+	"github.com/srwiley/oksvg"
+	"github.com/srwiley/rasterx"
+)
+
+// DefaultOverrideDir is where user supplied team logos are kept
+const DefaultOverrideDir = "/etc/sportsmatrix_logos"
+
+// svgSize is the longest side an SVG logo is rasterized at, about the size of ESPN's logos
+const svgSize = 500
+
+// OverrideKind is the type of a user supplied logo
+type OverrideKind string
+
+// OverrideKinds
+const (
+	OverrideNone     OverrideKind = ""
+	OverridePixelArt OverrideKind = "pixel art"
+	OverrideSVG      OverrideKind = "svg"
+	OverrideImage    OverrideKind = "image"
+)
+
+var (
+	overrideDir     = DefaultOverrideDir
+	overrideDirLock sync.RWMutex
+)
+
+// PixelArt is a logo drawn for an exact panel size. It's drawn as is, without being trimmed,
+// fitted or zoomed.
+type PixelArt struct {
+	image.Image
+}
+
+// SetOverrideDir sets the directory of user supplied logos
+func SetOverrideDir(dir string) {
+	overrideDirLock.Lock()
+	defer overrideDirLock.Unlock()
+	overrideDir = dir
+}
+
+// FindOverride looks for a user supplied logo for a team. Logos are kept in a directory per
+// league, named after the team's ID or abbreviation, ie. ncaaf/ISU.svg or ncaaf/66_64x32.png.
+// Each team key is tried in order, and names aren't case sensitive. A PNG suffixed with the panel
+// size is pixel art, which is preferred over an SVG, which is preferred over any other image.
+func FindOverride(league string, matrixBounds image.Rectangle, teamKeys ...string) (string, OverrideKind) {
+	overrideDirLock.RLock()
+	dir := overrideDir
+	overrideDirLock.RUnlock()
+
+	return findOverride(dir, league, matrixBounds, teamKeys...)
+}
+
+func findOverride(baseDir string, league string, matrixBounds image.Rectangle, teamKeys ...string) (string, OverrideKind) {
+	dir := filepath.Join(baseDir, strings.ToLower(league))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", OverrideNone
+	}
+	files := make(map[string]string, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			files[strings.ToLower(e.Name())] = filepath.Join(dir, e.Name())
+		}
+	}
+
+	for _, key := range teamKeys {
+		if key == "" {
+			continue
+		}
+		key = strings.ToLower(key)
+
+		if f, ok := files[fmt.Sprintf("%s_%dx%d.png", key, matrixBounds.Dx(), matrixBounds.Dy())]; ok {
+			return f, OverridePixelArt
+		}
+		if f, ok := files[key+".svg"]; ok {
+			return f, OverrideSVG
+		}
+		for _, ext := range []string{".png", ".jpg", ".jpeg", ".gif"} {
+			if f, ok := files[key+ext]; ok {
+				return f, OverrideImage
+			}
+		}
+	}
+
+	return "", OverrideNone
+}
+
+// LoadOverride loads a user supplied logo for a team, or returns nil if there isn't one. See
+// FindOverride.
+func LoadOverride(league string, matrixBounds image.Rectangle, teamKeys ...string) (image.Image, error) {
+	file, kind := FindOverride(league, matrixBounds, teamKeys...)
+
+	return loadOverride(file, kind)
+}
+
+func loadOverride(file string, kind OverrideKind) (image.Image, error) {
+	switch kind {
+	case OverridePixelArt:
+		img, err := imaging.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open logo %s: %w", file, err)
+		}
+		return &PixelArt{Image: img}, nil
+	case OverrideSVG:
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open logo %s: %w", file, err)
+		}
+		defer f.Close()
+		img, err := RasterizeSVG(f, svgSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rasterize logo %s: %w", file, err)
+		}
+		return img, nil
+	case OverrideImage:
+		img, err := imaging.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open logo %s: %w", file, err)
+		}
+		return img, nil
+	}
+
+	return nil, nil
+}
+
+// RasterizeSVG draws an SVG so that its longest side is the given size
+func RasterizeSVG(r io.Reader, size int) (image.Image, error) {
+	icon, err := oksvg.ReadIconStream(r)
+	if err != nil {
+		return nil, err
+	}
+
+	w, h := icon.ViewBox.W, icon.ViewBox.H
+	if w <= 0 || h <= 0 {
+		return nil, fmt.Errorf("SVG has no size")
+	}
+	scale := float64(size) / math.Max(w, h)
+	width := int(math.Ceil(w * scale))
+	height := int(math.Ceil(h * scale))
+
+	icon.SetTarget(0, 0, float64(width), float64(height))
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.Transparent, image.Point{}, draw.Src)
+	scanner := rasterx.NewScannerGV(width, height, img, img.Bounds())
+	icon.Draw(rasterx.NewDasher(width, height, scanner), 1)
+
+	return img, nil
+}
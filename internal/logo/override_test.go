@@ -0,0 +1,119 @@
+package logo
+
+import (
+	"context"
+	"image"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindOverride(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "ncaaf"), 0o755))
+	for _, f := range []string{"ISU.svg", "isu_64x32.png", "66.png", "MICH.jpg", "mich.svg"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "ncaaf", f), []byte{}, 0o644))
+	}
+
+	tests := []struct {
+		name   string
+		league string
+		bounds image.Rectangle
+		keys   []string
+		file   string
+		kind   OverrideKind
+	}{
+		{
+			name:   "pixel art for the panel size",
+			league: "NCAAF",
+			bounds: image.Rect(0, 0, 64, 32),
+			keys:   []string{"isu"},
+			file:   "isu_64x32.png",
+			kind:   OverridePixelArt,
+		},
+		{
+			name:   "svg at another panel size",
+			league: "ncaaf",
+			bounds: image.Rect(0, 0, 128, 64),
+			keys:   []string{"ISU"},
+			file:   "ISU.svg",
+			kind:   OverrideSVG,
+		},
+		{
+			name:   "svg over other images",
+			league: "ncaaf",
+			bounds: image.Rect(0, 0, 64, 32),
+			keys:   []string{"MICH"},
+			file:   "mich.svg",
+			kind:   OverrideSVG,
+		},
+		{
+			name:   "ID before abbreviation",
+			league: "ncaaf",
+			bounds: image.Rect(0, 0, 64, 32),
+			keys:   []string{"66", "ISU"},
+			file:   "66.png",
+			kind:   OverrideImage,
+		},
+		{
+			name:   "none",
+			league: "nfl",
+			bounds: image.Rect(0, 0, 64, 32),
+			keys:   []string{"ISU"},
+			kind:   OverrideNone,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			file, kind := findOverride(dir, test.league, test.bounds, test.keys...)
+			require.Equal(t, test.kind, kind)
+			if test.file != "" {
+				require.Equal(t, filepath.Join(dir, "ncaaf", test.file), file)
+			}
+		})
+	}
+}
+
+func TestRasterizeSVG(t *testing.T) {
+	t.Parallel()
+
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 20 10">
+<rect x="0" y="0" width="10" height="10" fill="#ff0000"/>
+</svg>`
+
+	img, err := RasterizeSVG(strings.NewReader(svg), 100)
+	require.NoError(t, err)
+	require.Equal(t, 100, img.Bounds().Dx())
+	require.Equal(t, 50, img.Bounds().Dy())
+	require.Equal(t, image.Rect(0, 0, 50, 50), ContentBounds(img))
+}
+
+func TestPixelArtNotShifted(t *testing.T) {
+	t.Parallel()
+
+	bounds := image.Rect(0, 0, 64, 32)
+	art := image.NewRGBA(image.Rect(0, 0, 32, 32))
+
+	l := New("ncaaf_66_HOME_64x32", func(ctx context.Context) (image.Image, error) {
+		return &PixelArt{Image: art}, nil
+	}, t.TempDir(), bounds, &Config{
+		Abbrev: "ncaaf_66_HOME_64x32",
+		XSize:  64,
+		YSize:  32,
+		Pt:     &Pt{X: 5, Y: 3, Zoom: 0.5},
+	})
+
+	for i := 0; i < 2; i++ {
+		img, err := l.RenderLeftAlignedWithStart(context.Background(), bounds, 0)
+		require.NoError(t, err)
+		require.Equal(t, image.Pt(0, 0), img.Bounds().Min)
+	}
+}
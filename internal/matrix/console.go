@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/metrics"
 )
 
 // ConsoleMatrix prints a representation of a matrix to a terminal.
@@ -180,6 +182,7 @@ func (c *ConsoleMatrix) render(leds []uint32) error {
 	rendered = append(rendered, strings.Repeat("_ ", c.width+1)+"|")
 
 	_, _ = fmt.Fprintln(c.out, strings.Join(rendered, "\n"))
+	metrics.Frames.Frame("console")
 
 	c.Reset()
 
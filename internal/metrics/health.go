@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultFailureThreshold is the number of requests to a data source that must fail in a row
+// before it's reported as degraded
+const DefaultFailureThreshold = 5
+
+// Health statuses
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+)
+
+var (
+	sources          = make(map[string]*SourceHealth)
+	failureThreshold = DefaultFailureThreshold
+	healthLock       sync.RWMutex
+)
+
+// SourceHealth is the health of an upstream data source
+type SourceHealth struct {
+	Source              string    `json:"source"`
+	League              string    `json:"league,omitempty"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	LastError           string    `json:"lastError,omitempty"`
+	LastFailure         time.Time `json:"lastFailure,omitempty"`
+	LastSuccess         time.Time `json:"lastSuccess,omitempty"`
+	Degraded            bool      `json:"degraded"`
+}
+
+// Health is the health of the service
+type Health struct {
+	Status  string          `json:"status"`
+	Sources []*SourceHealth `json:"sources"`
+}
+
+// SetFailureThreshold sets the number of requests to a data source that must fail in a row before
+// it's reported as degraded
+func SetFailureThreshold(n int) {
+	if n < 1 {
+		n = DefaultFailureThreshold
+	}
+	healthLock.Lock()
+	defer healthLock.Unlock()
+	failureThreshold = n
+}
+
+func sourceHealth(source, league string) *SourceHealth {
+	k := source + "/" + league
+	s, ok := sources[k]
+	if !ok {
+		s = &SourceHealth{
+			Source: source,
+			League: league,
+		}
+		sources[k] = s
+	}
+	return s
+}
+
+// RecordSuccess records a successful request to a data source
+func RecordSuccess(source, league string) {
+	healthLock.Lock()
+	defer healthLock.Unlock()
+	s := sourceHealth(source, league)
+	s.ConsecutiveFailures = 0
+	s.LastSuccess = time.Now()
+	UpstreamConsecutiveFailures.Set(0, source, league)
+}
+
+// RecordFailure records a failed request to a data source
+func RecordFailure(source, league string, err error) {
+	healthLock.Lock()
+	defer healthLock.Unlock()
+	s := sourceHealth(source, league)
+	s.ConsecutiveFailures++
+	s.LastFailure = time.Now()
+	if err != nil {
+		s.LastError = err.Error()
+	}
+	UpstreamErrors.Inc(source, league)
+	UpstreamConsecutiveFailures.Set(float64(s.ConsecutiveFailures), source, league)
+}
+
+// GetHealth returns the health of the service. It's degraded if any data source has failed the
+// threshold number of times in a row.
+func GetHealth() *Health {
+	healthLock.RLock()
+	defer healthLock.RUnlock()
+
+	h := &Health{
+		Status:  StatusOK,
+		Sources: make([]*SourceHealth, 0, len(sources)),
+	}
+	for _, s := range sources {
+		c := *s
+		c.Degraded = c.ConsecutiveFailures >= failureThreshold
+		if c.Degraded {
+			h.Status = StatusDegraded
+		}
+		h.Sources = append(h.Sources, &c)
+	}
+	sort.SliceStable(h.Sources, func(i, j int) bool {
+		if h.Sources[i].Source != h.Sources[j].Source {
+			return h.Sources[i].Source < h.Sources[j].Source
+		}
+		return h.Sources[i].League < h.Sources[j].League
+	})
+
+	return h
+}
+
+// HealthHandler serves the health of the service as JSON. It responds with 503 when degraded.
+func HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		h := GetHealth()
+		w.Header().Set("Content-Type", "application/json")
+		if h.Status != StatusOK {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(h)
+	})
+}
@@ -0,0 +1,324 @@
+package metrics
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// DefaultBuckets are the histogram buckets, in seconds, used for durations
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// registry holds the metrics of the display service. It's kept separate from the default registry
+// so that only these metrics are served.
+var registry = prometheus.NewRegistry()
+
+// Metrics exposed by the display service
+var (
+	RenderDuration = NewHistogramVec("sportsmatrix_board_render_seconds",
+		"Time taken for a board to render to a canvas", DefaultBuckets, "board")
+	Frames = NewFPSMeter("sportsmatrix_frames_per_second",
+		"Frames drawn to the matrix per second", "matrix")
+	UpstreamDuration = NewHistogramVec("sportsmatrix_upstream_request_seconds",
+		"Latency of requests to upstream data sources", DefaultBuckets, "source", "league")
+	UpstreamErrors = NewCounterVec("sportsmatrix_upstream_errors_total",
+		"Failed requests to upstream data sources", "source", "league")
+	UpstreamConsecutiveFailures = NewGaugeVec("sportsmatrix_upstream_consecutive_failures",
+		"Requests to an upstream data source that have failed in a row", "source", "league")
+	CacheRequests = NewCounterVec("sportsmatrix_cache_requests_total",
+		"Lookups in the logo and image caches", "cache", "result")
+	CacheHitRatio = NewGaugeFunc("sportsmatrix_cache_hit_ratio",
+		"Ratio of cache lookups that were hits", cacheHitRatios, "cache")
+	ScrollCanvasBytes = NewGaugeVec("sportsmatrix_scroll_canvas_bytes",
+		"Memory used by the rendered images of a scroll canvas", "canvas")
+	ScreenOn = NewGaugeVec("sportsmatrix_screen_on",
+		"Whether the screen is on")
+)
+
+// Handler serves all metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// CacheHit records a lookup in a cache that found what it was looking for
+func CacheHit(cache string) {
+	CacheRequests.Inc(cache, "hit")
+}
+
+// CacheMiss records a lookup in a cache that didn't find what it was looking for
+func CacheMiss(cache string) {
+	CacheRequests.Inc(cache, "miss")
+}
+
+func cacheHitRatios() map[string]float64 {
+	hits := map[string]float64{}
+	totals := map[string]float64{}
+	CacheRequests.each(func(labels []string, v float64) {
+		totals[labels[0]] += v
+		if labels[1] == "hit" {
+			hits[labels[0]] += v
+		}
+	})
+
+	ratios := make(map[string]float64, len(totals))
+	for cache, total := range totals {
+		if total > 0 {
+			ratios[cache] = hits[cache] / total
+		}
+	}
+
+	return ratios
+}
+
+// CounterVec is a counter for each combination of label values
+type CounterVec struct {
+	vec    *prometheus.CounterVec
+	labels []string
+}
+
+// NewCounterVec creates and registers a CounterVec
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{
+		vec:    prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels),
+		labels: labels,
+	}
+	registry.MustRegister(c.vec)
+	return c
+}
+
+// Inc adds 1 to the counter
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.vec.WithLabelValues(labelValues...).Inc()
+}
+
+// Add adds a value to the counter. Counters only go up, so negative values are ignored.
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		return
+	}
+	c.vec.WithLabelValues(labelValues...).Add(delta)
+}
+
+// Value returns the counter's value
+func (c *CounterVec) Value(labelValues ...string) float64 {
+	return read(c.vec.WithLabelValues(labelValues...)).GetCounter().GetValue()
+}
+
+// each calls f with the label values and value of each counter, in the order of the vec's labels
+func (c *CounterVec) each(f func(labels []string, v float64)) {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		c.vec.Collect(ch)
+		close(ch)
+	}()
+
+	for m := range ch {
+		d := read(m)
+		byName := make(map[string]string, len(d.GetLabel()))
+		for _, l := range d.GetLabel() {
+			byName[l.GetName()] = l.GetValue()
+		}
+		values := make([]string, len(c.labels))
+		for i, l := range c.labels {
+			values[i] = byName[l]
+		}
+		f(values, d.GetCounter().GetValue())
+	}
+}
+
+// GaugeVec is a gauge for each combination of label values
+type GaugeVec struct {
+	vec *prometheus.GaugeVec
+}
+
+// NewGaugeVec creates and registers a GaugeVec
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	g := &GaugeVec{
+		vec: prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels),
+	}
+	registry.MustRegister(g.vec)
+	return g
+}
+
+// Set sets the gauge's value
+func (g *GaugeVec) Set(val float64, labelValues ...string) {
+	g.vec.WithLabelValues(labelValues...).Set(val)
+}
+
+// SetBool sets the gauge to 1 if true, or 0 if false
+func (g *GaugeVec) SetBool(val bool, labelValues ...string) {
+	if val {
+		g.Set(1, labelValues...)
+		return
+	}
+	g.Set(0, labelValues...)
+}
+
+// Add adds a value to the gauge
+func (g *GaugeVec) Add(delta float64, labelValues ...string) {
+	g.vec.WithLabelValues(labelValues...).Add(delta)
+}
+
+// Value returns the gauge's value
+func (g *GaugeVec) Value(labelValues ...string) float64 {
+	return read(g.vec.WithLabelValues(labelValues...)).GetGauge().GetValue()
+}
+
+// GaugeFunc is a gauge whose values are computed when metrics are collected. The function
+// returns values keyed by the value of the gauge's single label.
+type GaugeFunc struct {
+	desc *prometheus.Desc
+	fn   func() map[string]float64
+}
+
+// NewGaugeFunc creates and registers a GaugeFunc
+func NewGaugeFunc(name, help string, fn func() map[string]float64, label string) *GaugeFunc {
+	g := &GaugeFunc{
+		desc: prometheus.NewDesc(name, help, []string{label}, nil),
+		fn:   fn,
+	}
+	registry.MustRegister(g)
+	return g
+}
+
+// Describe implements prometheus.Collector
+func (g *GaugeFunc) Describe(ch chan<- *prometheus.Desc) {
+	ch <- g.desc
+}
+
+// Collect implements prometheus.Collector
+func (g *GaugeFunc) Collect(ch chan<- prometheus.Metric) {
+	for k, v := range g.fn() {
+		ch <- prometheus.MustNewConstMetric(g.desc, prometheus.GaugeValue, v, k)
+	}
+}
+
+// HistogramVec is a histogram for each combination of label values
+type HistogramVec struct {
+	vec *prometheus.HistogramVec
+}
+
+// NewHistogramVec creates and registers a HistogramVec
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	h := &HistogramVec{
+		vec: prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels),
+	}
+	registry.MustRegister(h.vec)
+	return h
+}
+
+// Observe adds a value to the histogram
+func (h *HistogramVec) Observe(val float64, labelValues ...string) {
+	h.vec.WithLabelValues(labelValues...).Observe(val)
+}
+
+// ObserveDuration adds the time since a start time to the histogram, in seconds
+func (h *HistogramVec) ObserveDuration(start time.Time, labelValues ...string) {
+	h.Observe(time.Since(start).Seconds(), labelValues...)
+}
+
+// Count returns the number of values added to the histogram
+func (h *HistogramVec) Count(labelValues ...string) uint64 {
+	m, ok := h.vec.WithLabelValues(labelValues...).(prometheus.Metric)
+	if !ok {
+		return 0
+	}
+	return read(m).GetHistogram().GetSampleCount()
+}
+
+// FPSMeter measures frames per second over the last second for each combination of label values
+type FPSMeter struct {
+	desc    *prometheus.Desc
+	windows map[string]*fpsWindow
+	sync.Mutex
+}
+
+type fpsWindow struct {
+	start  time.Time
+	last   time.Time
+	frames int
+	rate   float64
+}
+
+// NewFPSMeter creates and registers an FPSMeter
+func NewFPSMeter(name, help string, labels ...string) *FPSMeter {
+	f := &FPSMeter{
+		desc:    prometheus.NewDesc(name, help, labels, nil),
+		windows: make(map[string]*fpsWindow),
+	}
+	registry.MustRegister(f)
+	return f
+}
+
+// Frame records a frame being drawn
+func (f *FPSMeter) Frame(labelValues ...string) {
+	f.frame(time.Now(), labelValues)
+}
+
+func (f *FPSMeter) frame(now time.Time, labelValues []string) {
+	k := strings.Join(labelValues, "\xff")
+
+	f.Lock()
+	defer f.Unlock()
+	win, ok := f.windows[k]
+	if !ok {
+		f.windows[k] = &fpsWindow{start: now, last: now}
+		return
+	}
+	win.frames++
+	win.last = now
+	if elapsed := now.Sub(win.start); elapsed >= time.Second {
+		win.rate = float64(win.frames) / elapsed.Seconds()
+		win.start = now
+		win.frames = 0
+	}
+}
+
+// Rate returns the frames per second. It's 0 once no frames have been drawn for a couple seconds.
+func (f *FPSMeter) Rate(labelValues ...string) float64 {
+	return f.rate(time.Now(), strings.Join(labelValues, "\xff"))
+}
+
+func (f *FPSMeter) rate(now time.Time, k string) float64 {
+	f.Lock()
+	defer f.Unlock()
+	win, ok := f.windows[k]
+	if !ok || now.Sub(win.last) > 2*time.Second {
+		return 0
+	}
+	return win.rate
+}
+
+// Describe implements prometheus.Collector
+func (f *FPSMeter) Describe(ch chan<- *prometheus.Desc) {
+	ch <- f.desc
+}
+
+// Collect implements prometheus.Collector
+func (f *FPSMeter) Collect(ch chan<- prometheus.Metric) {
+	f.Lock()
+	keys := make([]string, 0, len(f.windows))
+	for k := range f.windows {
+		keys = append(keys, k)
+	}
+	f.Unlock()
+	sort.Strings(keys)
+
+	now := time.Now()
+	for _, k := range keys {
+		ch <- prometheus.MustNewConstMetric(f.desc, prometheus.GaugeValue, f.rate(now, k), strings.Split(k, "\xff")...)
+	}
+}
+
+// read gets the current value of a metric. Counters, gauges and histograms always write.
+func read(m prometheus.Metric) *dto.Metric {
+	d := &dto.Metric{}
+	_ = m.Write(d)
+	return d
+}
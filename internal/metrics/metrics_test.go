@@ -0,0 +1,203 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	counter := NewCounterVec("test_requests_total", "Test requests", "code")
+	counter.Inc("200")
+	counter.Add(2, "200")
+	counter.Inc("500")
+
+	hist := NewHistogramVec("test_duration_seconds", "Test durations", []float64{0.1, 1}, "board")
+	hist.Observe(0.05, "nfl")
+	hist.Observe(0.5, "nfl")
+
+	gauge := NewGaugeVec("test_on", "Test gauge")
+	gauge.SetBool(true)
+
+	fps := NewFPSMeter("test_handler_fps", "Test FPS", "matrix")
+	fps.Frame("test")
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(rec.Body)
+	require.NoError(t, err)
+
+	requests := families["test_requests_total"]
+	require.NotNil(t, requests)
+	require.Equal(t, dto.MetricType_COUNTER, requests.GetType())
+	values := map[string]float64{}
+	for _, m := range requests.GetMetric() {
+		values[m.GetLabel()[0].GetValue()] = m.GetCounter().GetValue()
+	}
+	require.Equal(t, map[string]float64{"200": 3, "500": 1}, values)
+
+	duration := families["test_duration_seconds"]
+	require.NotNil(t, duration)
+	h := duration.GetMetric()[0].GetHistogram()
+	require.Equal(t, uint64(2), h.GetSampleCount())
+	require.InDelta(t, 0.55, h.GetSampleSum(), 0.0001)
+	require.Equal(t, uint64(1), h.GetBucket()[0].GetCumulativeCount())
+	require.Equal(t, uint64(2), h.GetBucket()[1].GetCumulativeCount())
+
+	require.Equal(t, float64(1), families["test_on"].GetMetric()[0].GetGauge().GetValue())
+	require.Equal(t, dto.MetricType_GAUGE, families["test_handler_fps"].GetType())
+	require.Equal(t, float64(3), counter.Value("200"))
+	require.Equal(t, uint64(2), hist.Count("nfl"))
+}
+
+func TestCacheHitRatio(t *testing.T) {
+	t.Parallel()
+
+	CacheHit("test_cache")
+	CacheHit("test_cache")
+	CacheHit("test_cache")
+	CacheMiss("test_cache")
+
+	require.Equal(t, 0.75, cacheHitRatios()["test_cache"])
+}
+
+func TestFPSMeter(t *testing.T) {
+	t.Parallel()
+
+	f := NewFPSMeter("test_fps", "Test FPS", "matrix")
+	start := time.Now()
+	for i := 0; i <= 31; i++ {
+		f.frame(start.Add(time.Duration(i)*(time.Second/30)), []string{"test"})
+	}
+
+	require.InDelta(t, 30, f.rate(start.Add(1100*time.Millisecond), "test"), 0.1)
+	require.Equal(t, float64(0), f.rate(start.Add(5*time.Second), "test"))
+	require.Equal(t, float64(0), f.Rate("none"))
+}
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		url    string
+		source string
+		league string
+	}{
+		{
+			url:    "http://site.api.espn.com/apis/site/v2/sports/football/nfl/scoreboard?dates=20231121",
+			source: SourceESPN,
+			league: "nfl",
+		},
+		{
+			url:    "http://sports.core.api.espn.com/v2/sports/mma/leagues/ufc/events/100?lang=en",
+			source: SourceESPN,
+			league: "ufc",
+		},
+		{
+			url:    "https://site.web.api.espn.com/apis/site/v2/sports/golf/leaderboard?league=pga",
+			source: SourcePGA,
+			league: SourcePGA,
+		},
+		{
+			url:    "https://a.espncdn.com/i/teamlogos/nfl/500/dal.png",
+			source: SourceESPN,
+		},
+		{
+			url:    "https://statsapi.web.nhl.com/api/v1/schedule",
+			source: SourceNHL,
+			league: SourceNHL,
+		},
+		{
+			url:    "https://statsapi.mlb.com/api/v1/schedule",
+			source: SourceMLB,
+			league: SourceMLB,
+		},
+		{
+			url:    "https://www.googleapis.com/calendar/v3/calendars/primary/events",
+			source: SourceGcal,
+		},
+		{
+			url:    "https://notespn.com/feed",
+			source: SourceOther,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.url, func(t *testing.T) {
+			t.Parallel()
+			u, err := url.Parse(test.url)
+			require.NoError(t, err)
+			source, league := Classify(&http.Request{URL: u})
+			require.Equal(t, test.source, source)
+			require.Equal(t, test.league, league)
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestHealth(t *testing.T) {
+	t.Parallel()
+
+	status := http.StatusOK
+	var fail error
+	client := &http.Client{
+		Transport: NewTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if fail != nil {
+				return nil, fail
+			}
+			return &http.Response{StatusCode: status, Status: http.StatusText(status), Body: http.NoBody}, nil
+		})),
+	}
+
+	get := func() {
+		resp, err := client.Get("http://site.api.espn.com/apis/site/v2/sports/hockey/testleague/scoreboard")
+		if err == nil {
+			resp.Body.Close()
+		}
+	}
+
+	healthz := func() int {
+		rec := httptest.NewRecorder()
+		HealthHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		return rec.Code
+	}
+
+	get()
+	require.Equal(t, http.StatusOK, healthz())
+	require.Equal(t, uint64(1), UpstreamDuration.Count(SourceESPN, "testleague"))
+
+	status = http.StatusBadGateway
+	for i := 0; i < DefaultFailureThreshold-1; i++ {
+		get()
+	}
+	require.Equal(t, http.StatusOK, healthz())
+
+	fail = errors.New("connection refused")
+	get()
+	require.Equal(t, http.StatusServiceUnavailable, healthz())
+	require.Equal(t, float64(DefaultFailureThreshold), UpstreamErrors.Value(SourceESPN, "testleague"))
+	require.Equal(t, float64(DefaultFailureThreshold), UpstreamConsecutiveFailures.Value(SourceESPN, "testleague"))
+
+	fail = nil
+	status = http.StatusOK
+	get()
+	require.Equal(t, http.StatusOK, healthz())
+}
@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Upstream data sources
+const (
+	SourceESPN  = "espn"
+	SourceNHL   = "nhl"
+	SourceMLB   = "mlb"
+	SourcePGA   = "pga"
+	SourceGcal  = "gcal"
+	SourceOther = "other"
+)
+
+// Transport is an http.RoundTripper that records the latency, errors and health of requests
+// to upstream data sources
+type Transport struct {
+	Base http.RoundTripper
+}
+
+// NewTransport wraps an http.RoundTripper. http.DefaultTransport is used if base is nil.
+func NewTransport(base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{
+		Base: base,
+	}
+}
+
+// RoundTrip ...
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	source, league := Classify(req)
+	start := time.Now()
+
+	resp, err := t.Base.RoundTrip(req)
+
+	// Requests canceled because the board moved on say nothing about the source
+	if errors.Is(err, context.Canceled) {
+		return resp, err
+	}
+
+	UpstreamDuration.ObserveDuration(start, source, league)
+
+	switch {
+	case err != nil:
+		RecordFailure(source, league, err)
+	case resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests:
+		RecordFailure(source, league, fmt.Errorf("%s %s: %s", req.Method, req.URL.Path, resp.Status))
+	default:
+		RecordSuccess(source, league)
+	}
+
+	return resp, err
+}
+
+// Classify determines the data source and league of a request, ie. espn and nfl
+func Classify(req *http.Request) (source string, league string) {
+	host := strings.ToLower(req.URL.Hostname())
+
+	switch {
+	case hostIs(host, "espn.com"):
+		return classifyESPN(req)
+	case hostIs(host, "espncdn.com"):
+		return SourceESPN, ""
+	case hostIs(host, "nhl.com"), hostIs(host, "nhle.com"):
+		return SourceNHL, SourceNHL
+	case hostIs(host, "mlb.com"):
+		return SourceMLB, SourceMLB
+	case hostIs(host, "googleapis.com"):
+		return SourceGcal, ""
+	}
+
+	return SourceOther, ""
+}
+
+func hostIs(host string, domain string) bool {
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// classifyESPN finds the league in ESPN API paths, ie. /apis/site/v2/sports/football/nfl/scoreboard
+// or /v2/sports/mma/leagues/ufc/events
+func classifyESPN(req *http.Request) (string, string) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, p := range parts {
+		if p != "sports" || i+1 >= len(parts) {
+			continue
+		}
+
+		if parts[i+1] == "golf" {
+			if l := req.URL.Query().Get("league"); l != "" && l != SourcePGA {
+				return SourceESPN, strings.ToLower(l)
+			}
+			return SourcePGA, SourcePGA
+		}
+
+		if i+2 >= len(parts) {
+			return SourceESPN, ""
+		}
+		league := parts[i+2]
+		if league == "leagues" && i+3 < len(parts) {
+			league = parts[i+3]
+		}
+		return SourceESPN, strings.ToLower(league)
+	}
+
+	return SourceESPN, ""
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	"github.com/robbydyer/sports/internal/fetch"
 )
 
 // LiveGameGetter is a func used to retrieve an updated sportboard.Game
@@ -221,7 +222,7 @@ func GetLiveGame(ctx context.Context, link string) (sportboard.Game, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -265,7 +266,7 @@ func getGames(ctx context.Context, dateStr string) ([]*Game, error) {
 	if err != nil {
 		return nil, err
 	}
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	req = req.WithContext(ctx)
 
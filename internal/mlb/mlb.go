@@ -12,6 +12,7 @@ import (
 
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
 	"github.com/robbydyer/sports/internal/espn"
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/logo"
 )
 
@@ -69,6 +70,11 @@ func (m *MLB) CacheClear(ctx context.Context) {
 	_ = m.espnAPI.ClearCache()
 }
 
+// Stale implements board.StaleAPI
+func (m *MLB) Stale() bool {
+	return fetch.Stale(linkBase)
+}
+
 // HTTPPathPrefix returns the path prefix for the HTTP handlers for this board
 func (m *MLB) HTTPPathPrefix() string {
 	return "mlb"
@@ -14,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	statboard "github.com/robbydyer/sports/internal/board/stat"
+	"github.com/robbydyer/sports/internal/fetch"
 )
 
 const (
@@ -204,7 +205,7 @@ func (p *Player) setStats(ctx context.Context) error {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
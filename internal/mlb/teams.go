@@ -10,6 +10,8 @@ import (
 	"net/url"
 	"strconv"
 	"time"
+
+	"github.com/robbydyer/sports/internal/fetch"
 )
 
 //go:embed assets/divisions.json
@@ -104,7 +106,7 @@ func GetTeams(ctx context.Context) ([]*Team, error) {
 
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -166,7 +168,7 @@ func (t *Team) setRoster(ctx context.Context) error {
 
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -7,19 +7,21 @@ import (
 	"image/color"
 	"image/draw"
 	"strings"
+	"unicode/utf8"
 
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/internal/board"
 	"github.com/robbydyer/sports/internal/logo"
 	"github.com/robbydyer/sports/internal/rgbrender"
+	"github.com/robbydyer/sports/internal/theme"
 )
 
-var fillColor = color.RGBA{255, 255, 0, 100}
-
 type MlbLive struct {
 	Logger   *zap.Logger
 	FontSize float64
+	Font     *rgbrender.FontConfig
+	Theme    string
 	writer   *rgbrender.TextWriter
 }
 
@@ -107,16 +109,13 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 	draw.Draw(canvas, awayLogoBounds, awayLogoImg, image.Point{}, draw.Over)
 	draw.Draw(canvas, homeLogoBounds, homeLogoImg, image.Point{}, draw.Over)
 
-	var homeClr color.Color
-	var awayClr color.Color
-	homeClr, _, err = game.HomeColor()
-	if err != nil {
-		homeClr = color.White
-	}
-	awayClr, _, err = game.AwayColor()
-	if err != nil {
-		awayClr = color.White
-	}
+	th := theme.Resolve(m.Theme)
+	textClr := th.TextColor()
+	emptyClr := th.BackgroundColor()
+	fillColor := m.fillColor(th)
+
+	homeClr := teamColor(th, game.HomeColor)
+	awayClr := teamColor(th, game.AwayColor)
 
 	img := rgbrender.GradientXRectangle(awayScoreBounds, 0.0, awayClr, m.Logger)
 	draw.Draw(canvas, img.Bounds(), img, image.Pt(img.Bounds().Min.X, img.Bounds().Min.Y), draw.Over)
@@ -125,8 +124,8 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 
 	writer.XStartCorrection = 2
 
-	m.writeScore(canvas, awayScoreBounds, writer, game.AwayAbbrev(), awayScore, color.White)
-	m.writeScore(canvas, homeScoreBounds, writer, game.HomeAbbrev(), homeScore, color.White)
+	m.writeScore(canvas, awayScoreBounds, writer, game.AwayAbbrev(), awayScore, textClr)
+	m.writeScore(canvas, homeScoreBounds, writer, game.HomeAbbrev(), homeScore, textClr)
 
 	runners, err := game.GetRunners(ctx)
 	if err != nil {
@@ -146,7 +145,7 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 	)
 
 	var thirdFill color.Color
-	thirdFill = color.Black
+	thirdFill = emptyClr
 	if runners.Third {
 		thirdFill = fillColor
 	}
@@ -156,12 +155,12 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 		image.Pt(diamondStartShift, runnerBounds.Max.Y/2),
 		diamondW,
 		diamondW,
-		color.White,
+		textClr,
 		thirdFill,
 	)
 
 	var secondFill color.Color
-	secondFill = color.Black
+	secondFill = emptyClr
 	if runners.Second {
 		secondFill = fillColor
 	}
@@ -171,12 +170,12 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 		image.Pt(diamondStartShift+(diamondW/2)+2, runnerBounds.Max.Y/4),
 		diamondW,
 		diamondW,
-		color.White,
+		textClr,
 		secondFill,
 	)
 
 	var firstFill color.Color
-	firstFill = color.Black
+	firstFill = emptyClr
 	if runners.First {
 		firstFill = fillColor
 	}
@@ -186,7 +185,7 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 		image.Pt(diamondStartShift+(((diamondW/2)+2)*2), runnerBounds.Max.Y/2),
 		diamondW,
 		diamondW,
-		color.White,
+		textClr,
 		firstFill,
 	)
 
@@ -204,8 +203,8 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 			image.Pt(inningPointBounds.Min.X+1, inningPointBounds.Min.Y+(inningPointBounds.Dy()/2)),
 			inningPointBounds.Dx()/2,
 			inningPointBounds.Dx()/4,
-			color.White,
-			color.White,
+			textClr,
+			textClr,
 		)
 	} else {
 		rgbrender.DrawDownTriangle(
@@ -213,8 +212,8 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 			image.Pt(inningPointBounds.Min.X+1, inningPointBounds.Min.Y+(inningPointBounds.Dy()/2)),
 			inningPointBounds.Dx()/2,
 			inningPointBounds.Dx()/4,
-			color.White,
-			color.White,
+			textClr,
+			textClr,
 		)
 	}
 
@@ -230,7 +229,7 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 		[]string{
 			strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(inning.Number, "th", ""), "nd", ""), "st", ""), "rd", ""),
 		},
-		color.White,
+		textClr,
 	)
 
 	cnt, err := game.GetCount(ctx)
@@ -242,6 +241,9 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 	outBounds := image.Rect(countBounds.Min.X, zeroed.Max.Y-(zeroed.Dy()/4), countBounds.Min.X+(canvasWidth/4), zeroed.Max.Y)
 
 	writer.YStartCorrection = ((countBounds.Dy() - int(writer.FontSize)) / 2) - 3
+	if writer.IsBitmap() {
+		writer.YStartCorrection = (countBounds.Dy() - int(writer.FontSize)) / 2
+	}
 	m.Logger.Debug("MLB live count Y start correction",
 		zap.Int("count bounds Y", countBounds.Dy()),
 		zap.Int("font", int(writer.FontSize)),
@@ -253,13 +255,13 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 		[]string{
 			cnt,
 		},
-		color.White,
+		textClr,
 	)
 
 	var out1Clr color.Color
 	var out2Clr color.Color
-	out1Clr = color.Black
-	out2Clr = color.Black
+	out1Clr = emptyClr
+	out2Clr = emptyClr
 	if inning.Outs == 1 {
 		out1Clr = fillColor
 	}
@@ -274,7 +276,7 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 		canvas,
 		image.Pt(outBounds.Min.X, outBounds.Min.Y+2),
 		outBox,
-		color.White,
+		textClr,
 		out1Clr,
 	)
 	// Out 2
@@ -282,7 +284,7 @@ func (m *MlbLive) RenderLive(ctx context.Context, canvas board.Canvas, game Game
 		canvas,
 		image.Pt(outBounds.Min.X+outBox+2, outBounds.Min.Y+2),
 		outBox,
-		color.White,
+		textClr,
 		out2Clr,
 	)
 
@@ -315,19 +317,43 @@ func (m *MlbLive) getWriter(canvasBounds image.Rectangle) (*rgbrender.TextWriter
 		w.YStartCorrection = -1 * ((canvasBounds.Dy() / 32) + 1)
 	}
 
+	if err := w.Configure(m.Font); err != nil {
+		return nil, err
+	}
+
 	m.writer = w
 
 	return w, nil
 }
 
+// fillColor is the translucent theme highlight of occupied bases and outs
+func (m *MlbLive) fillColor(th *theme.Theme) color.Color {
+	r, g, b, _ := th.HighlightColor().RGBA()
+	return color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8), 100}
+}
+
+// teamColor is a team's color, lightened if it's too dark to see against black
+func teamColor(th *theme.Theme, getColor func() (*color.RGBA, *color.RGBA, error)) color.Color {
+	primary, alternate, err := getColor()
+	if err != nil || primary == nil {
+		return th.TextColor()
+	}
+	var alt color.Color
+	if alternate != nil {
+		alt = alternate
+	}
+
+	return th.TeamColor(primary, alt)
+}
+
 func (m *MlbLive) writeScore(canvas draw.Image, bounds image.Rectangle, writer *rgbrender.TextWriter, abbrev string, score int, teamClr color.Color) {
-	clrs := make([]color.Color, len(abbrev))
+	clrs := make([]color.Color, utf8.RuneCountInString(abbrev))
 	for x := 0; x < len(clrs); x++ {
 		clrs[x] = teamClr
 	}
 	scrClrs := make([]color.Color, len(fmt.Sprintf("%d", score)))
 	for x := 0; x < len(scrClrs); x++ {
-		scrClrs[x] = color.White
+		scrClrs[x] = theme.Resolve(m.Theme).ScoreColor()
 	}
 
 	_ = writer.WriteColorCodes(
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
+	"github.com/robbydyer/sports/internal/fetch"
 )
 
 // LiveGameGetter retrieves a live game from a game link
@@ -214,7 +215,7 @@ func GetLiveGame(ctx context.Context, link string) (sportboard.Game, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -249,7 +250,7 @@ func getGames(ctx context.Context, dateStr string) ([]*Game, error) {
 	if err != nil {
 		return nil, err
 	}
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	req = req.WithContext(ctx)
 
@@ -11,6 +11,7 @@ import (
 
 	sportboard "github.com/robbydyer/sports/internal/board/sport"
 	"github.com/robbydyer/sports/internal/espn"
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/logo"
 )
 
@@ -70,6 +71,11 @@ func (n *NHL) CacheClear(ctx context.Context) {
 	_ = n.espnAPI.ClearCache()
 }
 
+// Stale implements board.StaleAPI
+func (n *NHL) Stale() bool {
+	return fetch.Stale(linkBase)
+}
+
 // HTTPPathPrefix returns the prefix of HTTP calls to this board. i.e. /nhl/foo
 func (n *NHL) HTTPPathPrefix() string {
 	return "nhl"
@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	statboard "github.com/robbydyer/sports/internal/board/stat"
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/util"
 )
 
@@ -202,7 +203,7 @@ func (p *Player) setStats(ctx context.Context) error {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
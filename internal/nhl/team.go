@@ -12,6 +12,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/internal/fetch"
 	"github.com/robbydyer/sports/internal/util"
 )
 
@@ -172,7 +173,7 @@ func (n *NHL) getTeamAPIData(ctx context.Context) ([]byte, error) {
 
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list teams: %w", err)
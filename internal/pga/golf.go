@@ -0,0 +1,213 @@
+package pga
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	golfboard "github.com/robbydyer/sports/internal/board/golf"
+	"github.com/robbydyer/sports/internal/fetch"
+)
+
+const golfLeaderboardURL = "https://site.web.api.espn.com/apis/site/v2/sports/golf/leaderboard"
+
+var teeTimeFormats = []string{
+	"2006-01-02T15:04Z",
+	time.RFC3339,
+	time.UnixDate,
+}
+
+// Golf implements golfboard.API for a tour
+type Golf struct {
+	leaguer Leaguer
+	log     *zap.Logger
+}
+
+type tournamentDat struct {
+	Events []*struct {
+		Name       string `json:"name"`
+		ShortName  string `json:"shortName"`
+		Tournament struct {
+			CutRound int      `json:"cutRound"`
+			CutScore *float64 `json:"cutScore"`
+		} `json:"tournament"`
+		Competitions []*struct {
+			Status struct {
+				Period int `json:"period"`
+			} `json:"status"`
+			Competitors []*Player `json:"competitors"`
+		} `json:"competitions"`
+	} `json:"events"`
+}
+
+// NewGolf ...
+func NewGolf(leaguer Leaguer, logger *zap.Logger) *Golf {
+	return &Golf{
+		leaguer: leaguer,
+		log:     logger,
+	}
+}
+
+// LeagueShortName ...
+func (g *Golf) LeagueShortName() string {
+	return g.leaguer.ShortName()
+}
+
+// HTTPPathPrefix ...
+func (g *Golf) HTTPPathPrefix() string {
+	return g.leaguer.HTTPPathPrefix()
+}
+
+// Stale implements board.StaleAPI
+func (g *Golf) Stale() bool {
+	return fetch.Stale(fmt.Sprintf("%s?league=%s", golfLeaderboardURL, g.leaguer.APIPath()))
+}
+
+// GetTournament ...
+func (g *Golf) GetTournament(ctx context.Context) (*golfboard.Tournament, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s?league=%s", golfLeaderboardURL, g.leaguer.APIPath()), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	client := fetch.Client()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := parseTournament(body)
+	if err != nil {
+		return nil, err
+	}
+
+	g.log.Debug("golf tournament",
+		zap.String("tour", g.leaguer.ShortName()),
+		zap.String("tournament", t.Name),
+		zap.Int("round", t.Round),
+		zap.Int("players", len(t.Players)),
+	)
+
+	return t, nil
+}
+
+func parseTournament(body []byte) (*golfboard.Tournament, error) {
+	var dat *tournamentDat
+	if err := json.Unmarshal(body, &dat); err != nil {
+		return nil, err
+	}
+
+	if len(dat.Events) < 1 || len(dat.Events[0].Competitions) < 1 {
+		return nil, fmt.Errorf("could not find tournament")
+	}
+
+	event := dat.Events[0]
+	comp := event.Competitions[0]
+
+	t := &golfboard.Tournament{
+		Name:  event.ShortName,
+		Round: comp.Status.Period,
+	}
+	if t.Name == "" {
+		t.Name = event.Name
+	}
+
+	// The cut line is only meaningful until the cut is made
+	if event.Tournament.CutScore != nil && (event.Tournament.CutRound == 0 || t.Round <= event.Tournament.CutRound) {
+		t.CutLine = toParString(int(*event.Tournament.CutScore))
+	}
+
+	for i, c := range comp.Competitors {
+		p := &golfboard.Player{
+			ID:       c.ID,
+			Name:     c.Athlete.DisplayName,
+			Position: c.Status.Position.DisplayName,
+			Order:    c.SortOrder,
+			Score:    c.GetStat("score"),
+			Movement: c.Movement,
+			Cut:      c.Status.Type.Name == "STATUS_CUT" || c.Status.Type.Name == "STATUS_WD",
+			TeeTime:  parseTeeTime(c.Status.TeeTime),
+			Holes:    c.holes(t.Round),
+		}
+		if c.Team != nil {
+			p.Name = c.Team.DisplayName
+		}
+		if p.Order == 0 {
+			p.Order = i + 1
+		}
+		if c.Status.Thru > 0 {
+			p.Thru = fmt.Sprint(c.Status.Thru)
+			if c.Status.Thru >= 18 {
+				p.Thru = "F"
+			}
+		}
+
+		t.Players = append(t.Players, p)
+	}
+
+	return t, nil
+}
+
+// holes returns the player's hole by hole scores for a round
+func (p *Player) holes(round int) []*golfboard.Hole {
+	holes := []*golfboard.Hole{}
+	for _, r := range p.Linescores {
+		if r.Period != round {
+			continue
+		}
+		for _, h := range r.Linescores {
+			holes = append(holes, &golfboard.Hole{
+				Number:  h.Period,
+				Strokes: int(h.Value),
+				ToPar:   parseToPar(h.ScoreType.DisplayValue),
+			})
+		}
+	}
+
+	return holes
+}
+
+func parseTeeTime(s string) time.Time {
+	for _, f := range teeTimeFormats {
+		t, err := time.Parse(f, s)
+		if err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// parseToPar converts scores such as "-1", "E" and "+2" to an int
+func parseToPar(s string) int {
+	i, err := strconv.Atoi(strings.TrimPrefix(s, "+"))
+	if err != nil {
+		return 0
+	}
+	return i
+}
+
+func toParString(i int) string {
+	switch {
+	case i == 0:
+		return "E"
+	case i > 0:
+		return fmt.Sprintf("+%d", i)
+	}
+	return fmt.Sprint(i)
+}
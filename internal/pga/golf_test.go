@@ -0,0 +1,87 @@
+package pga
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const leaderboardJSON = `{
+  "events": [{
+    "name": "The Masters Tournament",
+    "shortName": "The Masters",
+    "tournament": {"cutRound": 2, "cutScore": 1},
+    "competitions": [{
+      "status": {"period": 2},
+      "competitors": [
+        {
+          "id": "1",
+          "athlete": {"displayName": "Scottie Scheffler"},
+          "status": {"thru": 18, "period": 2, "position": {"displayName": "1"}, "type": {"name": "STATUS_FINISH"}},
+          "score": {"displayValue": "-8"},
+          "sortOrder": 1,
+          "movement": 3,
+          "linescores": [
+            {"period": 1, "value": 68, "linescores": [{"period": 1, "value": 3, "scoreType": {"displayValue": "-1"}}]},
+            {"period": 2, "value": 68, "linescores": [
+              {"period": 1, "value": 4, "scoreType": {"displayValue": "E"}},
+              {"period": 2, "value": 6, "scoreType": {"displayValue": "+1"}}
+            ]}
+          ]
+        },
+        {
+          "id": "2",
+          "athlete": {"displayName": "Rory McIlroy"},
+          "status": {"teeTime": "2025-04-11T14:30Z", "period": 2, "position": {"displayName": "T60"}, "type": {"name": "STATUS_CUT"}},
+          "score": {"displayValue": "+3"},
+          "sortOrder": 60,
+          "movement": -4
+        }
+      ]
+    }]
+  }]
+}`
+
+func TestParseTournament(t *testing.T) {
+	t.Parallel()
+
+	tourn, err := parseTournament([]byte(leaderboardJSON))
+	require.NoError(t, err)
+
+	require.Equal(t, "The Masters", tourn.Name)
+	require.Equal(t, 2, tourn.Round)
+	require.Equal(t, "+1", tourn.CutLine)
+	require.Len(t, tourn.Players, 2)
+	require.True(t, tourn.Started())
+
+	leader := tourn.Players[0]
+	require.Equal(t, "Scottie Scheffler", leader.Name)
+	require.Equal(t, "-8", leader.Score)
+	require.Equal(t, "F", leader.Thru)
+	require.Equal(t, 3, leader.Movement)
+	require.False(t, leader.Cut)
+	require.Len(t, leader.Holes, 2)
+	require.Equal(t, 6, leader.Holes[1].Strokes)
+	require.Equal(t, 1, leader.Holes[1].ToPar)
+
+	cut := tourn.Players[1]
+	require.True(t, cut.Cut)
+	require.Equal(t, -4, cut.Movement)
+	require.Equal(t, "", cut.Thru)
+	require.False(t, cut.TeeTime.IsZero())
+
+	_, err = parseTournament([]byte(`{"events": []}`))
+	require.Error(t, err)
+}
+
+func TestGetLeaguer(t *testing.T) {
+	t.Parallel()
+
+	for _, tour := range []string{"pga", "lpga", "dpworld", "LIV"} {
+		_, err := GetLeaguer(tour)
+		require.NoError(t, err)
+	}
+
+	_, err := GetLeaguer("nope")
+	require.Error(t, err)
+}
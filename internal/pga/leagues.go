@@ -0,0 +1,101 @@
+package pga
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Leaguer ...
+type Leaguer interface {
+	ShortName() string
+	HTTPPathPrefix() string
+	APIPath() string
+}
+
+// GetLeaguer returns the Leaguer for a golf tour by its HTTP path prefix, such as "pga" or "lpga"
+func GetLeaguer(tour string) (Leaguer, error) {
+	switch strings.Trim(strings.ToLower(tour), " ") {
+	case "pga":
+		return &PGATour{}, nil
+	case "lpga":
+		return &LPGA{}, nil
+	case "dpworld":
+		return &DPWorld{}, nil
+	case "liv":
+		return &LIV{}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported golf tour '%s'", tour)
+}
+
+// PGATour ...
+type PGATour struct{}
+
+// ShortName ...
+func (a *PGATour) ShortName() string {
+	return "PGA"
+}
+
+// HTTPPathPrefix ...
+func (a *PGATour) HTTPPathPrefix() string {
+	return "pga"
+}
+
+// APIPath ...
+func (a *PGATour) APIPath() string {
+	return "pga"
+}
+
+// LPGA ...
+type LPGA struct{}
+
+// ShortName ...
+func (a *LPGA) ShortName() string {
+	return "LPGA"
+}
+
+// HTTPPathPrefix ...
+func (a *LPGA) HTTPPathPrefix() string {
+	return "lpga"
+}
+
+// APIPath ...
+func (a *LPGA) APIPath() string {
+	return "lpga"
+}
+
+// DPWorld ...
+type DPWorld struct{}
+
+// ShortName ...
+func (a *DPWorld) ShortName() string {
+	return "DP World"
+}
+
+// HTTPPathPrefix ...
+func (a *DPWorld) HTTPPathPrefix() string {
+	return "dpworld"
+}
+
+// APIPath ...
+func (a *DPWorld) APIPath() string {
+	return "eur"
+}
+
+// LIV ...
+type LIV struct{}
+
+// ShortName ...
+func (a *LIV) ShortName() string {
+	return "LIV"
+}
+
+// HTTPPathPrefix ...
+func (a *LIV) HTTPPathPrefix() string {
+	return "liv"
+}
+
+// APIPath ...
+func (a *LIV) APIPath() string {
+	return "liv"
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/robfig/cron/v3"
 
 	statboard "github.com/robbydyer/sports/internal/board/stat"
+	"github.com/robbydyer/sports/internal/fetch"
 )
 
 const leaderboardURL = "https://site.web.api.espn.com/apis/site/v2/sports/golf/leaderboard?league=pga"
@@ -138,7 +139,7 @@ func (p *PGA) updatePlayers(ctx context.Context) ([]*Player, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	statboard "github.com/robbydyer/sports/internal/board/stat"
+	"github.com/robbydyer/sports/internal/locale"
 )
 
 // Player ...
@@ -21,19 +22,38 @@ type Player struct {
 		TeeTime  string `json:"teeTime"`
 		Hole     int    `json:"hole"`
 		Thru     int    `json:"thru"`
+		Period   int    `json:"period"`
 		Position struct {
 			DisplayName string `json:"displayName"`
 			ID          string `json:"id"`
 		}
+		Type struct {
+			Name string `json:"name"`
+		} `json:"type"`
 	} `json:"status"`
 	Score struct {
 		DisplayValue string `json:"displayValue"`
 	} `json:"score"`
 	SortOrder  int `json:"sortOrder"`
+	Movement   int `json:"movement"`
 	Statistics []*struct {
 		Name         string `json:"name"`
 		DisplayValue string `json:"displayValue"`
 	} `json:"statistics"`
+	Linescores []*roundScore `json:"linescores"`
+}
+
+// roundScore is a player's score for a round. Its Linescores are the scores for each hole.
+type roundScore struct {
+	Period     int     `json:"period"`
+	Value      float64 `json:"value"`
+	Linescores []*struct {
+		Period    int     `json:"period"`
+		Value     float64 `json:"value"`
+		ScoreType struct {
+			DisplayValue string `json:"displayValue"`
+		} `json:"scoreType"`
+	} `json:"linescores"`
 }
 
 type athlete struct {
@@ -95,7 +115,7 @@ func (p *Player) GetStat(stat string) string {
 		if time.Until(t) < 0 {
 			return ""
 		}
-		return t.Local().Format("03:04PM")
+		return locale.Current().Time(t)
 	case "hole":
 		return fmt.Sprint(p.Status.Thru)
 	case "score":
@@ -272,6 +272,243 @@ func (x *LiveOnlyReq) GetLiveOnly() bool {
 	return false
 }
 
+type CurrentState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreenOn         bool          `protobuf:"varint,1,opt,name=screen_on,json=screenOn,proto3" json:"screen_on,omitempty"`
+	Board            string        `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Item             *BoardItem    `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	SecondsRemaining float64       `protobuf:"fixed64,4,opt,name=seconds_remaining,json=secondsRemaining,proto3" json:"seconds_remaining,omitempty"`
+	Upcoming         []string      `protobuf:"bytes,5,rep,name=upcoming,proto3" json:"upcoming,omitempty"`
+	Boards           []*BoardState `protobuf:"bytes,6,rep,name=boards,proto3" json:"boards,omitempty"`
+}
+
+func (x *CurrentState) Reset() {
+	*x = CurrentState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentState) ProtoMessage() {}
+
+func (x *CurrentState) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentState.ProtoReflect.Descriptor instead.
+func (*CurrentState) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CurrentState) GetScreenOn() bool {
+	if x != nil {
+		return x.ScreenOn
+	}
+	return false
+}
+
+func (x *CurrentState) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *CurrentState) GetItem() *BoardItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *CurrentState) GetSecondsRemaining() float64 {
+	if x != nil {
+		return x.SecondsRemaining
+	}
+	return 0
+}
+
+func (x *CurrentState) GetUpcoming() []string {
+	if x != nil {
+		return x.Upcoming
+	}
+	return nil
+}
+
+func (x *CurrentState) GetBoards() []*BoardState {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+type BoardItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Teams []string `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
+}
+
+func (x *BoardItem) Reset() {
+	*x = BoardItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardItem) ProtoMessage() {}
+
+func (x *BoardItem) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardItem.ProtoReflect.Descriptor instead.
+func (*BoardItem) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BoardItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BoardItem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BoardItem) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type BoardState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled       bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	SkipReason    string `protobuf:"bytes,3,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+	LastError     string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime int64  `protobuf:"varint,5,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	LastShownTime int64  `protobuf:"varint,6,opt,name=last_shown_time,json=lastShownTime,proto3" json:"last_shown_time,omitempty"`
+}
+
+func (x *BoardState) Reset() {
+	*x = BoardState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardState) ProtoMessage() {}
+
+func (x *BoardState) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardState.ProtoReflect.Descriptor instead.
+func (*BoardState) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BoardState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardState) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BoardState) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+func (x *BoardState) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *BoardState) GetLastErrorTime() int64 {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return 0
+}
+
+func (x *BoardState) GetLastShownTime() int64 {
+	if x != nil {
+		return x.LastShownTime
+	}
+	return 0
+}
+
 var File_sportsmatrix_sportsmatrix_proto protoreflect.FileDescriptor
 
 var file_sportsmatrix_sportsmatrix_proto_rawDesc = []byte{
@@ -296,45 +533,81 @@ var file_sportsmatrix_sportsmatrix_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x2a, 0x0a, 0x0b, 0x4c,
 	0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69,
 	0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c,
-	0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x32, 0xdc, 0x04, 0x0a, 0x0c, 0x53, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x39, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6d, 0x61,
-	0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x08, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12,
+	0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xe3, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2d,
+	0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0x47, 0x0a,
+	0x09, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x32, 0xa0, 0x05, 0x0a, 0x0c, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x6d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x12, 0x39, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x3b, 0x0a, 0x09, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x12, 0x16, 0x2e, 0x67,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x3a, 0x0a, 0x08, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x53,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e,
+	0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x36, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x2e,
+	0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x32, 0x0a, 0x04, 0x4a, 0x75, 0x6d, 0x70, 0x12, 0x12, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x06,
-	0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x04, 0x4a, 0x75, 0x6d, 0x70, 0x12, 0x12, 0x2e, 0x6d,
-	0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x69,
-	0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x79, 0x12, 0x40, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e,
+	0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x62, 0x62, 0x79, 0x64, 0x79, 0x65, 0x72, 0x2f, 0x73,
 	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x6d, 0x61, 0x74, 0x72, 0x69,
@@ -353,41 +626,48 @@ func file_sportsmatrix_sportsmatrix_proto_rawDescGZIP() []byte {
 	return file_sportsmatrix_sportsmatrix_proto_rawDescData
 }
 
-var file_sportsmatrix_sportsmatrix_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_sportsmatrix_sportsmatrix_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_sportsmatrix_sportsmatrix_proto_goTypes = []interface{}{
-	(*VersionResp)(nil), // 0: matrix.v1.VersionResp
-	(*Status)(nil),      // 1: matrix.v1.Status
-	(*SetAllReq)(nil),   // 2: matrix.v1.SetAllReq
-	(*JumpReq)(nil),     // 3: matrix.v1.JumpReq
-	(*LiveOnlyReq)(nil), // 4: matrix.v1.LiveOnlyReq
-	(*empty.Empty)(nil), // 5: google.protobuf.Empty
+	(*VersionResp)(nil),  // 0: matrix.v1.VersionResp
+	(*Status)(nil),       // 1: matrix.v1.Status
+	(*SetAllReq)(nil),    // 2: matrix.v1.SetAllReq
+	(*JumpReq)(nil),      // 3: matrix.v1.JumpReq
+	(*LiveOnlyReq)(nil),  // 4: matrix.v1.LiveOnlyReq
+	(*CurrentState)(nil), // 5: matrix.v1.CurrentState
+	(*BoardItem)(nil),    // 6: matrix.v1.BoardItem
+	(*BoardState)(nil),   // 7: matrix.v1.BoardState
+	(*empty.Empty)(nil),  // 8: google.protobuf.Empty
 }
 var file_sportsmatrix_sportsmatrix_proto_depIdxs = []int32{
-	5,  // 0: matrix.v1.Sportsmatrix.Version:input_type -> google.protobuf.Empty
-	5,  // 1: matrix.v1.Sportsmatrix.ScreenOn:input_type -> google.protobuf.Empty
-	5,  // 2: matrix.v1.Sportsmatrix.ScreenOff:input_type -> google.protobuf.Empty
-	5,  // 3: matrix.v1.Sportsmatrix.GetStatus:input_type -> google.protobuf.Empty
-	1,  // 4: matrix.v1.Sportsmatrix.SetStatus:input_type -> matrix.v1.Status
-	2,  // 5: matrix.v1.Sportsmatrix.SetAll:input_type -> matrix.v1.SetAllReq
-	3,  // 6: matrix.v1.Sportsmatrix.Jump:input_type -> matrix.v1.JumpReq
-	5,  // 7: matrix.v1.Sportsmatrix.NextBoard:input_type -> google.protobuf.Empty
-	5,  // 8: matrix.v1.Sportsmatrix.RestartService:input_type -> google.protobuf.Empty
-	4,  // 9: matrix.v1.Sportsmatrix.SetLiveOnly:input_type -> matrix.v1.LiveOnlyReq
-	0,  // 10: matrix.v1.Sportsmatrix.Version:output_type -> matrix.v1.VersionResp
-	5,  // 11: matrix.v1.Sportsmatrix.ScreenOn:output_type -> google.protobuf.Empty
-	5,  // 12: matrix.v1.Sportsmatrix.ScreenOff:output_type -> google.protobuf.Empty
-	1,  // 13: matrix.v1.Sportsmatrix.GetStatus:output_type -> matrix.v1.Status
-	5,  // 14: matrix.v1.Sportsmatrix.SetStatus:output_type -> google.protobuf.Empty
-	5,  // 15: matrix.v1.Sportsmatrix.SetAll:output_type -> google.protobuf.Empty
-	5,  // 16: matrix.v1.Sportsmatrix.Jump:output_type -> google.protobuf.Empty
-	5,  // 17: matrix.v1.Sportsmatrix.NextBoard:output_type -> google.protobuf.Empty
-	5,  // 18: matrix.v1.Sportsmatrix.RestartService:output_type -> google.protobuf.Empty
-	5,  // 19: matrix.v1.Sportsmatrix.SetLiveOnly:output_type -> google.protobuf.Empty
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	6,  // 0: matrix.v1.CurrentState.item:type_name -> matrix.v1.BoardItem
+	7,  // 1: matrix.v1.CurrentState.boards:type_name -> matrix.v1.BoardState
+	8,  // 2: matrix.v1.Sportsmatrix.Version:input_type -> google.protobuf.Empty
+	8,  // 3: matrix.v1.Sportsmatrix.ScreenOn:input_type -> google.protobuf.Empty
+	8,  // 4: matrix.v1.Sportsmatrix.ScreenOff:input_type -> google.protobuf.Empty
+	8,  // 5: matrix.v1.Sportsmatrix.GetStatus:input_type -> google.protobuf.Empty
+	1,  // 6: matrix.v1.Sportsmatrix.SetStatus:input_type -> matrix.v1.Status
+	2,  // 7: matrix.v1.Sportsmatrix.SetAll:input_type -> matrix.v1.SetAllReq
+	3,  // 8: matrix.v1.Sportsmatrix.Jump:input_type -> matrix.v1.JumpReq
+	8,  // 9: matrix.v1.Sportsmatrix.NextBoard:input_type -> google.protobuf.Empty
+	8,  // 10: matrix.v1.Sportsmatrix.RestartService:input_type -> google.protobuf.Empty
+	4,  // 11: matrix.v1.Sportsmatrix.SetLiveOnly:input_type -> matrix.v1.LiveOnlyReq
+	8,  // 12: matrix.v1.Sportsmatrix.GetCurrentState:input_type -> google.protobuf.Empty
+	0,  // 13: matrix.v1.Sportsmatrix.Version:output_type -> matrix.v1.VersionResp
+	8,  // 14: matrix.v1.Sportsmatrix.ScreenOn:output_type -> google.protobuf.Empty
+	8,  // 15: matrix.v1.Sportsmatrix.ScreenOff:output_type -> google.protobuf.Empty
+	1,  // 16: matrix.v1.Sportsmatrix.GetStatus:output_type -> matrix.v1.Status
+	8,  // 17: matrix.v1.Sportsmatrix.SetStatus:output_type -> google.protobuf.Empty
+	8,  // 18: matrix.v1.Sportsmatrix.SetAll:output_type -> google.protobuf.Empty
+	8,  // 19: matrix.v1.Sportsmatrix.Jump:output_type -> google.protobuf.Empty
+	8,  // 20: matrix.v1.Sportsmatrix.NextBoard:output_type -> google.protobuf.Empty
+	8,  // 21: matrix.v1.Sportsmatrix.RestartService:output_type -> google.protobuf.Empty
+	8,  // 22: matrix.v1.Sportsmatrix.SetLiveOnly:output_type -> google.protobuf.Empty
+	5,  // 23: matrix.v1.Sportsmatrix.GetCurrentState:output_type -> matrix.v1.CurrentState
+	13, // [13:24] is the sub-list for method output_type
+	2,  // [2:13] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_sportsmatrix_sportsmatrix_proto_init() }
@@ -456,6 +736,42 @@ func file_sportsmatrix_sportsmatrix_proto_init() {
 				return nil
 			}
 		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -463,7 +779,7 @@ func file_sportsmatrix_sportsmatrix_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sportsmatrix_sportsmatrix_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
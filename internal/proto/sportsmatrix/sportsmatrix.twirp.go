@@ -53,6 +53,8 @@ type Sportsmatrix interface {
 	RestartService(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
 
 	SetLiveOnly(context.Context, *LiveOnlyReq) (*google_protobuf.Empty, error)
+
+	GetCurrentState(context.Context, *google_protobuf.Empty) (*CurrentState, error)
 }
 
 // ============================
@@ -61,7 +63,7 @@ type Sportsmatrix interface {
 
 type sportsmatrixProtobufClient struct {
 	client      HTTPClient
-	urls        [10]string
+	urls        [11]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -89,7 +91,7 @@ func NewSportsmatrixProtobufClient(baseURL string, client HTTPClient, opts ...tw
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "matrix.v1", "Sportsmatrix")
-	urls := [10]string{
+	urls := [11]string{
 		serviceURL + "Version",
 		serviceURL + "ScreenOn",
 		serviceURL + "ScreenOff",
@@ -100,6 +102,7 @@ func NewSportsmatrixProtobufClient(baseURL string, client HTTPClient, opts ...tw
 		serviceURL + "NextBoard",
 		serviceURL + "RestartService",
 		serviceURL + "SetLiveOnly",
+		serviceURL + "GetCurrentState",
 	}
 
 	return &sportsmatrixProtobufClient{
@@ -570,13 +573,59 @@ func (c *sportsmatrixProtobufClient) callSetLiveOnly(ctx context.Context, in *Li
 	return out, nil
 }
 
+func (c *sportsmatrixProtobufClient) GetCurrentState(ctx context.Context, in *google_protobuf.Empty) (*CurrentState, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCurrentState")
+	caller := c.callGetCurrentState
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*CurrentState, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetCurrentState(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CurrentState)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CurrentState) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixProtobufClient) callGetCurrentState(ctx context.Context, in *google_protobuf.Empty) (*CurrentState, error) {
+	out := new(CurrentState)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ========================
 // Sportsmatrix JSON Client
 // ========================
 
 type sportsmatrixJSONClient struct {
 	client      HTTPClient
-	urls        [10]string
+	urls        [11]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -604,7 +653,7 @@ func NewSportsmatrixJSONClient(baseURL string, client HTTPClient, opts ...twirp.
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "matrix.v1", "Sportsmatrix")
-	urls := [10]string{
+	urls := [11]string{
 		serviceURL + "Version",
 		serviceURL + "ScreenOn",
 		serviceURL + "ScreenOff",
@@ -615,6 +664,7 @@ func NewSportsmatrixJSONClient(baseURL string, client HTTPClient, opts ...twirp.
 		serviceURL + "NextBoard",
 		serviceURL + "RestartService",
 		serviceURL + "SetLiveOnly",
+		serviceURL + "GetCurrentState",
 	}
 
 	return &sportsmatrixJSONClient{
@@ -1085,6 +1135,52 @@ func (c *sportsmatrixJSONClient) callSetLiveOnly(ctx context.Context, in *LiveOn
 	return out, nil
 }
 
+func (c *sportsmatrixJSONClient) GetCurrentState(ctx context.Context, in *google_protobuf.Empty) (*CurrentState, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCurrentState")
+	caller := c.callGetCurrentState
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*CurrentState, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetCurrentState(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CurrentState)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CurrentState) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callGetCurrentState(ctx context.Context, in *google_protobuf.Empty) (*CurrentState, error) {
+	out := new(CurrentState)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===========================
 // Sportsmatrix Server Handler
 // ===========================
@@ -1212,6 +1308,9 @@ func (s *sportsmatrixServer) ServeHTTP(resp http.ResponseWriter, req *http.Reque
 	case "SetLiveOnly":
 		s.serveSetLiveOnly(ctx, resp, req)
 		return
+	case "GetCurrentState":
+		s.serveGetCurrentState(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -3019,6 +3118,186 @@ func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *sportsmatrixServer) serveGetCurrentState(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetCurrentStateJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetCurrentStateProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveGetCurrentStateJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCurrentState")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.GetCurrentState
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*CurrentState, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.GetCurrentState(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CurrentState)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CurrentState) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CurrentState
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CurrentState and nil error while calling GetCurrentState. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveGetCurrentStateProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCurrentState")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.GetCurrentState
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*CurrentState, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.GetCurrentState(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CurrentState)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CurrentState) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CurrentState
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CurrentState and nil error while calling GetCurrentState. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *sportsmatrixServer) ServiceDescriptor() ([]byte, int) {
 	return twirpFileDescriptor0, 0
 }
@@ -3600,32 +3879,48 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xd1, 0x6a, 0xd4, 0x40,
-	0x14, 0xa5, 0x5a, 0x77, 0x37, 0x37, 0x52, 0x71, 0x28, 0x65, 0xe9, 0x3e, 0xac, 0x04, 0xa4, 0xe2,
-	0x43, 0x82, 0x15, 0x2a, 0x55, 0x04, 0x2d, 0x88, 0x20, 0xe2, 0x42, 0x02, 0x3e, 0xf8, 0xb2, 0x64,
-	0x92, 0xbb, 0xeb, 0xc0, 0x64, 0x26, 0xce, 0x4c, 0x62, 0xf3, 0xaf, 0x7e, 0x8c, 0x24, 0x99, 0x91,
-	0x11, 0x4d, 0x61, 0x1f, 0xcf, 0xb9, 0xe7, 0x64, 0x2e, 0xe7, 0xe4, 0xc2, 0x5a, 0xd7, 0x52, 0x19,
-	0x5d, 0xe5, 0x46, 0xb1, 0xdb, 0xc4, 0x07, 0x71, 0xad, 0xa4, 0x91, 0x24, 0xb0, 0xa8, 0x7d, 0x71,
-	0xbe, 0xda, 0x4b, 0xb9, 0xe7, 0x98, 0x0c, 0x03, 0xda, 0xec, 0x12, 0xac, 0x6a, 0xd3, 0x8d, 0xba,
-	0xe8, 0x02, 0xc2, 0xaf, 0xa8, 0x34, 0x93, 0x22, 0x45, 0x5d, 0x93, 0x25, 0xcc, 0xdb, 0x11, 0x2e,
-	0x8f, 0x9e, 0x1c, 0x3d, 0x0b, 0x52, 0x07, 0x23, 0x09, 0xb3, 0xcc, 0xe4, 0xa6, 0xd1, 0x64, 0x05,
-	0x81, 0x2e, 0x14, 0xa2, 0xd8, 0x5a, 0xd5, 0x22, 0x5d, 0x8c, 0xc4, 0x46, 0x90, 0x35, 0x84, 0x3f,
-	0x91, 0x52, 0x99, 0xab, 0xb2, 0x1f, 0xdf, 0x1b, 0xc6, 0xe0, 0xa8, 0x8d, 0x20, 0x17, 0xf0, 0xa8,
-	0x90, 0x15, 0x65, 0x02, 0xcb, 0xad, 0x2e, 0x94, 0xe4, 0x7c, 0x79, 0x7f, 0x10, 0x9d, 0x38, 0x3a,
-	0x1b, 0xd8, 0xe8, 0x29, 0x04, 0x19, 0x9a, 0xf7, 0x9c, 0xa7, 0xf8, 0xa3, 0xdf, 0x0b, 0x45, 0x4e,
-	0x39, 0x96, 0xf6, 0x45, 0x07, 0xa3, 0x35, 0xcc, 0x3f, 0x35, 0x55, 0xdd, 0x8b, 0x4e, 0xe1, 0xc1,
-	0xf0, 0x8a, 0x5d, 0x7d, 0x04, 0xd1, 0x73, 0x08, 0x3f, 0xb3, 0x16, 0x37, 0x82, 0x77, 0xbd, 0x68,
-	0x05, 0x01, 0x67, 0x2d, 0x6e, 0xa5, 0xe0, 0x9d, 0xdb, 0x9e, 0xdb, 0xf9, 0xe5, 0xaf, 0x63, 0x78,
-	0x98, 0x79, 0x61, 0x92, 0x6b, 0x98, 0xdb, 0x78, 0xc8, 0x59, 0x3c, 0xe6, 0x18, 0xbb, 0x1c, 0xe3,
-	0x0f, 0x7d, 0x8e, 0xe7, 0x67, 0xf1, 0x9f, 0xa8, 0x63, 0x3f, 0xca, 0xd7, 0xb0, 0xc8, 0x5c, 0x2a,
-	0xd3, 0xde, 0xff, 0xf2, 0xe4, 0x0d, 0x04, 0xd6, 0xbb, 0xdb, 0x1d, 0x6c, 0xbe, 0x82, 0xe0, 0x23,
-	0x1a, 0x5b, 0xd6, 0x94, 0xf9, 0xb1, 0xb7, 0xb5, 0x95, 0x5e, 0x0d, 0x81, 0x5b, 0xf0, 0xef, 0xfc,
-	0x8e, 0xf7, 0x66, 0x63, 0x51, 0xe4, 0xd4, 0x37, 0xb9, 0xee, 0x26, 0x7d, 0x97, 0x70, 0xdc, 0x37,
-	0x47, 0x88, 0xe7, 0xb2, 0x55, 0xde, 0x15, 0xcc, 0x17, 0xbc, 0x35, 0x37, 0x7d, 0xb3, 0x07, 0x07,
-	0xf3, 0x0e, 0x4e, 0x52, 0xd4, 0x26, 0x57, 0x26, 0x43, 0xd5, 0xb2, 0x02, 0x0f, 0xfe, 0xc2, 0x5b,
-	0x08, 0x33, 0x34, 0xee, 0x77, 0x22, 0x7e, 0xf5, 0xde, 0x3f, 0x36, 0x65, 0xbf, 0xb9, 0xfe, 0xf6,
-	0x6a, 0xcf, 0xcc, 0xf7, 0x86, 0xc6, 0x85, 0xac, 0x12, 0x25, 0x29, 0xed, 0xca, 0x0e, 0x95, 0xbd,
-	0xdf, 0x84, 0x09, 0x83, 0x4a, 0xe4, 0x7c, 0xbc, 0xd4, 0xbf, 0xae, 0x9a, 0xce, 0x06, 0xee, 0xe5,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0xbf, 0x16, 0x0b, 0xf9, 0x03, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xed, 0x6a, 0x13, 0x4d,
+	0x14, 0x26, 0x9f, 0xcd, 0x9e, 0xf4, 0x6d, 0xdf, 0x0e, 0xb5, 0x86, 0x16, 0x69, 0x58, 0xd0, 0x06,
+	0xc5, 0x04, 0x23, 0x54, 0xaa, 0x08, 0x5a, 0x29, 0x45, 0x11, 0x0b, 0xb3, 0xe2, 0x0f, 0xff, 0x84,
+	0xdd, 0xe4, 0x34, 0x1d, 0x9c, 0x9d, 0x89, 0x33, 0x93, 0xb4, 0xb9, 0x1b, 0xaf, 0xc7, 0xdb, 0xf0,
+	0x46, 0x64, 0x66, 0x67, 0xb7, 0x5b, 0x35, 0x85, 0xfe, 0xdb, 0xf3, 0x9c, 0xe7, 0xcc, 0xf9, 0x98,
+	0x79, 0xce, 0xc2, 0xbe, 0x9e, 0x49, 0x65, 0x74, 0x1a, 0x1b, 0xc5, 0xae, 0x06, 0x65, 0xa3, 0x3f,
+	0x53, 0xd2, 0x48, 0x12, 0x78, 0x6b, 0xf1, 0x6c, 0x77, 0x6f, 0x2a, 0xe5, 0x94, 0xe3, 0xc0, 0x39,
+	0x92, 0xf9, 0xf9, 0x00, 0xd3, 0x99, 0x59, 0x66, 0xbc, 0xf0, 0x00, 0xda, 0x5f, 0x50, 0x69, 0x26,
+	0x05, 0x45, 0x3d, 0x23, 0x1d, 0x58, 0x5b, 0x64, 0x66, 0xa7, 0xd2, 0xad, 0xf4, 0x02, 0x9a, 0x9b,
+	0xa1, 0x84, 0x66, 0x64, 0x62, 0x33, 0xd7, 0x64, 0x0f, 0x02, 0x3d, 0x56, 0x88, 0x62, 0xe4, 0x59,
+	0x2d, 0xda, 0xca, 0x80, 0x33, 0x41, 0xf6, 0xa1, 0x7d, 0x89, 0x49, 0x22, 0x63, 0x35, 0xb1, 0xee,
+	0xaa, 0x73, 0x43, 0x0e, 0x9d, 0x09, 0x72, 0x00, 0x9b, 0x63, 0x99, 0x26, 0x4c, 0xe0, 0x64, 0xa4,
+	0xc7, 0x4a, 0x72, 0xde, 0xa9, 0x39, 0xd2, 0x46, 0x0e, 0x47, 0x0e, 0x0d, 0x1f, 0x42, 0x10, 0xa1,
+	0x79, 0xcb, 0x39, 0xc5, 0xef, 0xb6, 0x2e, 0x14, 0x71, 0xc2, 0x71, 0xe2, 0x33, 0xe6, 0x66, 0xb8,
+	0x0f, 0x6b, 0x1f, 0xe6, 0xe9, 0xcc, 0x92, 0xb6, 0xa1, 0xe1, 0xb2, 0xf8, 0xd2, 0x33, 0x23, 0x7c,
+	0x0c, 0xed, 0x8f, 0x6c, 0x81, 0x67, 0x82, 0x2f, 0x2d, 0x69, 0x0f, 0x02, 0xce, 0x16, 0x38, 0x92,
+	0x82, 0x2f, 0xf3, 0xea, 0xb9, 0xf7, 0x87, 0xbf, 0x2a, 0xb0, 0xfe, 0x6e, 0xae, 0x14, 0x0a, 0x63,
+	0x9b, 0xc5, 0xdb, 0x7b, 0x2d, 0xf2, 0x55, 0x4b, 0xf9, 0x48, 0x0f, 0xea, 0xcc, 0x60, 0xea, 0xba,
+	0x6a, 0x0f, 0xb7, 0xfb, 0xc5, 0x45, 0xf4, 0x8f, 0xad, 0xff, 0xbd, 0xc1, 0x94, 0x3a, 0x06, 0x79,
+	0x02, 0x5b, 0x1a, 0xc7, 0x52, 0x4c, 0xf4, 0x48, 0x61, 0x1a, 0x33, 0xc1, 0xc4, 0xb4, 0x53, 0xef,
+	0x56, 0x7a, 0x15, 0xfa, 0xbf, 0x77, 0xd0, 0x1c, 0x27, 0xbb, 0xd0, 0x9a, 0xcf, 0xc6, 0x32, 0xb5,
+	0x9c, 0x46, 0xb7, 0xd6, 0x0b, 0x68, 0x61, 0x93, 0xa7, 0xd0, 0x74, 0xb9, 0x75, 0xa7, 0xd9, 0xad,
+	0xf5, 0xda, 0xc3, 0x7b, 0x7f, 0x26, 0x75, 0xcd, 0x50, 0x4f, 0x0a, 0x4f, 0x21, 0x28, 0x4a, 0x21,
+	0x1b, 0x50, 0x65, 0xf9, 0xc4, 0xaa, 0x6c, 0x62, 0x9b, 0xe2, 0x71, 0x82, 0x3c, 0x6f, 0xca, 0x19,
+	0x16, 0x35, 0x18, 0xa7, 0xba, 0x53, 0x73, 0xa9, 0x33, 0x23, 0xfc, 0x59, 0x01, 0xb8, 0x3e, 0x9f,
+	0x10, 0xa8, 0x8b, 0x38, 0x45, 0x7f, 0x98, 0xfb, 0x2e, 0x5f, 0x5c, 0xf5, 0xc6, 0xc5, 0xd9, 0x97,
+	0xa2, 0xbf, 0xb1, 0xd9, 0x48, 0x61, 0xac, 0xa5, 0x70, 0xe3, 0x0a, 0x28, 0x58, 0x88, 0x3a, 0x84,
+	0x3c, 0x00, 0xe0, 0xb1, 0x36, 0x23, 0x54, 0x4a, 0x2a, 0x37, 0x97, 0x80, 0x06, 0x16, 0x39, 0xb1,
+	0x00, 0x79, 0x04, 0x9b, 0xd7, 0xee, 0x91, 0x61, 0x29, 0x76, 0x1a, 0xdd, 0x4a, 0xaf, 0x46, 0xff,
+	0x2b, 0x38, 0x9f, 0x59, 0x8a, 0x05, 0x4f, 0x5f, 0xc8, 0x4b, 0x91, 0xf1, 0x9a, 0xd7, 0xbc, 0xc8,
+	0xa2, 0x96, 0x37, 0xfc, 0xd1, 0x80, 0xf5, 0xa8, 0x24, 0x24, 0x72, 0x04, 0x6b, 0x5e, 0x1a, 0x64,
+	0xa7, 0x9f, 0x69, 0xa8, 0x9f, 0x6b, 0xa8, 0x7f, 0x62, 0x35, 0xb4, 0xbb, 0x53, 0x1a, 0x74, 0x59,
+	0x46, 0x2f, 0xa1, 0x15, 0xe5, 0xaf, 0x64, 0x75, 0xec, 0x3f, 0x71, 0xf2, 0x0a, 0x02, 0x1f, 0x7b,
+	0x7e, 0x7e, 0xe7, 0xe0, 0x43, 0x08, 0x4e, 0xd1, 0x78, 0xa1, 0xae, 0x0a, 0xde, 0x2a, 0x55, 0xed,
+	0xa9, 0x87, 0x4e, 0x6c, 0xde, 0xf8, 0xdb, 0x7f, 0x4b, 0xbe, 0x66, 0x26, 0x52, 0x52, 0x7e, 0xe8,
+	0x85, 0x6e, 0x57, 0xc6, 0x0d, 0xa1, 0x6e, 0x55, 0x4b, 0x48, 0x29, 0xca, 0xcb, 0xf8, 0xb6, 0xc1,
+	0x7c, 0xc2, 0x2b, 0xe3, 0x1e, 0xdc, 0x9d, 0x07, 0xf3, 0x06, 0x36, 0x28, 0x6a, 0x13, 0x2b, 0x13,
+	0xa1, 0x5a, 0xb0, 0x31, 0xde, 0xf9, 0x84, 0xd7, 0xd0, 0x8e, 0xd0, 0xe4, 0xab, 0x84, 0x94, 0xaf,
+	0xbe, 0xb4, 0x5f, 0x56, 0x86, 0x1f, 0xc3, 0xe6, 0x29, 0x9a, 0x1b, 0xcb, 0x65, 0x55, 0x05, 0xf7,
+	0x4b, 0x47, 0x97, 0x03, 0x8e, 0x8f, 0xbe, 0xbe, 0x98, 0x32, 0x73, 0x31, 0x4f, 0xfa, 0x63, 0x99,
+	0x0e, 0x94, 0x4c, 0x92, 0xe5, 0x64, 0x89, 0xca, 0xef, 0xff, 0x01, 0x13, 0x06, 0x95, 0x88, 0x79,
+	0xb6, 0xe9, 0x6f, 0xfc, 0x15, 0x92, 0xa6, 0xc3, 0x9e, 0xff, 0x1e, 0x00, 0x66, 0xde, 0xa8, 0x3d,
+	0x39, 0x06, 0x00, 0x00,
 }
@@ -49,6 +49,7 @@ import (
 	"unsafe"
 
 	"github.com/robbydyer/sports/internal/matrix"
+	"github.com/robbydyer/sports/internal/metrics"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
@@ -350,6 +351,7 @@ func (c *RGBLedMatrix) render(leds []C.uint32_t) error {
 		C.int(h),
 		(*C.uint32_t)(unsafe.Pointer(&leds[0])),
 	)
+	metrics.Frames.Frame("rgbmatrix")
 
 	return nil
 }
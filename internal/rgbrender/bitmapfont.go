@@ -0,0 +1,574 @@
+package rgbrender
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// BitmapFont is a BDF or PCF font. It's drawn pixel for pixel at the size it was made, so it
+// stays sharp on small matrices.
+type BitmapFont struct {
+	Name        string
+	Ascent      int
+	Descent     int
+	glyphs      map[rune]*bitmapGlyph
+	defaultChar rune
+}
+
+// bitmapGlyph is a glyph's mask, positioned relative to the dot with y pointing down
+type bitmapGlyph struct {
+	mask    *image.Alpha
+	x       int
+	y       int
+	advance int
+}
+
+// Height is the pixel height of a line of the font
+func (f *BitmapFont) Height() int {
+	return f.Ascent + f.Descent
+}
+
+// HasGlyph determines if the font has a glyph for a rune
+func (f *BitmapFont) HasGlyph(r rune) bool {
+	_, ok := f.glyphs[r]
+	return ok
+}
+
+// Face returns the font as a font.Face
+func (f *BitmapFont) Face() font.Face {
+	return &bitmapFace{font: f}
+}
+
+func (f *BitmapFont) glyph(r rune) (*bitmapGlyph, bool) {
+	if g, ok := f.glyphs[r]; ok {
+		return g, true
+	}
+	for _, fallback := range []rune{f.defaultChar, '?', ' '} {
+		if g, ok := f.glyphs[fallback]; ok {
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+// IsBitmapFont determines if a font file name is a BDF or PCF font
+func IsBitmapFont(name string) bool {
+	name = strings.TrimSuffix(strings.ToLower(name), ".gz")
+	return strings.HasSuffix(name, ".bdf") || strings.HasSuffix(name, ".pcf")
+}
+
+// ParseBitmapFont parses a BDF or PCF font. Gzipped fonts are decompressed.
+func ParseBitmapFont(name string, dat []byte) (*BitmapFont, error) {
+	if len(dat) > 2 && dat[0] == 0x1f && dat[1] == 0x8b {
+		r, err := gzip.NewReader(bytes.NewReader(dat))
+		if err != nil {
+			return nil, err
+		}
+		dat, err = io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var f *BitmapFont
+	var err error
+	if bytes.HasPrefix(dat, []byte(pcfMagic)) {
+		f, err = parsePCF(dat)
+	} else {
+		f, err = parseBDF(dat)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse font %s: %w", name, err)
+	}
+	f.Name = name
+
+	return f, nil
+}
+
+func parseBDF(dat []byte) (*BitmapFont, error) {
+	f := &BitmapFont{
+		glyphs:      make(map[rune]*bitmapGlyph),
+		defaultChar: -1,
+	}
+
+	var (
+		bbx      [4]int
+		fontBBX  [4]int
+		glyph    *bitmapGlyph
+		encoding rune
+		rows     []string
+		inBitmap bool
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(dat))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 1 {
+			continue
+		}
+
+		if inBitmap {
+			if fields[0] != "ENDCHAR" {
+				rows = append(rows, fields[0])
+				continue
+			}
+			inBitmap = false
+			if encoding < 0 {
+				continue
+			}
+			mask, err := bdfMask(bbx[0], bbx[1], rows)
+			if err != nil {
+				return nil, fmt.Errorf("invalid bitmap for char %d: %w", encoding, err)
+			}
+			glyph.mask = mask
+			glyph.x = bbx[2]
+			glyph.y = -(bbx[3] + bbx[1])
+			f.glyphs[encoding] = glyph
+			continue
+		}
+
+		var err error
+		switch fields[0] {
+		case "FONTBOUNDINGBOX":
+			fontBBX, err = bdfInts4(fields)
+		case "FONT_ASCENT":
+			f.Ascent, err = bdfInt(fields)
+		case "FONT_DESCENT":
+			f.Descent, err = bdfInt(fields)
+		case "DEFAULT_CHAR":
+			var c int
+			c, err = bdfInt(fields)
+			f.defaultChar = rune(c)
+		case "STARTCHAR":
+			glyph = &bitmapGlyph{}
+			encoding = -1
+			bbx = fontBBX
+		case "ENCODING":
+			var c int
+			c, err = bdfInt(fields)
+			encoding = rune(c)
+		case "DWIDTH":
+			glyph.advance, err = bdfInt(fields)
+		case "BBX":
+			bbx, err = bdfInts4(fields)
+		case "BITMAP":
+			inBitmap = true
+			rows = nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", fields[0], err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(f.glyphs) < 1 {
+		return nil, fmt.Errorf("font has no glyphs")
+	}
+	if f.Ascent == 0 && f.Descent == 0 {
+		f.Ascent = fontBBX[1] + fontBBX[3]
+		f.Descent = -fontBBX[3]
+	}
+
+	return f, nil
+}
+
+func bdfInt(fields []string) (int, error) {
+	if len(fields) < 2 {
+		return 0, fmt.Errorf("missing value")
+	}
+	return strconv.Atoi(fields[1])
+}
+
+func bdfInts4(fields []string) ([4]int, error) {
+	var v [4]int
+	if len(fields) < 5 {
+		return v, fmt.Errorf("expected 4 values")
+	}
+	for i := range v {
+		var err error
+		v[i], err = strconv.Atoi(fields[i+1])
+		if err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
+
+// bdfMask converts hex encoded rows, where the most significant bit is the leftmost pixel
+func bdfMask(w int, h int, rows []string) (*image.Alpha, error) {
+	mask := image.NewAlpha(image.Rect(0, 0, w, h))
+	for y, row := range rows {
+		if y >= h {
+			break
+		}
+		b, err := hex.DecodeString(row)
+		if err != nil {
+			return nil, err
+		}
+		for x := 0; x < w && x/8 < len(b); x++ {
+			if b[x/8]&(0x80>>(x%8)) != 0 {
+				mask.Pix[y*mask.Stride+x] = 0xff
+			}
+		}
+	}
+	return mask, nil
+}
+
+const (
+	pcfMagic = "\x01fcp"
+
+	pcfAccelerators    = 1 << 1
+	pcfMetrics         = 1 << 2
+	pcfBitmaps         = 1 << 3
+	pcfBDFEncodings    = 1 << 5
+	pcfBDFAccelerators = 1 << 8
+
+	pcfCompressedMetrics = 0x100
+	pcfByteMSB           = 1 << 2
+	pcfBitMSB            = 1 << 3
+)
+
+type pcfMetric struct {
+	left    int
+	right   int
+	width   int
+	ascent  int
+	descent int
+}
+
+// pcfTable reads a PCF table, whose format sets the byte order of everything after it
+type pcfTable struct {
+	dat    []byte
+	pos    int
+	format uint32
+	order  binary.ByteOrder
+}
+
+func (t *pcfTable) next(n int) ([]byte, error) {
+	if t.pos+n > len(t.dat) {
+		return nil, fmt.Errorf("table is truncated")
+	}
+	b := t.dat[t.pos : t.pos+n]
+	t.pos += n
+	return b, nil
+}
+
+func (t *pcfTable) uint8() (int, error) {
+	b, err := t.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return int(b[0]), nil
+}
+
+func (t *pcfTable) int16() (int, error) {
+	b, err := t.next(2)
+	if err != nil {
+		return 0, err
+	}
+	return int(int16(t.order.Uint16(b))), nil
+}
+
+func (t *pcfTable) int32() (int, error) {
+	b, err := t.next(4)
+	if err != nil {
+		return 0, err
+	}
+	return int(int32(t.order.Uint32(b))), nil
+}
+
+func parsePCF(dat []byte) (*BitmapFont, error) {
+	if len(dat) < 8 {
+		return nil, fmt.Errorf("file is truncated")
+	}
+	count := int(binary.LittleEndian.Uint32(dat[4:]))
+	tables := make(map[uint32]*pcfTable)
+	for i := 0; i < count; i++ {
+		entry := 8 + i*16
+		if entry+16 > len(dat) {
+			return nil, fmt.Errorf("table of contents is truncated")
+		}
+		typ := binary.LittleEndian.Uint32(dat[entry:])
+		size := int(binary.LittleEndian.Uint32(dat[entry+8:]))
+		offset := int(binary.LittleEndian.Uint32(dat[entry+12:]))
+		if offset+size > len(dat) || size < 4 {
+			return nil, fmt.Errorf("table %d is out of range", typ)
+		}
+		t := &pcfTable{
+			dat:    dat[offset : offset+size],
+			pos:    4,
+			format: binary.LittleEndian.Uint32(dat[offset:]),
+		}
+		t.order = binary.LittleEndian
+		if t.format&pcfByteMSB != 0 {
+			t.order = binary.BigEndian
+		}
+		tables[typ] = t
+	}
+
+	for _, typ := range []uint32{pcfMetrics, pcfBitmaps, pcfBDFEncodings} {
+		if _, ok := tables[typ]; !ok {
+			return nil, fmt.Errorf("missing table %d", typ)
+		}
+	}
+
+	f := &BitmapFont{
+		glyphs: make(map[rune]*bitmapGlyph),
+	}
+
+	accel, ok := tables[pcfBDFAccelerators]
+	if !ok {
+		accel, ok = tables[pcfAccelerators]
+	}
+	if ok {
+		// Skip the 8 flag bytes
+		if _, err := accel.next(8); err != nil {
+			return nil, err
+		}
+		var err error
+		if f.Ascent, err = accel.int32(); err != nil {
+			return nil, err
+		}
+		if f.Descent, err = accel.int32(); err != nil {
+			return nil, err
+		}
+	}
+
+	metrics, err := pcfReadMetrics(tables[pcfMetrics])
+	if err != nil {
+		return nil, fmt.Errorf("invalid metrics: %w", err)
+	}
+
+	masks, err := pcfReadBitmaps(tables[pcfBitmaps], metrics)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bitmaps: %w", err)
+	}
+
+	enc := tables[pcfBDFEncodings]
+	var minChar, maxChar, minByte1, maxByte1, defaultChar int
+	for _, v := range []*int{&minChar, &maxChar, &minByte1, &maxByte1, &defaultChar} {
+		if *v, err = enc.int16(); err != nil {
+			return nil, err
+		}
+	}
+	f.defaultChar = rune(defaultChar)
+	cols := maxChar - minChar + 1
+	n := cols * (maxByte1 - minByte1 + 1)
+	for i := 0; i < n; i++ {
+		b, err := enc.next(2)
+		if err != nil {
+			return nil, err
+		}
+		idx := int(enc.order.Uint16(b))
+		if idx == 0xffff || idx >= len(metrics) {
+			continue
+		}
+		code := rune(((minByte1 + i/cols) << 8) | (minChar + i%cols))
+		m := metrics[idx]
+		f.glyphs[code] = &bitmapGlyph{
+			mask:    masks[idx],
+			x:       m.left,
+			y:       -m.ascent,
+			advance: m.width,
+		}
+	}
+
+	if len(f.glyphs) < 1 {
+		return nil, fmt.Errorf("font has no glyphs")
+	}
+	if f.Ascent == 0 && f.Descent == 0 {
+		for _, m := range metrics {
+			if m.ascent > f.Ascent {
+				f.Ascent = m.ascent
+			}
+			if m.descent > f.Descent {
+				f.Descent = m.descent
+			}
+		}
+	}
+
+	return f, nil
+}
+
+func pcfReadMetrics(t *pcfTable) ([]*pcfMetric, error) {
+	compressed := t.format&pcfCompressedMetrics != 0
+
+	var count int
+	var err error
+	if compressed {
+		count, err = t.int16()
+	} else {
+		count, err = t.int32()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make([]*pcfMetric, count)
+	for i := range metrics {
+		var v [5]int
+		for j := range v {
+			if compressed {
+				v[j], err = t.uint8()
+				v[j] -= 0x80
+			} else {
+				v[j], err = t.int16()
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !compressed {
+			// attributes
+			if _, err := t.next(2); err != nil {
+				return nil, err
+			}
+		}
+		metrics[i] = &pcfMetric{
+			left:    v[0],
+			right:   v[1],
+			width:   v[2],
+			ascent:  v[3],
+			descent: v[4],
+		}
+	}
+
+	return metrics, nil
+}
+
+func pcfReadBitmaps(t *pcfTable, metrics []*pcfMetric) ([]*image.Alpha, error) {
+	count, err := t.int32()
+	if err != nil {
+		return nil, err
+	}
+	if count != len(metrics) {
+		return nil, fmt.Errorf("%d bitmaps for %d glyphs", count, len(metrics))
+	}
+
+	offsets := make([]int, count)
+	for i := range offsets {
+		if offsets[i], err = t.int32(); err != nil {
+			return nil, err
+		}
+	}
+	var sizes [4]int
+	for i := range sizes {
+		if sizes[i], err = t.int32(); err != nil {
+			return nil, err
+		}
+	}
+
+	pad := 1 << (t.format & 3)
+	unit := 1 << ((t.format >> 4) & 3)
+	bitMSB := t.format&pcfBitMSB != 0
+	byteMSB := t.format&pcfByteMSB != 0
+	data, err := t.next(sizes[t.format&3])
+	if err != nil {
+		return nil, err
+	}
+
+	masks := make([]*image.Alpha, count)
+	for i, m := range metrics {
+		w, h := m.right-m.left, m.ascent+m.descent
+		if w < 0 || h < 0 {
+			return nil, fmt.Errorf("glyph %d has a negative size", i)
+		}
+		mask := image.NewAlpha(image.Rect(0, 0, w, h))
+		stride := ((w+7)/8 + pad - 1) / pad * pad
+
+		for y := 0; y < h; y++ {
+			start := offsets[i] + y*stride
+			if start < 0 || start+stride > len(data) {
+				return nil, fmt.Errorf("glyph %d is out of range", i)
+			}
+			row := make([]byte, stride)
+			copy(row, data[start:start+stride])
+			if bitMSB != byteMSB && unit > 1 {
+				for u := 0; u+unit <= len(row); u += unit {
+					for a, b := u, u+unit-1; a < b; a, b = a+1, b-1 {
+						row[a], row[b] = row[b], row[a]
+					}
+				}
+			}
+			for x := 0; x < w; x++ {
+				bit := byte(0x80 >> (x % 8))
+				if !bitMSB {
+					bit = 1 << (x % 8)
+				}
+				if row[x/8]&bit != 0 {
+					mask.Pix[y*mask.Stride+x] = 0xff
+				}
+			}
+		}
+		masks[i] = mask
+	}
+
+	return masks, nil
+}
+
+// bitmapFace implements font.Face for a BitmapFont. Missing glyphs are drawn as the font's
+// default char.
+type bitmapFace struct {
+	font *BitmapFont
+}
+
+func (b *bitmapFace) Close() error {
+	return nil
+}
+
+func (b *bitmapFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	g, ok := b.font.glyph(r)
+	if !ok {
+		return image.Rectangle{}, nil, image.Point{}, 0, false
+	}
+	x := dot.X.Round() + g.x
+	y := dot.Y.Round() + g.y
+	dr := image.Rect(x, y, x+g.mask.Rect.Dx(), y+g.mask.Rect.Dy())
+
+	return dr, g.mask, image.Point{}, fixed.I(g.advance), true
+}
+
+func (b *bitmapFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	g, ok := b.font.glyph(r)
+	if !ok {
+		return fixed.Rectangle26_6{}, 0, false
+	}
+	bounds := fixed.R(g.x, g.y, g.x+g.mask.Rect.Dx(), g.y+g.mask.Rect.Dy())
+
+	return bounds, fixed.I(g.advance), true
+}
+
+func (b *bitmapFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	g, ok := b.font.glyph(r)
+	if !ok {
+		return 0, false
+	}
+	return fixed.I(g.advance), true
+}
+
+func (b *bitmapFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	return 0
+}
+
+func (b *bitmapFace) Metrics() font.Metrics {
+	return font.Metrics{
+		Height:    fixed.I(b.font.Height()),
+		Ascent:    fixed.I(b.font.Ascent),
+		Descent:   fixed.I(b.font.Descent),
+		CapHeight: fixed.I(b.font.Ascent),
+		XHeight:   fixed.I(b.font.Ascent),
+	}
+}
@@ -0,0 +1,195 @@
+package rgbrender
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testBDF = `STARTFONT 2.1
+FONT -Test-Fixed-Medium-R-Normal--4-40-75-75-C-40-ISO10646-1
+SIZE 4 75 75
+FONTBOUNDINGBOX 3 4 0 -1
+STARTPROPERTIES 3
+FONT_ASCENT 3
+FONT_DESCENT 1
+DEFAULT_CHAR 63
+ENDPROPERTIES
+CHARS 2
+STARTCHAR question
+ENCODING 63
+DWIDTH 4 0
+BBX 3 4 0 -1
+BITMAP
+E0
+20
+40
+00
+ENDCHAR
+STARTCHAR I
+ENCODING 73
+DWIDTH 4 0
+BBX 1 3 1 0
+BITMAP
+80
+80
+80
+ENDCHAR
+ENDFONT
+`
+
+func TestParseBDF(t *testing.T) {
+	t.Parallel()
+
+	f, err := ParseBitmapFont("test.bdf", []byte(testBDF))
+	require.NoError(t, err)
+	require.Equal(t, 3, f.Ascent)
+	require.Equal(t, 1, f.Descent)
+	require.True(t, f.HasGlyph('I'))
+	require.False(t, f.HasGlyph('J'))
+
+	g := f.glyphs['I']
+	require.Equal(t, 1, g.x)
+	require.Equal(t, -3, g.y)
+	require.Equal(t, 4, g.advance)
+	require.Equal(t, image.Rect(0, 0, 1, 3), g.mask.Rect)
+
+	// Missing glyphs use the default char
+	adv, ok := f.Face().GlyphAdvance('J')
+	require.True(t, ok)
+	require.Equal(t, 4, adv.Round())
+}
+
+func TestBitmapTextWriter(t *testing.T) {
+	t.Parallel()
+
+	f, err := ParseBitmapFont("test.bdf", []byte(testBDF))
+	require.NoError(t, err)
+	writer := NewBitmapTextWriter(f)
+	require.True(t, writer.IsBitmap())
+	require.Equal(t, 4.0, writer.FontSize)
+
+	canvas := image.NewRGBA(image.Rect(0, 0, 8, 4))
+	require.NoError(t, writer.Write(canvas, canvas.Bounds(), []string{"II"}, color.White))
+
+	// Each I is a sharp 1px line in the first 3 rows
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 8; x++ {
+			expected := color.RGBA{}
+			if y < 3 && (x == 1 || x == 5) {
+				expected = color.RGBA{255, 255, 255, 255}
+			}
+			require.Equal(t, expected, canvas.RGBAAt(x, y), "pixel %d,%d", x, y)
+		}
+	}
+
+	widths, err := writer.MeasureStrings(canvas, []string{"III"})
+	require.NoError(t, err)
+	require.Equal(t, []int{12}, widths)
+}
+
+// testPCF encodes the I glyph of testBDF as a little endian, LSBit first PCF font
+func testPCF() []byte {
+	le := binary.LittleEndian
+	table := func(format uint32, body ...interface{}) []byte {
+		var b bytes.Buffer
+		_ = binary.Write(&b, le, format)
+		for _, v := range body {
+			_ = binary.Write(&b, le, v)
+		}
+		return b.Bytes()
+	}
+
+	// 1 glyph, 4 byte row padding
+	accel := table(0, [8]uint8{}, int32(3), int32(1))
+	metrics := table(0, int32(1), [5]int16{1, 2, 4, 3, 0}, uint16(0))
+	bitmaps := table(2, int32(1), int32(0), [4]int32{3, 6, 12, 24}, [12]byte{1, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0})
+	encodings := table(0, [5]int16{'I', 'I', 0, 0, 0}, uint16(0))
+
+	tables := []struct {
+		typ uint32
+		dat []byte
+	}{
+		{pcfAccelerators, accel},
+		{pcfMetrics, metrics},
+		{pcfBitmaps, bitmaps},
+		{pcfBDFEncodings, encodings},
+	}
+
+	var out bytes.Buffer
+	out.WriteString(pcfMagic)
+	_ = binary.Write(&out, le, int32(len(tables)))
+	offset := 8 + 16*len(tables)
+	for _, tbl := range tables {
+		_ = binary.Write(&out, le, [4]int32{int32(tbl.typ), 0, int32(len(tbl.dat)), int32(offset)})
+		offset += len(tbl.dat)
+	}
+	for _, tbl := range tables {
+		out.Write(tbl.dat)
+	}
+
+	return out.Bytes()
+}
+
+func TestParsePCF(t *testing.T) {
+	t.Parallel()
+
+	f, err := ParseBitmapFont("test.pcf", testPCF())
+	require.NoError(t, err)
+	require.Equal(t, 3, f.Ascent)
+	require.Equal(t, 1, f.Descent)
+	require.True(t, f.HasGlyph('I'))
+
+	bdf, err := ParseBitmapFont("test.bdf", []byte(testBDF))
+	require.NoError(t, err)
+	require.Equal(t, bdf.glyphs['I'], f.glyphs['I'])
+}
+
+func TestConfigure(t *testing.T) {
+	t.Parallel()
+
+	writer, err := DefaultTextWriter()
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Configure(&FontConfig{Size: 12}))
+	require.False(t, writer.IsBitmap())
+	require.Equal(t, 12.0, writer.FontSize)
+	require.Equal(t, -2, writer.YStartCorrection)
+
+	require.NoError(t, writer.Configure(&FontConfig{Name: "5x7", Size: 12}))
+	require.True(t, writer.IsBitmap())
+	require.Equal(t, 7.0, writer.FontSize)
+	require.Equal(t, 0, writer.YStartCorrection)
+
+	require.NoError(t, writer.Configure(&FontConfig{Name: "score.ttf", Size: 16}))
+	require.False(t, writer.IsBitmap())
+	require.Equal(t, 16.0, writer.FontSize)
+
+	require.Error(t, writer.Configure(&FontConfig{Name: "/does/not/exist.bdf"}))
+}
+
+func TestBuiltinFonts(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range BuiltinFonts {
+		f, err := LoadFont(name)
+		require.NoError(t, err, name)
+		require.Equal(t, IsBitmapFont(name), f.Bitmap != nil, name)
+	}
+}
+
+func TestTrueTypeWriter(t *testing.T) {
+	t.Parallel()
+
+	writer, err := DefaultTextWriter()
+	require.NoError(t, err)
+	require.Equal(t, 8, writer.lineHeight())
+	require.Equal(t, 8, writer.baseline())
+
+	canvas := image.NewRGBA(image.Rect(0, 0, 32, 16))
+	require.NoError(t, writer.WriteAligned(CenterCenter, canvas, canvas.Bounds(), []string{"HI"}, color.White))
+}
@@ -56,7 +56,7 @@ func AlignPosition(align Align, bounds image.Rectangle, sizeX int, sizeY int) (i
 	endY := 0
 
 	if align == CenterTop || align == CenterCenter || align == CenterBottom {
-		startX = int(math.Ceil(float64(bounds.Max.X-sizeX) / 2))
+		startX = bounds.Min.X + int(math.Ceil(float64(bounds.Max.X-bounds.Min.X-sizeX)/2))
 		endX = startX + sizeX - 1
 	} else if align == RightTop || align == RightCenter || align == RightBottom {
 		startX = bounds.Max.X - sizeX + 1
@@ -82,7 +82,7 @@ func AlignPosition(align Align, bounds image.Rectangle, sizeX int, sizeY int) (i
 	}
 
 	if align == CenterCenter || align == RightCenter || align == LeftCenter {
-		startY = int(math.Ceil(float64(bounds.Max.Y-sizeY) / 2))
+		startY = bounds.Min.Y + int(math.Ceil(float64(bounds.Max.Y-bounds.Min.Y-sizeY)/2))
 		endY = startY + sizeY - 1
 	} else if align == CenterBottom || align == RightBottom || align == LeftBottom {
 		startY = bounds.Max.Y - sizeY + 1
@@ -97,7 +97,7 @@ func AlignPosition(align Align, bounds image.Rectangle, sizeX int, sizeY int) (i
 	} else {
 		// defaults to Top
 		startY = bounds.Min.Y
-		endY = sizeY - 1
+		endY = bounds.Min.Y + sizeY - 1
 
 		if endY > bounds.Max.Y {
 			newEndY := bounds.Max.Y
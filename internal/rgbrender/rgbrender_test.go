@@ -486,6 +486,30 @@ func TestAlignPosition(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "leftcenter offset",
+			align:    LeftCenter,
+			bounds:   image.Rect(10, 16, 60, 32),
+			sizeX:    5,
+			sizeY:    8,
+			expected: image.Rect(10, 20, 14, 27),
+		},
+		{
+			name:     "centercenter offset",
+			align:    CenterCenter,
+			bounds:   image.Rect(10, 16, 20, 32),
+			sizeX:    4,
+			sizeY:    8,
+			expected: image.Rect(13, 20, 16, 27),
+		},
+		{
+			name:     "righttop offset",
+			align:    RightTop,
+			bounds:   image.Rect(10, 16, 60, 32),
+			sizeX:    5,
+			sizeY:    8,
+			expected: image.Rect(56, 16, 60, 23),
+		},
 	}
 
 	for _, test := range tests {
@@ -7,12 +7,15 @@ import (
 	"image/color"
 	"image/draw"
 	"math"
+	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/golang/freetype"
 	"github.com/golang/freetype/truetype"
-
+	"go.uber.org/atomic"
 	"golang.org/x/image/font"
 	"golang.org/x/image/math/fixed"
 )
@@ -24,22 +27,54 @@ const (
 	defaultFont = "04b24.ttf"
 )
 
-// BuiltinFonts is a list of fonts names this pkg provides
+// BuiltinFonts is a list of fonts names this pkg provides. The BDF fonts are from hzeller's
+// rpi-rgb-led-matrix library.
 var BuiltinFonts = []string{
 	"04b24.ttf",
 	"BlockStockRegular-A71p.ttf",
 	"04B_03__.ttf",
 	"score.ttf",
+	"4x6.bdf",
+	"5x7.bdf",
+	"5x8.bdf",
+	"6x9.bdf",
+	"6x10.bdf",
+}
+
+var (
+	fontCache = make(map[string]*Font)
+	fontLock  sync.Mutex
+)
+
+// Font is either a TrueType or a bitmap font
+type Font struct {
+	TrueType *truetype.Font
+	Bitmap   *BitmapFont
+}
+
+// FontConfig chooses the font used for some text. Name is a builtin font or the path to a TTF,
+// BDF or PCF font file. Bitmap fonts are drawn at the size they were made, so Size only
+// applies to TrueType fonts. Fallbacks are fonts tried in order for characters the font doesn't
+// have, and Transliterate replaces characters that no font has with ASCII.
+type FontConfig struct {
+	Name          string       `json:"name"`
+	Size          float64      `json:"size"`
+	LineSpace     float64      `json:"lineSpace"`
+	Fallbacks     []string     `json:"fallbacks"`
+	Transliterate *atomic.Bool `json:"transliterate"`
 }
 
 // TextWriter ...
 type TextWriter struct {
 	context          *freetype.Context
 	font             *truetype.Font
+	bitmap           *BitmapFont
 	XStartCorrection int
 	YStartCorrection int
 	FontSize         float64
 	LineSpace        float64
+	fallbacks        []*Font
+	transliterate    *bool
 }
 
 // ColorChar is used to define text for writing in different colors
@@ -81,6 +116,126 @@ func NewTextWriter(font *truetype.Font, fontSize float64) *TextWriter {
 	}
 }
 
+// NewBitmapTextWriter returns a TextWriter for a BDF or PCF font. Its FontSize is the font's
+// pixel height.
+func NewBitmapTextWriter(f *BitmapFont) *TextWriter {
+	return &TextWriter{
+		bitmap:    f,
+		FontSize:  float64(f.Height()),
+		LineSpace: 0.5,
+	}
+}
+
+// LoadFont loads a builtin font by name, or a font file. The extension of a builtin font's name
+// is optional. Fonts ending in .bdf or .pcf, optionally gzipped, are bitmap fonts, and anything
+// else is parsed as a TrueType font.
+func LoadFont(name string) (*Font, error) {
+	fontLock.Lock()
+	defer fontLock.Unlock()
+
+	if f, ok := fontCache[name]; ok {
+		return f, nil
+	}
+
+	fileName, dat, err := readFont(name)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Font{}
+	if IsBitmapFont(fileName) {
+		f.Bitmap, err = ParseBitmapFont(fileName, dat)
+	} else {
+		f.TrueType, err = freetype.ParseFont(dat)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load font %s: %w", name, err)
+	}
+	fontCache[name] = f
+
+	return f, nil
+}
+
+// readFont reads a builtin font, falling back to a font file
+func readFont(name string) (string, []byte, error) {
+	for _, builtin := range BuiltinFonts {
+		if builtin == name || strings.TrimSuffix(builtin, filepath.Ext(builtin)) == name {
+			dat, err := fontDir.ReadFile(filepath.Join("assets/fonts", builtin))
+			return builtin, dat, err
+		}
+	}
+
+	dat, err := os.ReadFile(name)
+	if err != nil {
+		return "", nil, fmt.Errorf("font %s is not a builtin font or a readable file: %w", name, err)
+	}
+
+	return name, dat, nil
+}
+
+// SetFont replaces the writer's font
+func (t *TextWriter) SetFont(f *Font) {
+	if f.Bitmap != nil {
+		t.bitmap = f.Bitmap
+		t.font = nil
+		t.context = nil
+		t.FontSize = float64(f.Bitmap.Height())
+		return
+	}
+
+	t.bitmap = nil
+	t.font = f.TrueType
+	t.context = freetype.NewContext()
+	t.context.SetFont(f.TrueType)
+	t.context.SetFontSize(t.FontSize)
+}
+
+// IsBitmap determines if the writer uses a bitmap font
+func (t *TextWriter) IsBitmap() bool {
+	return t.bitmap != nil
+}
+
+// Configure applies a FontConfig to the writer, replacing any size it was given. A bitmap font
+// is drawn at its natural position, so the start corrections meant for TrueType fonts are reset.
+func (t *TextWriter) Configure(conf *FontConfig) error {
+	if conf == nil {
+		return nil
+	}
+
+	if conf.Name != "" {
+		f, err := LoadFont(conf.Name)
+		if err != nil {
+			return err
+		}
+		t.SetFont(f)
+	}
+
+	if t.bitmap != nil {
+		t.XStartCorrection = 0
+		t.YStartCorrection = 0
+	} else if conf.Size > 0 {
+		t.FontSize = conf.Size
+	}
+
+	if conf.LineSpace != 0 {
+		t.LineSpace = conf.LineSpace
+	}
+
+	if len(conf.Fallbacks) > 0 {
+		fonts, err := loadFonts(conf.Fallbacks)
+		if err != nil {
+			return err
+		}
+		t.SetFallbacks(fonts...)
+	}
+
+	if conf.Transliterate != nil {
+		t.SetTransliterate(conf.Transliterate.Load())
+	}
+
+	return nil
+}
+
 // GetFont gets a builtin font by the given name
 func GetFont(name string) (*truetype.Font, error) {
 	if !strings.HasPrefix("assets/fonts", name) {
@@ -95,33 +250,45 @@ func GetFont(name string) (*truetype.Font, error) {
 }
 
 func (t *TextWriter) getDrawer(canvas draw.Image, clr color.Color) (*font.Drawer, error) {
-	if t.font == nil {
+	if t.bitmap == nil && t.font == nil {
 		return nil, fmt.Errorf("font is not set")
 	}
 	return &font.Drawer{
-		Dst: canvas,
-		Src: image.NewUniform(clr),
-		Face: truetype.NewFace(t.font,
-			&truetype.Options{
-				Size:    t.FontSize,
-				Hinting: font.HintingFull,
-			},
-		),
+		Dst:  canvas,
+		Src:  image.NewUniform(clr),
+		Face: t.face(),
 	}, nil
 }
 
+// lineHeight is the pixel height of a line of text, including line spacing
+func (t *TextWriter) lineHeight() int {
+	if t.bitmap != nil {
+		return int(math.Floor(float64(t.bitmap.Height()) + t.LineSpace))
+	}
+	return int(math.Floor(t.FontSize + t.LineSpace))
+}
+
+// baseline is the distance from the top of a line to its baseline
+func (t *TextWriter) baseline() int {
+	if t.bitmap != nil {
+		return t.bitmap.Ascent
+	}
+	return int(math.Floor(t.FontSize))
+}
+
 // Write ...
 func (t *TextWriter) Write(canvas draw.Image, bounds image.Rectangle, str []string, clr color.Color) error {
 	drawer, err := t.getDrawer(canvas, clr)
 	if err != nil {
 		return err
 	}
+	str = t.prepareAll(str)
 	startX := bounds.Min.X + t.XStartCorrection
 
 	// lineY represents how much space to add for the newline
-	lineY := int(math.Floor(t.FontSize + t.LineSpace))
+	lineY := t.lineHeight()
 
-	y := int(math.Floor(t.FontSize)) + bounds.Min.Y + t.YStartCorrection
+	y := t.baseline() + bounds.Min.Y + t.YStartCorrection
 	drawer.Dot = fixed.P(startX, y)
 
 	for _, s := range str {
@@ -139,6 +306,7 @@ func (t *TextWriter) WriteAligned(align Align, canvas draw.Image, bounds image.R
 	if err != nil {
 		return err
 	}
+	str = t.prepareAll(str)
 
 	var maxXWidth fixed.Int26_6
 	for _, s := range str {
@@ -147,7 +315,7 @@ func (t *TextWriter) WriteAligned(align Align, canvas draw.Image, bounds image.R
 		}
 	}
 
-	yHeight := len(str) * int(math.Floor(t.FontSize+t.LineSpace))
+	yHeight := len(str) * t.lineHeight()
 
 	writeBox, err := AlignPosition(align, bounds, maxXWidth.Floor(), yHeight)
 	if err != nil {
@@ -155,10 +323,10 @@ func (t *TextWriter) WriteAligned(align Align, canvas draw.Image, bounds image.R
 	}
 
 	// lineY represents how much space to add for the newline
-	lineY := int(math.Floor(t.FontSize + t.LineSpace))
+	lineY := t.lineHeight()
 
 	startX := writeBox.Min.X + t.XStartCorrection
-	y := int(math.Floor(t.FontSize)) + writeBox.Min.Y + t.YStartCorrection
+	y := t.baseline() + writeBox.Min.Y + t.YStartCorrection
 	drawer.Dot = fixed.P(startX, y)
 
 	for _, s := range str {
@@ -179,7 +347,7 @@ func (t *TextWriter) MeasureStrings(canvas draw.Image, str []string) ([]int, err
 	}
 
 	for i, s := range str {
-		lengths[i] = drawer.MeasureString(s).Ceil()
+		lengths[i] = drawer.MeasureString(t.prepare(s)).Ceil()
 	}
 
 	return lengths, nil
@@ -211,6 +379,7 @@ func (t *TextWriter) WriteAlignedBoxed(align Align, canvas draw.Image, bounds im
 	if err != nil {
 		return err
 	}
+	str = t.prepareAll(str)
 
 	var maxXWidth fixed.Int26_6
 	for _, s := range str {
@@ -219,7 +388,7 @@ func (t *TextWriter) WriteAlignedBoxed(align Align, canvas draw.Image, bounds im
 		}
 	}
 
-	yHeight := len(str) * int(math.Floor(t.FontSize+t.LineSpace))
+	yHeight := len(str) * t.lineHeight()
 
 	boxAlign, err := AlignPosition(align, bounds, maxXWidth.Ceil(), yHeight)
 	if err != nil {
@@ -233,10 +402,10 @@ func (t *TextWriter) WriteAlignedBoxed(align Align, canvas draw.Image, bounds im
 	}
 
 	// lineY represents how much space to add for the newline
-	lineY := int(math.Floor(t.FontSize + t.LineSpace))
+	lineY := t.lineHeight()
 
 	startX := writeBox.Min.X + t.XStartCorrection
-	y := int(math.Floor(t.FontSize)) + writeBox.Min.Y + t.YStartCorrection
+	y := t.baseline() + writeBox.Min.Y + t.YStartCorrection
 	drawer.Dot = fixed.P(startX, y)
 
 	for _, s := range str {
@@ -260,7 +429,7 @@ func (t *TextWriter) WriteAlignedColorCodes(align Align, canvas draw.Image, boun
 	}
 	maxXWidth := t.maxWidth(colorChars, drawer)
 
-	yHeight := len(colorChars.Lines) * int(math.Floor(t.FontSize+t.LineSpace))
+	yHeight := len(colorChars.Lines) * t.lineHeight()
 
 	if colorChars.BoxClr != nil {
 		boxAlign, err := AlignPosition(align, bounds, maxXWidth.Ceil(), yHeight)
@@ -276,10 +445,10 @@ func (t *TextWriter) WriteAlignedColorCodes(align Align, canvas draw.Image, boun
 	}
 
 	// lineY represents how much space to add for the newline
-	lineY := int(math.Floor(t.FontSize + t.LineSpace))
+	lineY := t.lineHeight()
 
 	startX := writeBox.Min.X + t.XStartCorrection
-	y := int(math.Floor(t.FontSize)) + writeBox.Min.Y + t.YStartCorrection
+	y := t.baseline() + writeBox.Min.Y + t.YStartCorrection
 
 	pt := fixed.P(startX, y)
 	prev := []string{}
@@ -290,6 +459,7 @@ func (t *TextWriter) WriteAlignedColorCodes(align Align, canvas draw.Image, boun
 			if err != nil {
 				return err
 			}
+			char = t.prepare(char)
 			str := strings.Join(prev, "")
 			prevWidth := drawer.MeasureString(str)
 			drawer.Dot = pt
@@ -316,10 +486,10 @@ func (t *TextWriter) WriteColorCodes(canvas draw.Image, bounds image.Rectangle,
 	}
 
 	// lineY represents how much space to add for the newline
-	lineY := int(math.Floor(t.FontSize + t.LineSpace))
+	lineY := t.lineHeight()
 
 	startX := bounds.Min.X + t.XStartCorrection
-	y := int(math.Floor(t.FontSize)) + bounds.Min.Y + t.YStartCorrection
+	y := t.baseline() + bounds.Min.Y + t.YStartCorrection
 
 	pt := fixed.P(startX, y)
 	prev := []string{}
@@ -330,6 +500,7 @@ func (t *TextWriter) WriteColorCodes(canvas draw.Image, bounds image.Rectangle,
 			if err != nil {
 				return err
 			}
+			char = t.prepare(char)
 			str := strings.Join(prev, "")
 			prevWidth := drawer.MeasureString(str)
 			drawer.Dot = pt
@@ -349,7 +520,7 @@ func (t *TextWriter) WriteColorCodes(canvas draw.Image, bounds image.Rectangle,
 func (t *TextWriter) maxWidth(clrChars *ColorChar, drawer *font.Drawer) fixed.Int26_6 {
 	var m fixed.Int26_6
 	for _, line := range clrChars.Lines {
-		str := strings.Join(line.Chars, "")
+		str := t.prepare(strings.Join(line.Chars, ""))
 		if width := drawer.MeasureString(str); width > m {
 			m = width
 		}
@@ -375,7 +546,7 @@ func (t *TextWriter) BreakText(canvas draw.Image, maxPixWidth int, text string)
 		return []string{}, err
 	}
 
-	return breakText(maxChar, text), nil
+	return breakText(maxChar, t.prepare(text)), nil
 }
 
 func breakText(maxLineLen int, text string) []string {
@@ -386,14 +557,15 @@ func breakText(maxLineLen int, text string) []string {
 	lineIndex := 0
 	num := 0
 	for i, s := range words {
-		if num+len(s) >= maxLineLen {
+		wordLen := utf8.RuneCountInString(s)
+		if num+wordLen >= maxLineLen {
 			lines = append(lines, []string{})
 			lines[lineIndex+1] = append(lines[lineIndex+1], s)
 			lineIndex++
-			num = len(s)
+			num = wordLen
 		} else {
 			lines[lineIndex] = append(lines[lineIndex], s)
-			num += len(s)
+			num += wordLen
 			if i != 0 && i != len(words)-1 {
 				num++
 			}
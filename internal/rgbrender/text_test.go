@@ -49,6 +49,12 @@ func TestBreakText(t *testing.T) {
 				"baz",
 			},
 		},
+		{
+			name:     "multibyte",
+			in:       "Luka Dončić Jokić",
+			max:      11,
+			expected: []string{"Luka Dončić", "Jokić"},
+		},
 	}
 
 	for _, test := range tests {
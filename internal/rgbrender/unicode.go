@@ -0,0 +1,318 @@
+package rgbrender
+
+import (
+	"image"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/text/unicode/norm"
+)
+
+// builtinFallbacks are the builtin fonts used for characters missing from a writer's fonts. They
+// cover Latin, Greek and Cyrillic, and the one nearest the writer's size is used.
+var builtinFallbacks = []string{"4x6.bdf", "5x7.bdf", "5x8.bdf", "6x9.bdf", "6x10.bdf"}
+
+var (
+	fallbackFonts []*Font
+	transliterate bool
+	unicodeLock   sync.RWMutex
+)
+
+// SetFallbackFonts sets the fonts tried, in order, for characters missing from every writer's
+// font, before the builtin fallbacks
+func SetFallbackFonts(names ...string) error {
+	fonts, err := loadFonts(names)
+	if err != nil {
+		return err
+	}
+
+	unicodeLock.Lock()
+	defer unicodeLock.Unlock()
+	fallbackFonts = fonts
+
+	return nil
+}
+
+// SetTransliterate sets whether writers replace characters that none of their fonts have with
+// an ASCII equivalent, unless a writer chooses otherwise
+func SetTransliterate(on bool) {
+	unicodeLock.Lock()
+	defer unicodeLock.Unlock()
+	transliterate = on
+}
+
+func loadFonts(names []string) ([]*Font, error) {
+	fonts := make([]*Font, 0, len(names))
+	for _, name := range names {
+		f, err := LoadFont(name)
+		if err != nil {
+			return nil, err
+		}
+		fonts = append(fonts, f)
+	}
+
+	return fonts, nil
+}
+
+// HasGlyph determines if the font has a glyph for a character
+func (f *Font) HasGlyph(r rune) bool {
+	if f.Bitmap != nil {
+		return f.Bitmap.HasGlyph(r)
+	}
+	return f.TrueType != nil && f.TrueType.Index(r) != 0
+}
+
+// SetFallbacks sets the fonts tried, in order, for characters missing from the writer's font
+func (t *TextWriter) SetFallbacks(fonts ...*Font) {
+	t.fallbacks = fonts
+}
+
+// SetTransliterate overrides the global transliteration setting for the writer
+func (t *TextWriter) SetTransliterate(on bool) {
+	t.transliterate = &on
+}
+
+func (t *TextWriter) transliterates() bool {
+	if t.transliterate != nil {
+		return *t.transliterate
+	}
+	unicodeLock.RLock()
+	defer unicodeLock.RUnlock()
+	return transliterate
+}
+
+// fontChain is the writer's font followed by its fallbacks, the global fallbacks and the builtin
+// fallback nearest its size
+func (t *TextWriter) fontChain() []*Font {
+	chain := []*Font{{TrueType: t.font, Bitmap: t.bitmap}}
+	chain = append(chain, t.fallbacks...)
+
+	unicodeLock.RLock()
+	chain = append(chain, fallbackFonts...)
+	unicodeLock.RUnlock()
+
+	if f := nearestBuiltinFallback(t.FontSize); f != nil {
+		chain = append(chain, f)
+	}
+
+	return chain
+}
+
+func nearestBuiltinFallback(size float64) *Font {
+	var nearest *Font
+	diff := math.MaxFloat64
+	for _, name := range builtinFallbacks {
+		f, err := LoadFont(name)
+		if err != nil || f.Bitmap == nil {
+			continue
+		}
+		if d := math.Abs(float64(f.Bitmap.Height()) - size); d < diff {
+			nearest = f
+			diff = d
+		}
+	}
+
+	return nearest
+}
+
+// HasGlyphs determines if the writer can draw every character of a string with its fonts
+func (t *TextWriter) HasGlyphs(s string) bool {
+	return len(t.MissingGlyphs(s)) == 0
+}
+
+// MissingGlyphs returns the characters of a string that none of the writer's fonts have
+func (t *TextWriter) MissingGlyphs(s string) []rune {
+	chain := t.fontChain()
+	missing := []rune{}
+	for _, r := range s {
+		if unicode.IsSpace(r) || covered(chain, r) {
+			continue
+		}
+		missing = append(missing, r)
+	}
+
+	return missing
+}
+
+func covered(chain []*Font, r rune) bool {
+	for _, f := range chain {
+		if f.HasGlyph(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// prepare transliterates the characters of a string that the writer can't draw, if it's enabled
+func (t *TextWriter) prepare(s string) string {
+	if !t.transliterates() {
+		return s
+	}
+
+	chain := t.fontChain()
+	var b strings.Builder
+	for _, r := range s {
+		if r < utf8.RuneSelf || unicode.IsSpace(r) || covered(chain, r) {
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteString(Transliterate(string(r)))
+	}
+
+	return b.String()
+}
+
+func (t *TextWriter) prepareAll(str []string) []string {
+	out := make([]string, len(str))
+	for i, s := range str {
+		out[i] = t.prepare(s)
+	}
+	return out
+}
+
+// face returns a font.Face that draws each character with the first of the writer's fonts that has it
+func (t *TextWriter) face() font.Face {
+	chain := t.fontChain()
+	f := &fallbackFace{
+		faces: make([]font.Face, 0, len(chain)),
+		fonts: make([]*Font, 0, len(chain)),
+	}
+	for _, fnt := range chain {
+		switch {
+		case fnt.Bitmap != nil:
+			f.faces = append(f.faces, fnt.Bitmap.Face())
+		case fnt.TrueType != nil:
+			f.faces = append(f.faces, truetype.NewFace(fnt.TrueType,
+				&truetype.Options{
+					Size:    t.FontSize,
+					Hinting: font.HintingFull,
+				},
+			))
+		default:
+			continue
+		}
+		f.fonts = append(f.fonts, fnt)
+	}
+
+	if len(f.faces) == 1 {
+		return f.faces[0]
+	}
+
+	return f
+}
+
+type fallbackFace struct {
+	faces []font.Face
+	fonts []*Font
+}
+
+func (f *fallbackFace) pick(r rune) int {
+	for i, fnt := range f.fonts {
+		if fnt.HasGlyph(r) {
+			return i
+		}
+	}
+	return 0
+}
+
+func (f *fallbackFace) Close() error {
+	return nil
+}
+
+func (f *fallbackFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	return f.faces[f.pick(r)].Glyph(dot, r)
+}
+
+func (f *fallbackFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	return f.faces[f.pick(r)].GlyphBounds(r)
+}
+
+func (f *fallbackFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	return f.faces[f.pick(r)].GlyphAdvance(r)
+}
+
+func (f *fallbackFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	i := f.pick(r0)
+	if i != f.pick(r1) {
+		return 0
+	}
+	return f.faces[i].Kern(r0, r1)
+}
+
+func (f *fallbackFace) Metrics() font.Metrics {
+	return f.faces[0].Metrics()
+}
+
+// transliterations are ASCII equivalents of characters that aren't a letter plus accents
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'Æ': "AE", 'ø': "o", 'Ø': "O", 'œ': "oe", 'Œ': "OE", 'ł': "l", 'Ł': "L",
+	'đ': "d", 'Đ': "D", 'ð': "d", 'Ð': "D", 'þ': "th", 'Þ': "Th", 'ı': "i", 'ħ': "h", 'Ħ': "H",
+	'‘': "'", '’': "'", '‚': "'", '′': "'", '“': "\"", '”': "\"", '„': "\"", '«': "\"", '»': "\"",
+	'–': "-", '—': "-", '‐': "-", '…': "...", '•': "*", '·': ".", ' ': " ", '€': "EUR", '£': "GBP",
+
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i",
+	'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s",
+	'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// Transliterate replaces the non-ASCII characters of a string with ASCII equivalents, ie. "Dončić"
+// becomes "Doncic". Accents are removed, and Cyrillic and Greek letters are romanized. Characters
+// with no equivalent are kept.
+func Transliterate(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFC.String(s) {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+		if t, ok := transliterateRune(r); ok {
+			b.WriteString(t)
+			continue
+		}
+
+		// Strip the accents from a letter
+		for _, d := range norm.NFD.String(string(r)) {
+			if unicode.Is(unicode.Mn, d) {
+				continue
+			}
+			if t, ok := transliterateRune(d); ok {
+				b.WriteString(t)
+			} else {
+				b.WriteRune(d)
+			}
+		}
+	}
+
+	return norm.NFC.String(b.String())
+}
+
+func transliterateRune(r rune) (string, bool) {
+	if r < utf8.RuneSelf {
+		return string(r), true
+	}
+	if t, ok := transliterations[r]; ok {
+		return t, true
+	}
+
+	t, ok := transliterations[unicode.ToLower(r)]
+	if !ok || t == "" {
+		return t, ok
+	}
+
+	// Capitalize the romanization of an uppercase letter
+	first, size := utf8.DecodeRuneInString(t)
+	return string(unicode.ToUpper(first)) + t[size:], true
+}
@@ -0,0 +1,64 @@
+package rgbrender
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestTransliterate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{in: "Dončić", expected: "Doncic"},
+		{in: "Müller Straße", expected: "Muller Strasse"},
+		{in: "Ødegaard", expected: "Odegaard"},
+		{in: "Овечкин", expected: "Ovechkin"},
+		{in: "Малкин Й", expected: "Malkin Y"},
+		{in: "Αντετοκούνμπο", expected: "Antetokoynmpo"},
+		{in: "“Quoted” – dash…", expected: "\"Quoted\" - dash..."},
+		{in: "大谷", expected: "大谷"},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, Transliterate(test.in), test.in)
+	}
+}
+
+func TestFallbackFonts(t *testing.T) {
+	t.Parallel()
+
+	writer, err := DefaultTextWriter()
+	require.NoError(t, err)
+
+	// The TrueType font is ASCII only, so accents come from a builtin bitmap font
+	require.False(t, writer.fontChain()[0].HasGlyph('č'))
+	require.True(t, writer.HasGlyphs("Dončić"))
+	require.Equal(t, []rune{'大'}, writer.MissingGlyphs("Ohtani 大"))
+
+	canvas := image.NewRGBA(image.Rect(0, 0, 64, 16))
+	widths, err := writer.MeasureStrings(canvas, []string{"č"})
+	require.NoError(t, err)
+	require.Greater(t, widths[0], 0)
+	require.NoError(t, writer.Write(canvas, canvas.Bounds(), []string{"Dončić"}, color.White))
+}
+
+func TestWriterTransliterate(t *testing.T) {
+	t.Parallel()
+
+	writer, err := DefaultTextWriter()
+	require.NoError(t, err)
+	require.NoError(t, writer.Configure(&FontConfig{Transliterate: atomic.NewBool(true)}))
+
+	// Characters that a fallback font has are kept, the rest are transliterated
+	require.Equal(t, "Dončić Nguyen", writer.prepare("Dončić Nguyễn"))
+
+	writer.SetTransliterate(false)
+	require.Equal(t, "Nguyễn", writer.prepare("Nguyễn"))
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/robbydyer/sports/internal/board"
 	"github.com/robbydyer/sports/internal/matrix"
+	"github.com/robbydyer/sports/internal/metrics"
 )
 
 var (
@@ -58,6 +59,8 @@ type ScrollCanvas struct {
 	speedLock           sync.Mutex
 	matchScrollCtx      context.Context
 	matchScrollCancel   context.CancelFunc
+	trackedBytes        int
+	memoryLock          sync.Mutex
 }
 
 type subCanvasHorizontal struct {
@@ -115,6 +118,7 @@ func (c *ScrollCanvas) GetActual() *image.RGBA {
 // This should be called whenever a ScrollCanvas is used that is not Rendered
 // at some point or after Rendering
 func (c *ScrollCanvas) GC() {
+	c.untrackMemory()
 	for i := range c.subCanvases {
 		c.subCanvases[i] = nil
 	}
@@ -126,6 +130,61 @@ func (c *ScrollCanvas) GC() {
 	c.actual = nil
 }
 
+// memoryBytes is the size of the image data held by the canvas
+func (c *ScrollCanvas) memoryBytes() int {
+	seen := make(map[*image.RGBA]struct{})
+	total := 0
+	add := func(img *image.RGBA) {
+		if img == nil {
+			return
+		}
+		if _, ok := seen[img]; ok {
+			return
+		}
+		seen[img] = struct{}{}
+		total += len(img.Pix)
+	}
+
+	add(c.actual)
+	for _, a := range c.actuals {
+		add(a)
+	}
+	for _, sub := range c.subCanvases {
+		if sub != nil {
+			add(sub.img)
+		}
+	}
+
+	return total
+}
+
+func (c *ScrollCanvas) metricName() string {
+	if c.name != "" {
+		return c.name
+	}
+	return c.Name()
+}
+
+// trackMemory adds the canvas's image data to the scroll canvas memory metric until it's GC'd
+func (c *ScrollCanvas) trackMemory() {
+	b := c.memoryBytes()
+
+	c.memoryLock.Lock()
+	defer c.memoryLock.Unlock()
+	metrics.ScrollCanvasBytes.Add(float64(b-c.trackedBytes), c.metricName())
+	c.trackedBytes = b
+}
+
+func (c *ScrollCanvas) untrackMemory() {
+	c.memoryLock.Lock()
+	defer c.memoryLock.Unlock()
+	if c.trackedBytes == 0 {
+		return
+	}
+	metrics.ScrollCanvasBytes.Add(-float64(c.trackedBytes), c.metricName())
+	c.trackedBytes = 0
+}
+
 func (c *ScrollCanvas) AddCanvas(add draw.Image) {
 	if c.direction != RightToLeft && c.direction != LeftToRight {
 		return
@@ -279,6 +338,7 @@ func (c *ScrollCanvas) Close() error {
 
 // Render update the display with the data from the LED buffer
 func (c *ScrollCanvas) Render(ctx context.Context) error {
+	c.trackMemory()
 	defer func() {
 		if c.matchScrollCancel != nil {
 			c.matchScrollCancel()
@@ -550,6 +610,7 @@ SUBS:
 		)
 	}
 	c.log.Debug("done defining sub canvases")
+	c.trackMemory()
 }
 
 func (c *ScrollCanvas) rightToLeft(ctx context.Context) error {
@@ -3,12 +3,14 @@ package scrollcanvas
 import (
 	"image"
 	"io"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
 	"github.com/robbydyer/sports/internal/matrix"
+	"github.com/robbydyer/sports/internal/metrics"
 )
 
 func TestScrollCanvas(t *testing.T) {
@@ -24,3 +26,31 @@ func TestScrollCanvas(t *testing.T) {
 	require.Equal(t, 64, c.w)
 	require.Equal(t, 32, c.h)
 }
+
+func TestTrackMemory(t *testing.T) {
+	t.Parallel()
+	l := zaptest.NewLogger(t)
+	m := matrix.NewConsoleMatrix(64, 32, io.Discard, l)
+	c, err := NewScrollCanvas(m, l, WithName("test-track-memory"))
+	require.NoError(t, err)
+	c.actual = image.NewRGBA(image.Rect(0, 0, 64, 32))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c.trackMemory()
+		}()
+		go func() {
+			defer wg.Done()
+			c.untrackMemory()
+		}()
+	}
+	wg.Wait()
+
+	c.trackMemory()
+	require.Equal(t, float64(64*32*4), metrics.ScrollCanvasBytes.Value(c.name))
+	c.GC()
+	require.Equal(t, float64(0), metrics.ScrollCanvasBytes.Value(c.name))
+}
@@ -0,0 +1,214 @@
+package sportsmatrix
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/rgbrender"
+	scrcnvs "github.com/robbydyer/sports/internal/scrollcanvas"
+)
+
+var separatorColor = color.RGBA{R: 100, G: 100, B: 100, A: 255}
+
+// SetCombinedScroll turns combined scroll mode on or off. The change takes effect
+// after the current board or scroll finishes.
+func (s *SportsMatrix) SetCombinedScroll(on bool) {
+	if s.combinedScroll.Swap(on) == on {
+		return
+	}
+
+	s.log.Info("combined scroll mode changed",
+		zap.Bool("on", on),
+	)
+}
+
+// scrollCanvas returns the first enabled ScrollCanvas
+func (s *SportsMatrix) scrollCanvas() *scrcnvs.ScrollCanvas {
+	for _, canvas := range s.canvases {
+		if !canvas.Enabled() {
+			continue
+		}
+		if scr, ok := canvas.(*scrcnvs.ScrollCanvas); ok {
+			return scr
+		}
+	}
+
+	return nil
+}
+
+// scrollBoards returns the enabled boards that can render to a ScrollCanvas. Boards that an
+// enabled WrapperBoard already renders, such as the leagues in the Ticker, are skipped so that
+// their content isn't scrolled twice.
+func (s *SportsMatrix) scrollBoards() []board.ScrollBoard {
+	wrapped := make(map[board.Board]struct{})
+	for _, b := range s.boards {
+		if w, ok := b.(board.WrapperBoard); ok && b.Enabler().Enabled() {
+			for _, inner := range w.WrappedBoards() {
+				wrapped[inner] = struct{}{}
+			}
+		}
+	}
+
+	var boards []board.ScrollBoard
+	for _, b := range s.boards {
+		if !b.Enabler().Enabled() {
+			continue
+		}
+		if _, ok := wrapped[b]; ok {
+			continue
+		}
+		if sb, ok := b.(board.ScrollBoard); ok {
+			boards = append(boards, sb)
+		}
+	}
+
+	return boards
+}
+
+// serveCombinedScroll renders all scroll capable boards as one continuous scroll. Boards are
+// merged in chunks of PreloadThreads, so that only that many boards' images are held in
+// memory at once. Returns false if there is nothing to render in combined mode.
+func (s *SportsMatrix) serveCombinedScroll(ctx context.Context) bool {
+	base := s.scrollCanvas()
+	if base == nil {
+		return false
+	}
+
+	boards := s.scrollBoards()
+	if len(boards) < 1 {
+		return false
+	}
+
+	chunk := s.cfg.PreloadThreads
+	if chunk < 1 {
+		chunk = len(boards)
+	}
+
+	s.log.Debug("rendering combined scroll",
+		zap.Int("boards", len(boards)),
+		zap.Int("chunk size", chunk),
+	)
+
+	rendered := false
+
+	for start := 0; start < len(boards); start += chunk {
+		select {
+		case <-ctx.Done():
+			return true
+		default:
+		}
+
+		if !s.combinedScroll.Load() || len(s.jumpTo) > 0 {
+			return true
+		}
+
+		end := start + chunk
+		if end > len(boards) {
+			end = len(boards)
+		}
+
+		did, err := s.doCombinedScroll(ctx, base, boards[start:end])
+		if err != nil {
+			s.log.Error("failed to render combined scroll",
+				zap.Error(err),
+			)
+		}
+		rendered = rendered || did
+	}
+
+	return rendered
+}
+
+// doCombinedScroll merges the given boards into a single ScrollCanvas and renders it
+func (s *SportsMatrix) doCombinedScroll(ctx context.Context, base *scrcnvs.ScrollCanvas, boards []board.ScrollBoard) (bool, error) {
+	s.boardLock.Lock()
+	defer s.boardLock.Unlock()
+
+	s.currentBoardCtx, s.currentBoardCancel = context.WithCancel(ctx)
+	defer s.currentBoardCancel()
+
+	merged, err := scrcnvs.NewScrollCanvas(base.Matrix, s.log,
+		scrcnvs.WithMergePadding(s.cfg.CombinedScrollPadding),
+		scrcnvs.WithPreloadThreads(s.cfg.PreloadThreads),
+		scrcnvs.WithName("combined"),
+	)
+	if err != nil {
+		return false, err
+	}
+	merged.SetScrollDirection(scrcnvs.RightToLeft)
+
+	names := []string{}
+
+BOARDS:
+	for _, b := range boards {
+		select {
+		case <-s.currentBoardCtx.Done():
+			merged.GC()
+			return false, context.Canceled
+		default:
+		}
+
+		c, err := b.ScrollRender(s.currentBoardCtx, base, s.cfg.CombinedScrollPadding)
+		if err != nil {
+			s.log.Error("board failed to render to combined scroll",
+				zap.String("board", b.Name()),
+				zap.Error(err),
+			)
+			s.state.finished(b.Name(), err)
+			continue BOARDS
+		}
+
+		scr, ok := c.(*scrcnvs.ScrollCanvas)
+		if !ok || scr == nil || scr.Len() < 1 {
+			continue BOARDS
+		}
+
+		if merged.Len() > 0 {
+			merged.AddCanvas(separator(base))
+		}
+
+		s.log.Debug("adding board to combined scroll",
+			zap.String("board", b.Name()),
+			zap.Int("canvases", scr.Len()),
+		)
+
+		merged.AppendAndGC(scr)
+		names = append(names, b.Name())
+	}
+
+	if merged.Len() < 1 {
+		return false, nil
+	}
+
+	s.state.started(combinedScrollName)
+	s.state.Showing(combinedScrollName, &board.Item{
+		Label: strings.Join(names, ", "),
+	})
+
+	go merged.MatchScroll(s.currentBoardCtx, base)
+
+	return true, merged.Render(s.currentBoardCtx)
+}
+
+// separator returns an image that is drawn between each board in a combined scroll
+func separator(base *scrcnvs.ScrollCanvas) draw.Image {
+	img := image.NewRGBA(base.Bounds())
+
+	zeroed := rgbrender.ZeroedBounds(base.Bounds())
+	size := zeroed.Dy() / 8
+	if size < 1 {
+		size = 1
+	}
+
+	center := image.Pt(zeroed.Min.X+(zeroed.Dx()/2), zeroed.Min.Y+(zeroed.Dy()/2))
+	dot := image.Rect(center.X-size, center.Y-size, center.X+size, center.Y+size)
+	draw.Draw(img, dot, &image.Uniform{separatorColor}, image.Point{}, draw.Over)
+
+	return img
+}
@@ -0,0 +1,62 @@
+package sportsmatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+)
+
+type wrapperBoard struct {
+	namedBoard
+	wrapped []board.Board
+}
+
+func (b *wrapperBoard) WrappedBoards() []board.Board {
+	return b.wrapped
+}
+
+func TestScrollBoards(t *testing.T) {
+	t.Parallel()
+
+	newBoard := func(name string) *namedBoard {
+		b := &namedBoard{
+			TestBoard: TestBoard{
+				log:         zap.NewNop(),
+				hasRendered: atomic.NewBool(false),
+				enabler:     enabler.New(),
+			},
+			name: name,
+		}
+		b.enabler.Enable()
+		return b
+	}
+
+	nhl := newBoard("nhl")
+	mlb := newBoard("mlb")
+	ticker := &wrapperBoard{
+		namedBoard: *newBoard("ticker"),
+		wrapped:    []board.Board{nhl},
+	}
+
+	s := &SportsMatrix{
+		boards: []board.Board{nhl, mlb, ticker},
+	}
+
+	names := func() []string {
+		var n []string
+		for _, b := range s.scrollBoards() {
+			n = append(n, b.Name())
+		}
+		return n
+	}
+
+	require.Equal(t, []string{"mlb", "ticker"}, names())
+
+	ticker.Enabler().Disable()
+	require.Equal(t, []string{"nhl", "mlb"}, names())
+}
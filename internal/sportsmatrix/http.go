@@ -14,8 +14,11 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/twitchtv/twirp"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/fetch"
+	"github.com/robbydyer/sports/internal/metrics"
 	pb "github.com/robbydyer/sports/internal/proto/sportsmatrix"
 	"github.com/robbydyer/sports/internal/twirphelpers"
 )
@@ -141,6 +144,9 @@ func (s *SportsMatrix) startHTTP() chan error {
 	// router.Handle(twirpHandler.PathPrefix(), twirpHandler)
 	router.PathPrefix(twirpHandler.PathPrefix()).Handler(twirpHandler)
 
+	router.Handle("/metrics", metrics.Handler())
+	router.Handle("/healthz", metrics.HealthHandler())
+
 	if s.cfg.ServeWebUI {
 		filesys := fs.FS(assets)
 		web, err := fs.Sub(filesys, "assets/web")
@@ -172,6 +178,21 @@ func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 				_, _ = w.Write([]byte(version))
 			},
 		},
+		{
+			Path: "/api/matrixsize",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				if len(s.canvases) < 1 {
+					http.Error(w, "no canvases", http.StatusNotFound)
+					return
+				}
+				bounds := s.canvases[0].Bounds()
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]int{
+					"width":  bounds.Dx(),
+					"height": bounds.Dy(),
+				})
+			},
+		},
 		{
 			Path: "/api/screenon",
 			Handler: func(w http.ResponseWriter, req *http.Request) {
@@ -286,5 +307,83 @@ func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 				s.currentBoardCancel()
 			},
 		},
+		{
+			Path: "/api/state",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				b, err := protojson.Marshal(s.CurrentState())
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(b)
+			},
+		},
+		{
+			Path:    "/api/state/stream",
+			Handler: s.streamState,
+		},
+		{
+			Path: "/api/offlineon",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				fetch.SetOffline(true)
+			},
+		},
+		{
+			Path: "/api/offlineoff",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				fetch.SetOffline(false)
+			},
+		},
+		{
+			Path: "/api/offlinestatus",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				if fetch.Offline() {
+					_, _ = w.Write([]byte("true"))
+					return
+				}
+				_, _ = w.Write([]byte("false"))
+			},
+		},
+	}
+}
+
+// streamState sends the current state as server-sent events whenever it changes
+func (s *SportsMatrix) streamState(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	changed, unsubscribe := s.state.subscribe()
+	defer unsubscribe()
+
+	// Keep the connection alive, and keep time remaining current, between changes
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		b, err := protojson.Marshal(s.CurrentState())
+		if err != nil {
+			s.log.Error("failed to marshal state", zap.Error(err))
+			return
+		}
+		if _, err := fmt.Fprintf(w, "event: state\ndata: %s\n\n", b); err != nil {
+			return
+		}
+		flusher.Flush()
+
+		select {
+		case <-req.Context().Done():
+			return
+		case <-changed:
+		case <-ticker.C:
+		}
 	}
 }
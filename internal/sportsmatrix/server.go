@@ -104,14 +104,19 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.Status) (*emptypb.Empty,
 		}
 	}
 
+	if req.CombinedScroll != s.sm.combinedScroll.Load() {
+		s.sm.SetCombinedScroll(req.CombinedScroll)
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
 // GetStatus ...
 func (s *Server) GetStatus(ctx context.Context, req *emptypb.Empty) (*pb.Status, error) {
 	return &pb.Status{
-		ScreenOn:   s.sm.screenIsOn.Load(),
-		WebboardOn: s.sm.webBoardIsOn.Load(),
+		ScreenOn:       s.sm.screenIsOn.Load(),
+		WebboardOn:     s.sm.webBoardIsOn.Load(),
+		CombinedScroll: s.sm.combinedScroll.Load(),
 	}, nil
 }
 
@@ -157,3 +162,8 @@ func (s *Server) SetLiveOnly(ctx context.Context, req *pb.LiveOnlyReq) (*emptypb
 
 	return &emptypb.Empty{}, nil
 }
+
+// GetCurrentState returns what's on screen and why boards were skipped
+func (s *Server) GetCurrentState(ctx context.Context, req *emptypb.Empty) (*pb.CurrentState, error) {
+	return s.sm.CurrentState(), nil
+}
@@ -16,6 +16,8 @@ import (
 
 	"github.com/robbydyer/sports/internal/board"
 	"github.com/robbydyer/sports/internal/imgcanvas"
+	"github.com/robbydyer/sports/internal/locale"
+	"github.com/robbydyer/sports/internal/metrics"
 	rgb "github.com/robbydyer/sports/internal/rgbmatrix-rpi"
 )
 
@@ -56,6 +58,8 @@ type SportsMatrix struct {
 	webBoardCtx        context.Context
 	webBoardCancel     context.CancelFunc
 	liveOnly           *atomic.Bool
+	combinedScroll     *atomic.Bool
+	state              *stateTracker
 	sync.Mutex
 }
 
@@ -72,6 +76,12 @@ type Config struct {
 	LaunchWebBoard bool                `json:"launchWebBoard"`
 	WebBoardUser   string              `json:"webBoardUser"`
 	PreloadThreads int                 `json:"preloadThreads"`
+	// CombinedScroll merges all scroll capable boards into one continuous scroll
+	CombinedScroll        bool `json:"combinedScroll"`
+	CombinedScrollPadding int  `json:"combinedScrollPadding"`
+	// HealthFailureThreshold is the number of requests to a data source that must fail in a row
+	// before /healthz reports degraded
+	HealthFailureThreshold int `json:"healthFailureThreshold"`
 }
 
 // Defaults sets some sane config defaults
@@ -88,6 +98,12 @@ func (c *Config) Defaults() {
 	if c.WebBoardUser == "" {
 		c.WebBoardUser = "pi"
 	}
+	if c.CombinedScrollPadding == 0 {
+		c.CombinedScrollPadding = 8
+	}
+	if c.HealthFailureThreshold == 0 {
+		c.HealthFailureThreshold = metrics.DefaultFailureThreshold
+	}
 
 	if c.HardwareConfig == nil {
 		c.HardwareConfig = &rgb.DefaultConfig
@@ -144,7 +160,12 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config, canvases []board.
 		screenSwitch:  make(chan struct{}, 1),
 		webBoardWasOn: atomic.NewBool(false),
 		liveOnly:      atomic.NewBool(false),
+		state:         newStateTracker(),
 	}
+	s.combinedScroll = atomic.NewBool(cfg.CombinedScroll)
+
+	metrics.SetFailureThreshold(cfg.HealthFailureThreshold)
+	metrics.ScreenOn.SetBool(true)
 
 	s.boardCtx, s.boardCancel = context.WithCancel(context.Background())
 
@@ -167,9 +188,12 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config, canvases []board.
 
 	for _, b := range s.boards {
 		s.log.Info("Registering board", zap.String("board", b.Name()))
+		if r, ok := b.(board.Reportable); ok {
+			r.SetReporter(s.state)
+		}
 	}
 
-	c := cron.New()
+	c := cron.New(cron.WithLocation(locale.Location()))
 
 	for _, off := range s.cfg.ScreenOffTimes {
 		s.log.Info("Screen will be scheduled to turn off", zap.String("turn off", off))
@@ -205,8 +229,11 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config, canvases []board.
 }
 
 // AddBetweenBoard adds a board to be run between each enabled board
-func (s *SportsMatrix) AddBetweenBoard(board board.Board) {
-	s.betweenBoards = append(s.betweenBoards, board)
+func (s *SportsMatrix) AddBetweenBoard(b board.Board) {
+	if r, ok := b.(board.Reportable); ok {
+		r.SetReporter(s.state)
+	}
+	s.betweenBoards = append(s.betweenBoards, b)
 }
 
 // ScreenOn turns the matrix on
@@ -232,6 +259,8 @@ func (s *SportsMatrix) ScreenOn(ctx context.Context) error {
 		return nil
 	}
 
+	metrics.ScreenOn.SetBool(true)
+
 	if s.switchTestSleep {
 		s.switchedOn++
 	}
@@ -269,6 +298,7 @@ func (s *SportsMatrix) ScreenOff(ctx context.Context) error {
 	}
 
 	s.log.Warn("screen turning off")
+	metrics.ScreenOn.SetBool(false)
 
 	if s.switchTestSleep {
 		s.switchedOff++
@@ -452,14 +482,22 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 }
 
 func (s *SportsMatrix) serveLoop(ctx context.Context) {
+	// Pending jumps are handled by the regular board loop
+	if s.combinedScroll.Load() && len(s.jumpTo) < 1 {
+		if s.serveCombinedScroll(ctx) {
+			return
+		}
+	}
+
 BOARDS:
-	for _, b := range s.boards {
+	for i, b := range s.boards {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 		}
 
+		s.state.at(i)
 		s.currentBoardCtx, s.currentBoardCancel = context.WithCancel(ctx)
 		if err := s.doBoard(s.currentBoardCtx, b); err != nil {
 			s.currentBoardCancel()
@@ -530,13 +568,21 @@ func (s *SportsMatrix) doBoard(ctx context.Context, b board.Board) error {
 
 	if !b.Enabler().Enabled() {
 		// s.log.Debug("skipping disabled board", zap.String("board", b.Name()))
+		s.state.Skipped(b.Name(), disabledReason(b))
 		return nil
 	}
 
+	s.state.started(b.Name())
+
 	var wg sync.WaitGroup
 
 	var boardErr error
 
+	scrollMode := false
+	if sb, ok := b.(board.ScrollBoard); ok {
+		scrollMode = sb.ScrollMode()
+	}
+
 CANVASES:
 	for _, canvas := range s.canvases {
 		if !canvas.Enabled() {
@@ -544,10 +590,17 @@ CANVASES:
 			continue CANVASES
 		}
 
+		// Scroll mode boards only render to scroll canvases, and vice versa
+		if canvas.Scrollable() != scrollMode {
+			continue CANVASES
+		}
+
 		wg.Add(1)
 		go func(canvas board.Canvas) {
 			defer wg.Done()
 			s.log.Debug("rendering board", zap.String("board", b.Name()))
+			start := time.Now()
+			defer metrics.RenderDuration.ObserveDuration(start, b.Name())
 			if err := b.Render(s.currentBoardCtx, canvas); err != nil {
 				boardErr = err
 				s.log.Error("board render returned error",
@@ -571,6 +624,7 @@ CANVASES:
 	case <-done:
 	}
 	s.log.Debug("done waiting for canvases")
+	s.state.finished(b.Name(), boardErr)
 
 	return boardErr
 }
@@ -0,0 +1,229 @@
+package sportsmatrix
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/robbydyer/sports/internal/board"
+	pb "github.com/robbydyer/sports/internal/proto/sportsmatrix"
+)
+
+// combinedScrollName is the board name reported while the combined scroll is showing
+const combinedScrollName = "combined scroll"
+
+// boardState is what's known about a board's last turn in the rotation
+type boardState struct {
+	startedAt   time.Time
+	skipReason  string
+	lastError   string
+	lastErrorAt time.Time
+	lastShown   time.Time
+}
+
+// stateTracker tracks what's on screen and why boards were skipped. It implements board.Reporter.
+type stateTracker struct {
+	board       string
+	position    int
+	item        *board.Item
+	itemStart   time.Time
+	boards      map[string]*boardState
+	subscribers map[chan struct{}]struct{}
+	sync.RWMutex
+}
+
+func newStateTracker() *stateTracker {
+	return &stateTracker{
+		boards:      make(map[string]*boardState),
+		subscribers: make(map[chan struct{}]struct{}),
+	}
+}
+
+func (t *stateTracker) boardState(name string) *boardState {
+	s, ok := t.boards[name]
+	if !ok {
+		s = &boardState{}
+		t.boards[name] = s
+	}
+	return s
+}
+
+// at sets the position in the board rotation
+func (t *stateTracker) at(position int) {
+	t.Lock()
+	defer t.Unlock()
+	t.position = position
+}
+
+// started marks a board as the one on screen
+func (t *stateTracker) started(name string) {
+	t.Lock()
+	t.board = name
+	t.item = nil
+	t.itemStart = time.Now()
+	s := t.boardState(name)
+	s.skipReason = ""
+	s.startedAt = t.itemStart
+	t.Unlock()
+
+	t.notify()
+}
+
+// finished records the outcome of a board's turn
+func (t *stateTracker) finished(name string, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	t.Lock()
+	s := t.boardState(name)
+	switch {
+	case err != nil:
+		s.lastError = err.Error()
+		s.lastErrorAt = time.Now()
+		if s.skipReason == "" {
+			s.skipReason = board.SkipError
+		}
+	case s.skipReason == "" && s.lastShown.Before(s.startedAt):
+		s.lastShown = s.startedAt
+	}
+	t.Unlock()
+
+	t.notify()
+}
+
+// Showing implements board.Reporter
+func (t *stateTracker) Showing(name string, item *board.Item) {
+	t.Lock()
+	t.board = name
+	t.item = item
+	t.itemStart = time.Now()
+	s := t.boardState(name)
+	s.skipReason = ""
+	s.lastShown = t.itemStart
+	t.Unlock()
+
+	t.notify()
+}
+
+// Skipped implements board.Reporter
+func (t *stateTracker) Skipped(name string, reason string) {
+	t.Lock()
+	s := t.boardState(name)
+	changed := s.skipReason != reason
+	s.skipReason = reason
+	t.Unlock()
+
+	if changed {
+		t.notify()
+	}
+}
+
+// subscribe returns a channel that receives whenever the state changes, and a func to unsubscribe
+func (t *stateTracker) subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	t.Lock()
+	t.subscribers[ch] = struct{}{}
+	t.Unlock()
+
+	return ch, func() {
+		t.Lock()
+		defer t.Unlock()
+		delete(t.subscribers, ch)
+	}
+}
+
+func (t *stateTracker) notify() {
+	t.RLock()
+	defer t.RUnlock()
+	for ch := range t.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// CurrentState returns the board on screen, what it's showing, the upcoming rotation and the state
+// of every board
+func (s *SportsMatrix) CurrentState() *pb.CurrentState {
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	state := &pb.CurrentState{
+		ScreenOn: s.screenIsOn.Load(),
+		Board:    s.state.board,
+		Upcoming: s.upcoming(s.state.position),
+	}
+
+	if item := s.state.item; item != nil {
+		state.Item = &pb.BoardItem{
+			Id:    item.ID,
+			Label: item.Label,
+			Teams: item.Teams,
+		}
+		if remaining := item.Duration - time.Since(s.state.itemStart); remaining > 0 {
+			state.SecondsRemaining = remaining.Seconds()
+		}
+	}
+
+	boards := make([]board.Board, 0, len(s.boards)+len(s.betweenBoards))
+	boards = append(boards, s.boards...)
+	boards = append(boards, s.betweenBoards...)
+	for _, b := range boards {
+		bs := &pb.BoardState{
+			Name:    b.Name(),
+			Enabled: b.Enabler().Enabled(),
+		}
+		if known, ok := s.state.boards[b.Name()]; ok {
+			bs.SkipReason = known.skipReason
+			bs.LastError = known.lastError
+			bs.LastErrorTime = unixTime(known.lastErrorAt)
+			bs.LastShownTime = unixTime(known.lastShown)
+		}
+		if !bs.Enabled {
+			bs.SkipReason = disabledReason(b)
+		}
+		state.Boards = append(state.Boards, bs)
+	}
+
+	return state
+}
+
+// upcoming returns the names of the enabled boards that follow a position in the rotation
+func (s *SportsMatrix) upcoming(position int) []string {
+	var between []string
+	for _, b := range s.betweenBoards {
+		if b.Enabler().Enabled() {
+			between = append(between, b.Name())
+		}
+	}
+
+	names := []string{}
+	for i := 1; i <= len(s.boards); i++ {
+		b := s.boards[(position+i)%len(s.boards)]
+		if !b.Enabler().Enabled() {
+			continue
+		}
+		names = append(names, b.Name())
+		names = append(names, between...)
+	}
+
+	return names
+}
+
+func disabledReason(b board.Board) string {
+	if e, ok := b.Enabler().(board.ScheduledEnabler); ok && e.DisabledBySchedule() {
+		return board.SkipOffHours
+	}
+	return board.SkipDisabled
+}
+
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,96 @@
+package sportsmatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/robbydyer/sports/internal/board"
+	"github.com/robbydyer/sports/internal/enabler"
+)
+
+type namedBoard struct {
+	TestBoard
+	name string
+}
+
+func (b *namedBoard) Name() string {
+	return b.name
+}
+
+func TestCurrentState(t *testing.T) {
+	t.Parallel()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		ServeWebUI:    false,
+		WebBoardWidth: 1,
+	}
+
+	boards := []board.Board{}
+	for _, name := range []string{"nhl", "mlb", "clock", "sys"} {
+		b := &namedBoard{
+			TestBoard: TestBoard{
+				log:         logger,
+				hasRendered: atomic.NewBool(false),
+				enabler:     enabler.New(),
+			},
+			name: name,
+		}
+		b.enabler.Enable()
+		boards = append(boards, b)
+	}
+	board.DisableScheduled(boards[2].Enabler())
+	boards[3].Enabler().Disable()
+
+	s, err := New(context.Background(), logger, cfg, nil, boards...)
+	require.NoError(t, err)
+
+	changed, unsubscribe := s.state.subscribe()
+	defer unsubscribe()
+
+	s.state.at(0)
+	s.state.started("nhl")
+	s.state.Showing("nhl", &board.Item{
+		ID:       "401",
+		Label:    "BOS @ NYR",
+		Teams:    []string{"BOS", "NYR"},
+		Duration: time.Minute,
+	})
+	s.state.started("mlb")
+	s.state.Skipped("mlb", board.SkipNoLiveGames)
+	s.state.finished("mlb", errors.New("no games"))
+	s.state.started("nhl")
+	s.state.Showing("nhl", &board.Item{ID: "402", Duration: time.Minute})
+
+	select {
+	case <-changed:
+	default:
+		require.Fail(t, "subscriber was not notified")
+	}
+
+	state := s.CurrentState()
+	require.Equal(t, "nhl", state.Board)
+	require.Equal(t, "402", state.Item.Id)
+	require.InDelta(t, 60, state.SecondsRemaining, 1)
+	require.Equal(t, []string{"mlb", "nhl"}, state.Upcoming)
+
+	reasons := map[string]string{}
+	for _, b := range state.Boards {
+		reasons[b.Name] = b.SkipReason
+	}
+	require.Equal(t, map[string]string{
+		"nhl":   "",
+		"mlb":   board.SkipNoLiveGames,
+		"clock": board.SkipOffHours,
+		"sys":   board.SkipDisabled,
+	}, reasons)
+	require.Equal(t, "no games", state.Boards[1].LastError)
+	require.NotZero(t, state.Boards[0].LastShownTime)
+}
@@ -0,0 +1,339 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/robbydyer/sports/internal/rgbrender"
+)
+
+// DefaultName is the name of the default theme
+const DefaultName = "default"
+
+// DefaultMinContrast is the minimum contrast ratio a team color needs against the black of an
+// unlit LED. Darker colors are lightened until they reach it.
+const DefaultMinContrast = 3.0
+
+// Theme is a named palette of the colors boards draw with. Colors are hex strings, ie. "FFB000".
+// Any color left empty is taken from the default theme.
+type Theme struct {
+	Name string `json:"name"`
+	// Text is the color of most text
+	Text string `json:"text"`
+	// Secondary is the color of less important text, such as story summaries
+	Secondary string `json:"secondary"`
+	// Time is the color of game times, periods and clocks
+	Time string `json:"time"`
+	// Score is the color of scores
+	Score string `json:"score"`
+	// Scored is the color of a score that just changed
+	Scored string `json:"scored"`
+	// Favorite is the color of favorite teams and rankings
+	Favorite string `json:"favorite"`
+	// Highlight is the color of highlighted details, such as baserunners and favorite players
+	Highlight string `json:"highlight"`
+	// Background is the color behind text, including the gradient between logos
+	Background string `json:"background"`
+	// TeamColors draws accents, such as scores, borders and ticker separators, in team colors
+	TeamColors bool `json:"teamColors"`
+	// MinContrast is the minimum contrast of team colors against black. Defaults to DefaultMinContrast
+	MinContrast float64 `json:"minContrast"`
+}
+
+var builtinThemes = []*Theme{
+	{
+		Name:       DefaultName,
+		Text:       "FFFFFF",
+		Secondary:  "AAAAAA",
+		Time:       "FFFFFF",
+		Score:      "FFFFFF",
+		Scored:     "FF0000",
+		Favorite:   "00FF00",
+		Highlight:  "FFFF00",
+		Background: "000000",
+	},
+	{
+		Name:       "amber",
+		Text:       "FFB000",
+		Secondary:  "A06000",
+		Time:       "FFB000",
+		Score:      "FFD060",
+		Scored:     "FF3000",
+		Favorite:   "FFFFFF",
+		Highlight:  "FFE000",
+		Background: "000000",
+	},
+	{
+		Name:       "ice",
+		Text:       "D0F0FF",
+		Secondary:  "6090B0",
+		Time:       "80D0FF",
+		Score:      "FFFFFF",
+		Scored:     "FF4080",
+		Favorite:   "40FFC0",
+		Highlight:  "00A0FF",
+		Background: "000000",
+	},
+	{
+		Name:       "retro",
+		Text:       "33FF33",
+		Secondary:  "119911",
+		Time:       "33FF33",
+		Score:      "99FF99",
+		Scored:     "FFFF33",
+		Favorite:   "FFFFFF",
+		Highlight:  "FFFF33",
+		Background: "000000",
+	},
+	{
+		Name:       "team",
+		Text:       "FFFFFF",
+		Secondary:  "AAAAAA",
+		Time:       "FFFFFF",
+		Score:      "FFFFFF",
+		Scored:     "FF0000",
+		Favorite:   "00FF00",
+		Highlight:  "FFFF00",
+		Background: "000000",
+		TeamColors: true,
+	},
+}
+
+var (
+	themes      = make(map[string]*Theme)
+	defaultName = DefaultName
+	themeLock   sync.RWMutex
+)
+
+func init() {
+	for _, t := range builtinThemes {
+		themes[t.Name] = t
+	}
+}
+
+// Register adds a theme, replacing any theme of the same name
+func Register(t *Theme) error {
+	if t == nil || t.Name == "" {
+		return fmt.Errorf("theme is missing a name")
+	}
+	for name, hex := range t.colors() {
+		if hex == "" {
+			continue
+		}
+		if _, _, _, err := hexToRGB(hex); err != nil {
+			return fmt.Errorf("theme '%s' has an invalid %s color '%s': %w", t.Name, name, hex, err)
+		}
+	}
+
+	themeLock.Lock()
+	defer themeLock.Unlock()
+	themes[strings.ToLower(t.Name)] = t
+
+	return nil
+}
+
+// Get returns a theme by name. An empty name is the default theme.
+func Get(name string) (*Theme, error) {
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+
+	if name == "" {
+		name = defaultName
+	}
+	t, ok := themes[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme '%s'", name)
+	}
+
+	return t, nil
+}
+
+// Resolve returns a theme by name, falling back to the default theme if it doesn't exist
+func Resolve(name string) *Theme {
+	if t, err := Get(name); err == nil {
+		return t
+	}
+	return Default()
+}
+
+// SetDefault sets the theme used by boards that don't choose their own
+func SetDefault(name string) error {
+	if _, err := Get(name); err != nil {
+		return err
+	}
+
+	themeLock.Lock()
+	defer themeLock.Unlock()
+	defaultName = strings.ToLower(name)
+
+	return nil
+}
+
+// Default returns the theme used by boards that don't choose their own
+func Default() *Theme {
+	t, err := Get("")
+	if err != nil {
+		return builtinThemes[0]
+	}
+	return t
+}
+
+// Names lists the available themes
+func Names() []string {
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (t *Theme) colors() map[string]string {
+	return map[string]string{
+		"text":       t.Text,
+		"secondary":  t.Secondary,
+		"time":       t.Time,
+		"score":      t.Score,
+		"scored":     t.Scored,
+		"favorite":   t.Favorite,
+		"highlight":  t.Highlight,
+		"background": t.Background,
+	}
+}
+
+// color parses a hex color, falling back to the default theme's color
+func (t *Theme) color(hex string, fallback string) color.Color {
+	if hex == "" {
+		hex = fallback
+	}
+	r, g, b, err := hexToRGB(hex)
+	if err != nil {
+		r, g, b, _ = hexToRGB(fallback)
+	}
+	return color.RGBA{r, g, b, 255}
+}
+
+// hexToRGB parses a hex color with or without a leading #
+func hexToRGB(hex string) (uint8, uint8, uint8, error) {
+	return rgbrender.HexToRGB(strings.TrimPrefix(hex, "#"))
+}
+
+// TextColor ...
+func (t *Theme) TextColor() color.Color {
+	return t.color(t.Text, builtinThemes[0].Text)
+}
+
+// SecondaryColor ...
+func (t *Theme) SecondaryColor() color.Color {
+	return t.color(t.Secondary, builtinThemes[0].Secondary)
+}
+
+// TimeColor ...
+func (t *Theme) TimeColor() color.Color {
+	return t.color(t.Time, builtinThemes[0].Time)
+}
+
+// ScoreColor ...
+func (t *Theme) ScoreColor() color.Color {
+	return t.color(t.Score, builtinThemes[0].Score)
+}
+
+// ScoredColor ...
+func (t *Theme) ScoredColor() color.Color {
+	return t.color(t.Scored, builtinThemes[0].Scored)
+}
+
+// FavoriteColor ...
+func (t *Theme) FavoriteColor() color.Color {
+	return t.color(t.Favorite, builtinThemes[0].Favorite)
+}
+
+// HighlightColor ...
+func (t *Theme) HighlightColor() color.Color {
+	return t.color(t.Highlight, builtinThemes[0].Highlight)
+}
+
+// BackgroundColor ...
+func (t *Theme) BackgroundColor() color.Color {
+	return t.color(t.Background, builtinThemes[0].Background)
+}
+
+// TeamColor returns the first of a team's colors that stands out against black, lightening the
+// primary color if neither does. It returns nil if the team has no colors.
+func (t *Theme) TeamColor(primary color.Color, alternate color.Color) color.Color {
+	minContrast := t.MinContrast
+	if minContrast <= 0 {
+		minContrast = DefaultMinContrast
+	}
+
+	for _, c := range []color.Color{primary, alternate} {
+		if c != nil && Contrast(c, color.Black) >= minContrast {
+			return c
+		}
+	}
+	if primary == nil {
+		primary = alternate
+	}
+	if primary == nil {
+		return nil
+	}
+
+	return Readable(primary, minContrast)
+}
+
+// Luminance is the relative luminance of a color, as defined by WCAG
+func Luminance(c color.Color) float64 {
+	r, g, b, _ := c.RGBA()
+	channel := func(v uint32) float64 {
+		f := float64(v) / 0xffff
+		if f <= 0.03928 {
+			return f / 12.92
+		}
+		return math.Pow((f+0.055)/1.055, 2.4)
+	}
+
+	return 0.2126*channel(r) + 0.7152*channel(g) + 0.0722*channel(b)
+}
+
+// Contrast is the WCAG contrast ratio of two colors, from 1 to 21
+func Contrast(a color.Color, b color.Color) float64 {
+	la, lb := Luminance(a), Luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// Readable lightens a color towards white until its contrast against black is at least
+// minContrast, so that dark colors such as navy don't disappear on an LED matrix
+func Readable(c color.Color, minContrast float64) color.Color {
+	r, g, b, _ := c.RGBA()
+	base := color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8), 255}
+
+	for step := 0; step <= 20; step++ {
+		mix := float64(step) / 20
+		lighter := color.RGBA{
+			R: blend(base.R, mix),
+			G: blend(base.G, mix),
+			B: blend(base.B, mix),
+			A: 255,
+		}
+		if Contrast(lighter, color.Black) >= minContrast {
+			return lighter
+		}
+	}
+
+	return color.White
+}
+
+func blend(v uint8, mix float64) uint8 {
+	return uint8(math.Round(float64(v) + (255-float64(v))*mix))
+}
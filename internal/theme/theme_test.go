@@ -0,0 +1,80 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContrast(t *testing.T) {
+	t.Parallel()
+
+	require.InDelta(t, 21, Contrast(color.White, color.Black), 0.01)
+	require.InDelta(t, 1, Contrast(color.Black, color.Black), 0.01)
+	require.Equal(t, Contrast(color.White, color.Black), Contrast(color.Black, color.White))
+}
+
+func TestTeamColor(t *testing.T) {
+	t.Parallel()
+
+	navy := color.RGBA{0x13, 0x29, 0x4B, 255}
+	orange := color.RGBA{0xFB, 0x4F, 0x14, 255}
+	th := &Theme{}
+
+	tests := []struct {
+		name      string
+		primary   color.Color
+		alternate color.Color
+		expected  color.Color
+	}{
+		{
+			name:      "readable primary",
+			primary:   orange,
+			alternate: navy,
+			expected:  orange,
+		},
+		{
+			name:      "dark primary uses alternate",
+			primary:   navy,
+			alternate: orange,
+			expected:  orange,
+		},
+		{
+			name:     "no colors",
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, test.expected, th.TeamColor(test.primary, test.alternate))
+		})
+	}
+
+	// Navy on its own is lightened until it stands out against black
+	lightened := th.TeamColor(navy, color.Black)
+	require.GreaterOrEqual(t, Contrast(lightened, color.Black), DefaultMinContrast)
+	r, _, b, _ := lightened.RGBA()
+	require.Greater(t, b, r)
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, Register(&Theme{}))
+	require.Error(t, Register(&Theme{Name: "bad", Text: "nothex"}))
+	require.NoError(t, Register(&Theme{Name: "Sunset", Text: "#FF8000"}))
+
+	sunset, err := Get("sunset")
+	require.NoError(t, err)
+	require.Equal(t, color.RGBA{0xFF, 0x80, 0x00, 255}, sunset.TextColor())
+	// Unset colors come from the default theme
+	require.Equal(t, color.RGBA{255, 0, 0, 255}, sunset.ScoredColor())
+
+	_, err = Get("nope")
+	require.Error(t, err)
+	require.Equal(t, DefaultName, Resolve("nope").Name)
+}
@@ -10,15 +10,19 @@ import (
 	"time"
 
 	"github.com/robfig/cron/v3"
+
+	"github.com/robbydyer/sports/internal/fetch"
+	"github.com/robbydyer/sports/internal/locale"
 )
 
-// Today is sometimes actually yesterday
+// Today is sometimes actually yesterday. It's in the locale's time zone.
 func Today(t time.Time) time.Time {
-	if t.Local().Hour() < 4 {
-		return t.AddDate(0, 0, -1).Local()
+	t = t.In(locale.Location())
+	if t.Hour() < 4 {
+		return t.AddDate(0, 0, -1)
 	}
 
-	return t.Local()
+	return t
 }
 
 func FakeTodayFunc(t time.Time) func() []time.Time {
@@ -247,7 +251,7 @@ func PullPng(ctx context.Context, url string) (image.Image, error) {
 	if err != nil {
 		return nil, err
 	}
-	client := http.DefaultClient
+	client := fetch.Client()
 
 	req = req.WithContext(ctx)
 
@@ -282,7 +286,7 @@ func SetCrons(times []string, f func()) error {
 		return nil
 	}
 
-	c := cron.New()
+	c := cron.New(cron.WithLocation(locale.Location()))
 	for _, t := range times {
 		if _, err := c.AddFunc(t, f); err != nil {
 			return fmt.Errorf("failed to add cron func: %w", err)
@@ -7,6 +7,7 @@ import Board from './Board.js';
 import TopNav from './Nav.js';
 import All from './All.js';
 import BasicBoard from './BasicBoard';
+import LogoEditor from './LogoEditor.js';
 import { BrowserRouter, Route, Routes } from 'react-router-dom';
 import SwaggerUI from 'swagger-ui-react';
 import "swagger-ui-react/swagger-ui.css";
@@ -38,6 +39,7 @@ class App extends React.Component {
             <Route path="/sys" render={() => <BasicBoard id="sys" name="sys" key="sys" withImg="true" />} />
             <Route path="/gcal" render={() => <BasicBoard id="gcal" name="gcal" key="gcal" withImg="true" />} />
             <Route path="/board" exact component={Board} />
+            <Route path="/logos" element={<LogoEditor />} />
             <Route path="/docs" exact component={() => <SwaggerUI spec={swag} />} />
             <Route path="/f1" exact component={() => <Racing sport="f1" id="f1" key="f1" withImg="true" />} />
             <Route path="/irl" exact component={() => <Racing sport="irl" id="irl" key="irl" withImg="true" />} />
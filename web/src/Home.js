@@ -14,6 +14,7 @@ function jsonToStatus(jsonDat) {
     var status = new Status();
     status.setScreenOn(dat.screen_on);
     status.setWebboardOn(dat.webboard_on);
+    status.setCombinedScroll(dat.combined_scroll);
 
     return status;
 }
@@ -104,6 +105,12 @@ class Home extends React.Component {
                             onChange={() => { this.state.status.setWebboardOn(!this.state.status.getWebboardOn()); this.updateStatus(); }} />
                     </Col>
                 </Row>
+                <Row className="text-left">
+                    <Col>
+                        <Form.Switch id="combinedscroll" label="Combined Scroll On/Off" checked={this.state.status.getCombinedScroll()}
+                            onChange={() => { this.state.status.setCombinedScroll(!this.state.status.getCombinedScroll()); this.updateStatus(); }} />
+                    </Col>
+                </Row>
                 <Row className="text-left">
                     <Col>
                         <Button variant="primary" onClick={this.nextBoard}>Next Board</Button>
@@ -0,0 +1,193 @@
+import React from 'react';
+import 'bootstrap/dist/css/bootstrap.min.css';
+import Container from 'react-bootstrap/Container';
+import Row from 'react-bootstrap/Row';
+import Col from 'react-bootstrap/Col';
+import Form from 'react-bootstrap/Form';
+import Button from 'react-bootstrap/Button';
+import Image from 'react-bootstrap/Image';
+
+var BACKEND = "http://" + window.location.host
+
+const leagues = [
+    "ncaaf", "nfl", "nba", "ncaam", "ncaaw", "wnba", "nhl", "mlb", "mls",
+    "epl", "dfl", "dfb", "uefa", "fifa", "ligue", "seriea", "laliga", "xfl",
+]
+
+class LogoEditor extends React.Component {
+    constructor(props) {
+        super(props);
+        this.state = {
+            league: "ncaaf",
+            teams: [],
+            team: "",
+            side: "HOME",
+            width: 64,
+            height: 32,
+            xShift: 0,
+            yShift: 0,
+            zoom: 1,
+            message: "",
+        }
+    }
+
+    async componentDidMount() {
+        await fetch(`${BACKEND}/api/matrixsize`).then((resp) => {
+            if (resp.ok) {
+                return resp.json()
+            }
+            throw resp
+        }).then((size) => {
+            this.setState({ width: size.width, height: size.height })
+        }).catch(err => {
+            console.log("failed to get matrix size", err);
+        });
+        await this.getTeams(this.state.league);
+    }
+
+    query() {
+        return `team=${this.state.team}&side=${this.state.side}&width=${this.state.width}&height=${this.state.height}`
+    }
+
+    async getTeams(league) {
+        await fetch(`${BACKEND}/api/${league}/logos/teams`).then((resp) => {
+            if (resp.ok) {
+                return resp.json()
+            }
+            throw resp
+        }).then((teams) => {
+            var team = teams && teams.length > 0 ? teams[0].id : "";
+            this.setState({ teams: teams || [], team: team, message: "" }, () => this.getConfig());
+        }).catch(err => {
+            console.log("failed to get teams", err);
+            this.setState({ teams: [], team: "", message: `No logos for ${league}` });
+        });
+    }
+
+    async getConfig() {
+        if (this.state.team === "") {
+            return
+        }
+        await fetch(`${BACKEND}/api/${this.state.league}/logos/config?${this.query()}`).then((resp) => {
+            if (resp.ok) {
+                return resp.json()
+            }
+            throw resp
+        }).then((conf) => {
+            this.setState({
+                xShift: conf.pt ? conf.pt.xShift : 0,
+                yShift: conf.pt ? conf.pt.yShift : 0,
+                zoom: conf.pt ? conf.pt.zoom : 1,
+            });
+        }).catch(err => {
+            console.log("failed to get logo config", err);
+        });
+    }
+
+    async save() {
+        var body = JSON.stringify({
+            team: this.state.team,
+            side: this.state.side,
+            width: this.state.width,
+            height: this.state.height,
+            xShift: this.state.xShift,
+            yShift: this.state.yShift,
+            zoom: this.state.zoom,
+        });
+        await fetch(`${BACKEND}/api/${this.state.league}/logos/save`, {
+            method: "POST",
+            headers: { 'Content-Type': 'application/json' },
+            body: body,
+        }).then((resp) => {
+            this.setState({ message: resp.ok ? "Saved" : "Failed to save" });
+        });
+    }
+
+    nudge(field, amount) {
+        var val = Math.round((this.state[field] + amount) * 100) / 100;
+        this.setState({ [field]: val, message: "" });
+    }
+
+    previewSrc() {
+        return `${BACKEND}/api/${this.state.league}/logos/preview?${this.query()}&xShift=${this.state.xShift}&yShift=${this.state.yShift}&zoom=${this.state.zoom}`
+    }
+
+    render() {
+        return (
+            <Container fluid>
+                <Row className="text-center"><Col><h2>Logo Editor</h2></Col></Row>
+                <Row className="justify-content-md-center">
+                    <Col lg="auto">
+                        <Form.Select value={this.state.league} onChange={(e) => {
+                            this.setState({ league: e.target.value }, () => this.getTeams(this.state.league));
+                        }}>
+                            {leagues.map((l) => <option key={l} value={l}>{l.toUpperCase()}</option>)}
+                        </Form.Select>
+                    </Col>
+                    <Col lg="auto">
+                        <Form.Select value={this.state.team} onChange={(e) => {
+                            this.setState({ team: e.target.value, message: "" }, () => this.getConfig());
+                        }}>
+                            {this.state.teams.map((t) => <option key={t.id} value={t.id}>{t.abbreviation} - {t.name}</option>)}
+                        </Form.Select>
+                    </Col>
+                    <Col lg="auto">
+                        <Form.Select value={this.state.side} onChange={(e) => {
+                            this.setState({ side: e.target.value, message: "" }, () => this.getConfig());
+                        }}>
+                            <option value="HOME">Left</option>
+                            <option value="AWAY">Right</option>
+                        </Form.Select>
+                    </Col>
+                </Row>
+                <Row className="text-center">
+                    <Col>
+                        {this.state.team !== "" &&
+                            <Image src={this.previewSrc()} style={{
+                                width: this.state.width * 8,
+                                height: this.state.height * 8,
+                                imageRendering: 'pixelated',
+                            }} />
+                        }
+                    </Col>
+                </Row>
+                <Row className="text-center">
+                    <Col>{this.state.width}x{this.state.height}</Col>
+                </Row>
+                <Row className="justify-content-md-center text-center">
+                    <Col lg="auto">
+                        X: {this.state.xShift}<br />
+                        <Button variant="secondary" onClick={() => this.nudge("xShift", -1)}>&larr;</Button>{' '}
+                        <Button variant="secondary" onClick={() => this.nudge("xShift", 1)}>&rarr;</Button>
+                    </Col>
+                    <Col lg="auto">
+                        Y: {this.state.yShift}<br />
+                        <Button variant="secondary" onClick={() => this.nudge("yShift", -1)}>&uarr;</Button>{' '}
+                        <Button variant="secondary" onClick={() => this.nudge("yShift", 1)}>&darr;</Button>
+                    </Col>
+                    <Col lg="auto">
+                        Zoom: {this.state.zoom}<br />
+                        <Button variant="secondary" onClick={() => this.nudge("zoom", -0.05)}>-</Button>{' '}
+                        <Button variant="secondary" onClick={() => this.nudge("zoom", 0.05)}>+</Button>
+                    </Col>
+                </Row>
+                <Row className="justify-content-md-center">
+                    <Col lg="4">
+                        <Form.Range min="0.1" max="3" step="0.01" value={this.state.zoom} onChange={(e) => {
+                            this.setState({ zoom: parseFloat(e.target.value), message: "" });
+                        }} />
+                    </Col>
+                </Row>
+                <Row className="text-center">
+                    <Col>
+                        <Button variant="primary" disabled={this.state.team === ""} onClick={() => this.save()}>Save</Button>{' '}
+                        <Button variant="secondary" disabled={this.state.team === ""} onClick={() => this.getConfig()}>Reset</Button>
+                    </Col>
+                </Row>
+                <Row className="text-center"><Col>{this.state.message}</Col></Row>
+            </Container>
+        )
+    }
+}
+
+export default LogoEditor;
@@ -75,6 +75,7 @@ class TopNav extends React.Component {
                                 <NavDropDown.Item as={Link} to="/clock">Clock</NavDropDown.Item>
                                 <NavDropDown.Item as={Link} to="/gcal">Calendar</NavDropDown.Item>
                                 <NavDropDown.Item as={Link} to="/sys">System Info</NavDropDown.Item>
+                                <NavDropDown.Item as={Link} to="/logos">Logo Editor</NavDropDown.Item>
                             </NavDropDown>
                             <Nav.Link as={Link} to="/docs">API Docs</Nav.Link>
                             <Nav.Link as={Link} to="/board">Live Board</Nav.Link>